@@ -0,0 +1,180 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"hope/db"
+	"hope/middleware"
+	pb "hope/proto/v1/area"
+	"hope/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AreaHandler struct {
+	areaService service.AreaService
+	pb.UnimplementedAreaServiceServer
+}
+
+func NewAreaHandler(areaService service.AreaService) *AreaHandler {
+	return &AreaHandler{areaService: areaService}
+}
+
+func toZonePB(z *db.ServiceZone) *pb.ServiceZone {
+	if z == nil {
+		return nil
+	}
+	var ts *timestamppb.Timestamp
+	if !z.CreatedAt.IsZero() {
+		ts = timestamppb.New(z.CreatedAt)
+	}
+	return &pb.ServiceZone{
+		Id:        z.ID,
+		OrgId:     z.OrgID,
+		Name:      z.Name,
+		AppliesTo: z.AppliesTo,
+		MinLat:    z.MinLat,
+		MaxLat:    z.MaxLat,
+		MinLon:    z.MinLon,
+		MaxLon:    z.MaxLon,
+		CreatedAt: ts,
+	}
+}
+
+func toMeetingPointPB(p *db.MeetingPoint) *pb.MeetingPoint {
+	if p == nil {
+		return nil
+	}
+	return &pb.MeetingPoint{
+		Id:          p.ID,
+		OrgId:       p.OrgID,
+		Name:        p.Name,
+		Description: p.Description,
+		Latitude:    p.Latitude,
+		Longitude:   p.Longitude,
+		Geohash:     p.Geohash,
+	}
+}
+
+func areaStatus(err error, action string) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case strings.Contains(msg, "not found"), strings.Contains(msg, "nearby"):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Errorf(codes.InvalidArgument, "%s failed: %v", action, err)
+	}
+}
+
+func (h *AreaHandler) CreateZone(ctx context.Context, req *pb.CreateZoneRequest) (*pb.CreateZoneResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetOrgId()) == "" || strings.TrimSpace(req.GetName()) == "" || strings.TrimSpace(req.GetGeojson()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id, name and geojson are required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	z, err := h.areaService.CreateZone(ctx, callerID, req.GetOrgId(), req.GetName(), req.GetAppliesTo(), []byte(req.GetGeojson()))
+	if err != nil {
+		return nil, areaStatus(err, "create zone")
+	}
+	return &pb.CreateZoneResponse{Zone: toZonePB(z)}, nil
+}
+
+func (h *AreaHandler) ListZones(ctx context.Context, req *pb.ListZonesRequest) (*pb.ListZonesResponse, error) {
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	zones, err := h.areaService.ListZones(ctx, callerID, req.GetOrgId())
+	if err != nil {
+		return nil, areaStatus(err, "list zones")
+	}
+	out := make([]*pb.ServiceZone, 0, len(zones))
+	for i := range zones {
+		out = append(out, toZonePB(&zones[i]))
+	}
+	return &pb.ListZonesResponse{Zones: out}, nil
+}
+
+func (h *AreaHandler) DeleteZone(ctx context.Context, req *pb.DeleteZoneRequest) (*pb.DeleteZoneResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	if err := h.areaService.DeleteZone(ctx, callerID, req.GetId()); err != nil {
+		return nil, areaStatus(err, "delete zone")
+	}
+	return &pb.DeleteZoneResponse{Success: true}, nil
+}
+
+func (h *AreaHandler) CreateMeetingPoint(ctx context.Context, req *pb.CreateMeetingPointRequest) (*pb.CreateMeetingPointResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetOrgId()) == "" || strings.TrimSpace(req.GetName()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id and name are required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	p := &db.MeetingPoint{
+		OrgID:       req.GetOrgId(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Latitude:    req.GetLatitude(),
+		Longitude:   req.GetLongitude(),
+	}
+	if err := h.areaService.CreateMeetingPoint(ctx, callerID, p); err != nil {
+		return nil, areaStatus(err, "create meeting point")
+	}
+	return &pb.CreateMeetingPointResponse{Point: toMeetingPointPB(p)}, nil
+}
+
+func (h *AreaHandler) ListMeetingPoints(ctx context.Context, req *pb.ListMeetingPointsRequest) (*pb.ListMeetingPointsResponse, error) {
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	points, err := h.areaService.ListMeetingPoints(ctx, callerID, req.GetGeohashPrefix(), int(req.GetLimit()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list failed: %v", err)
+	}
+	out := make([]*pb.MeetingPoint, 0, len(points))
+	for i := range points {
+		out = append(out, toMeetingPointPB(&points[i]))
+	}
+	return &pb.ListMeetingPointsResponse{Points: out}, nil
+}
+
+func (h *AreaHandler) DeleteMeetingPoint(ctx context.Context, req *pb.DeleteMeetingPointRequest) (*pb.DeleteMeetingPointResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	if err := h.areaService.DeleteMeetingPoint(ctx, callerID, req.GetId()); err != nil {
+		return nil, areaStatus(err, "delete meeting point")
+	}
+	return &pb.DeleteMeetingPointResponse{Success: true}, nil
+}
+
+func (h *AreaHandler) SnapToMeetingPoint(ctx context.Context, req *pb.SnapToMeetingPointRequest) (*pb.SnapToMeetingPointResponse, error) {
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	p, dist, err := h.areaService.SnapToMeetingPoint(ctx, callerID, req.GetLatitude(), req.GetLongitude(), req.GetMaxMeters())
+	if err != nil {
+		return nil, areaStatus(err, "snap")
+	}
+	return &pb.SnapToMeetingPointResponse{Point: toMeetingPointPB(p), DistanceMeters: dist}, nil
+}
@@ -22,7 +22,7 @@ func (h *AuthHandler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 		return nil, status.Error(codes.InvalidArgument, "id_token is required")
 	}
 
-	jwtToken, user, err := h.authService.Login(ctx, req.GetIdToken())
+	jwtToken, user, err := h.authService.Login(ctx, req.GetIdToken(), req.GetInviteCode())
 	if err != nil {
 		return nil, status.Errorf(codes.Unauthenticated, "login failed: %v", err)
 	}
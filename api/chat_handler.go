@@ -35,6 +35,7 @@ func toChatPB(c *db.ChatMessage) *pb.ChatMessage {
 		SenderId:  c.SenderID,
 		Content:   c.Content,
 		Timestamp: ts,
+		System:    c.System,
 	}
 }
 
@@ -69,7 +70,8 @@ func (h *ChatHandler) ListMessagesByRide(ctx context.Context, req *pb.ListMessag
 	if req.GetBefore() != nil {
 		before = req.GetBefore().AsTime()
 	}
-	msgs, err := h.chatService.ListMessagesByRide(ctx, req.GetRideId(), int(req.GetLimit()), before)
+	callerID, _ := middleware.UserIDFromContext(ctx)
+	msgs, err := h.chatService.ListMessagesByRide(ctx, callerID, req.GetRideId(), int(req.GetLimit()), before)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "list failed: %v", err)
 	}
@@ -88,7 +90,8 @@ func (h *ChatHandler) ListMessagesBySender(ctx context.Context, req *pb.ListMess
 	if req.GetBefore() != nil {
 		before = req.GetBefore().AsTime()
 	}
-	msgs, err := h.chatService.ListMessagesBySender(ctx, req.GetSenderId(), int(req.GetLimit()), before)
+	callerID, _ := middleware.UserIDFromContext(ctx)
+	msgs, err := h.chatService.ListMessagesBySender(ctx, callerID, req.GetSenderId(), int(req.GetLimit()), before)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "list failed: %v", err)
 	}
@@ -107,7 +110,8 @@ func (h *ChatHandler) ListChatsForUser(ctx context.Context, req *pb.ListChatsFor
 	if req.GetBefore() != nil {
 		before = req.GetBefore().AsTime()
 	}
-	msgs, err := h.chatService.ListChatsForUser(ctx, req.GetUserId(), int(req.GetLimit()), before)
+	callerID, _ := middleware.UserIDFromContext(ctx)
+	msgs, err := h.chatService.ListChatsForUser(ctx, callerID, req.GetUserId(), int(req.GetLimit()), before)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "list failed: %v", err)
 	}
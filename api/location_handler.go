@@ -6,6 +6,9 @@ import (
 	"hope/middleware"
 	pb "hope/proto/v1/location"
 	"hope/service"
+	"strings"
+	"time"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -34,9 +37,21 @@ func toLocationPB(l *db.UserLocation) *pb.UserLocation {
 		Longitude: l.Longitude,
 		Geohash:   l.Geohash,
 		UpdatedAt: ts,
+		Coarse:    l.Coarse,
 	}
 }
 
+func toLocationSettingsPB(st *db.LocationSetting) *pb.LocationSettings {
+	if st == nil {
+		return nil
+	}
+	var ts *timestamppb.Timestamp
+	if !st.UpdatedAt.IsZero() {
+		ts = timestamppb.New(st.UpdatedAt)
+	}
+	return &pb.LocationSettings{Visibility: st.Visibility, UpdatedAt: ts}
+}
+
 
 func (h *LocationHandler) UpsertLocation(ctx context.Context, req *pb.UpsertLocationRequest) (*pb.UpsertLocationResponse, error) {
 	if req == nil {
@@ -65,7 +80,8 @@ func (h *LocationHandler) GetLocationByUser(ctx context.Context, req *pb.GetLoca
 	if req == nil || req.GetUserId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "user_id required")
 	}
-	l, err := h.locationService.GetLocationByUser(ctx, req.GetUserId())
+	callerID, _ := middleware.UserIDFromContext(ctx)
+	l, err := h.locationService.GetLocationByUser(ctx, callerID, req.GetUserId())
 	if err != nil || l == nil {
 		return nil, status.Error(codes.NotFound, "location not found")
 	}
@@ -73,11 +89,21 @@ func (h *LocationHandler) GetLocationByUser(ctx context.Context, req *pb.GetLoca
 }
 
 func (h *LocationHandler) ListNearby(ctx context.Context, req *pb.ListNearbyRequest) (*pb.ListNearbyResponse, error) {
-	if req == nil || req.GetGeohashPrefix() == "" {
-		return nil, status.Error(codes.InvalidArgument, "geohash_prefix required")
+	if req == nil || (req.GetGeohashPrefix() == "" && req.GetRadiusMeters() == 0) {
+		return nil, status.Error(codes.InvalidArgument, "geohash_prefix or radius_meters required")
+	}
+	callerID, _ := middleware.UserIDFromContext(ctx)
+	var locs []db.UserLocation
+	var err error
+	if req.GetRadiusMeters() != 0 {
+		locs, err = h.locationService.ListWithinRadius(ctx, callerID, req.GetLatitude(), req.GetLongitude(), req.GetRadiusMeters(), int(req.GetLimit()))
+	} else {
+		locs, err = h.locationService.ListNearby(ctx, callerID, req.GetGeohashPrefix(), int(req.GetLimit()))
 	}
-	locs, err := h.locationService.ListNearby(ctx, req.GetGeohashPrefix(), int(req.GetLimit()))
 	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "invalid") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "list failed: %v", err)
 	}
 	out := make([]*pb.UserLocation, 0, len(locs))
@@ -98,3 +124,58 @@ func (h *LocationHandler) DeleteMyLocation(ctx context.Context, _ *pb.DeleteMyLo
 	}
 	return &pb.DeleteMyLocationResponse{Success: true}, nil
 }
+
+func (h *LocationHandler) GetLocationSettings(ctx context.Context, _ *pb.GetLocationSettingsRequest) (*pb.GetLocationSettingsResponse, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	st, err := h.locationService.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "get settings failed: %v", err)
+	}
+	return &pb.GetLocationSettingsResponse{Settings: toLocationSettingsPB(st)}, nil
+}
+
+func (h *LocationHandler) UpdateLocationSettings(ctx context.Context, req *pb.UpdateLocationSettingsRequest) (*pb.UpdateLocationSettingsResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetVisibility()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "visibility required")
+	}
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	st, err := h.locationService.UpdateSettings(ctx, userID, req.GetVisibility())
+	if err != nil {
+		if strings.Contains(err.Error(), "visibility") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "update settings failed: %v", err)
+	}
+	return &pb.UpdateLocationSettingsResponse{Settings: toLocationSettingsPB(st)}, nil
+}
+
+// ListLocationViews is the caller's own audit trail: who looked at their location
+func (h *LocationHandler) ListLocationViews(ctx context.Context, req *pb.ListLocationViewsRequest) (*pb.ListLocationViewsResponse, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	before := time.Now()
+	if req.GetBefore() != nil {
+		before = req.GetBefore().AsTime()
+	}
+	views, err := h.locationService.ListViews(ctx, userID, int(req.GetLimit()), before)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list failed: %v", err)
+	}
+	out := make([]*pb.LocationView, 0, len(views))
+	for _, v := range views {
+		out = append(out, &pb.LocationView{
+			ViewerId: v.ViewerID,
+			Precise:  v.Precise,
+			ViewedAt: timestamppb.New(v.ViewedAt),
+		})
+	}
+	return &pb.ListLocationViewsResponse{Views: out}, nil
+}
@@ -33,13 +33,84 @@ func toMatchPB(m *db.Match) *pb.Match {
 	if !m.CreatedAt.IsZero() {
 		ts = timestamppb.New(m.CreatedAt)
 	}
-	return &pb.Match{
+	out := &pb.Match{
 		Id:        m.ID,
 		RiderId:   m.RiderID,
 		DriverId:  m.DriverID,
 		RideId:    m.RideID,
 		Status:    m.Status,
 		CreatedAt: ts,
+		OrgId:     m.OrgID,
+		PickupGeo: m.PickupGeo,
+
+		DropoffGeo:    m.DropoffGeo,
+		DetourSeconds: int32(m.DetourSeconds),
+		DetourMeters:  m.DetourMeters,
+
+		PickupStop:  int32(m.PickupStop),
+		DropoffStop: int32(m.DropoffStop),
+		Seats:       int32(m.Seats),
+
+		CancelledBy:  m.CancelledBy,
+		CancelReason: m.CancelReason,
+		LateCancel:   m.LateCancel,
+		NoShowUserId: m.NoShowUserID,
+
+		AcceptRule:   m.AcceptRule,
+		AutoAccepted: m.AutoAccepted,
+		AcceptNote:   m.AcceptNote,
+	}
+	if m.StartedAt != nil {
+		out.StartedAt = timestamppb.New(*m.StartedAt)
+	}
+	if m.CompletedAt != nil {
+		out.CompletedAt = timestamppb.New(*m.CompletedAt)
+	}
+	if m.CancelledAt != nil {
+		out.CancelledAt = timestamppb.New(*m.CancelledAt)
+	}
+	if m.NoShowReportedAt != nil {
+		out.NoShowReportedAt = timestamppb.New(*m.NoShowReportedAt)
+	}
+	if m.ConfirmBy != nil {
+		out.ConfirmBy = timestamppb.New(*m.ConfirmBy)
+	}
+	if m.EarliestPickup != nil || m.LatestPickup != nil || m.ArriveBy != nil {
+		out.PickupWindow = &pb.PickupWindow{
+			Earliest: optionalTimestamp(m.EarliestPickup),
+			Latest:   optionalTimestamp(m.LatestPickup),
+			ArriveBy: optionalTimestamp(m.ArriveBy),
+		}
+	}
+	out.PickupAt = optionalTimestamp(m.PickupAt)
+	out.DepartAt = optionalTimestamp(m.DepartAt)
+	out.PairId, out.Leg, out.PairMode = m.PairID, m.Leg, m.PairMode
+	out.Fare, out.FareBy, out.FareCounters = m.Fare, m.FareBy, int32(m.FareCounters)
+	return out
+}
+
+// joinMatch is the match riderID asks for with req
+func joinMatch(riderID string, req *pb.RequestToJoinRequest) *db.Match {
+	return &db.Match{
+		ID:        uuid.New().String(),
+		RiderID:   riderID,
+		RideID:    strings.TrimSpace(req.GetRideId()),
+		PickupGeo: strings.TrimSpace(req.GetPickupGeo()),
+		Status:    "requested",
+		CreatedAt: time.Now().UTC(),
+
+		DropoffGeo: strings.TrimSpace(req.GetDropoffGeo()),
+
+		PickupStop:  int(req.GetPickupStop()),
+		DropoffStop: int(req.GetDropoffStop()),
+		Seats:       int(req.GetSeats()),
+
+		EarliestPickup: optionalTime(req.GetPickupWindow().GetEarliest()),
+		LatestPickup:   optionalTime(req.GetPickupWindow().GetLatest()),
+		ArriveBy:       optionalTime(req.GetPickupWindow().GetArriveBy()),
+		PickupAt:       optionalTime(req.GetPickupAt()),
+
+		Fare: req.GetFare(),
 	}
 }
 
@@ -53,15 +124,12 @@ func (h *MatchHandler) RequestToJoin(ctx context.Context, req *pb.RequestToJoinR
 		return nil, status.Error(codes.Unauthenticated, "missing auth")
 	}
 
-	m := &db.Match{
-		ID:        uuid.New().String(),
-		RiderID:   riderID,
-		RideID:    strings.TrimSpace(req.GetRideId()),
-		Status:    "requested",
-		CreatedAt: time.Now().UTC(),
-	}
+	m := joinMatch(riderID, req)
 
-	if err := h.matchService.RequestToJoin(ctx, m); err != nil {
+	if err := h.matchService.RequestToJoin(ctx, m, req.GetWaitlist()); err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "invalid state") {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Errorf(codes.InvalidArgument, "request failed: %v", err)
 	}
 
@@ -76,7 +144,13 @@ func (h *MatchHandler) AcceptRideRequest(ctx context.Context, req *pb.AcceptRide
 	if !ok || driverID == "" {
 		return nil, status.Error(codes.Unauthenticated, "missing auth")
 	}
-	m, err := h.matchService.AcceptRideRequest(ctx, driverID, req.GetRequestId())
+	m, err := h.matchService.AcceptRideRequest(ctx, driverID, req.GetRequestId(), optionalTime(req.GetPickupAt()))
+	if err != nil && strings.Contains(err.Error(), "invalid state") {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil && strings.Contains(err.Error(), "invalid pickup time") {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil || m == nil || m.ID == "" {
 		return nil, status.Error(codes.NotFound, "match not created")
 	}
@@ -93,7 +167,7 @@ func (h *MatchHandler) AcceptRequest(ctx context.Context, req *pb.AcceptRequestR
 		return nil, status.Error(codes.Unauthenticated, "missing auth")
 	}
 
-	if err := h.matchService.AcceptRequest(ctx, callerID, req.GetMatchId()); err != nil {
+	if err := h.matchService.AcceptRequest(ctx, callerID, req.GetMatchId(), optionalTime(req.GetPickupAt())); err != nil {
 		msg := strings.ToLower(err.Error())
 		switch {
 		case strings.Contains(msg, "forbidden"):
@@ -107,7 +181,7 @@ func (h *MatchHandler) AcceptRequest(ctx context.Context, req *pb.AcceptRequestR
 		}
 	}
 
-	m, err := h.matchService.GetMatchByID(ctx, req.GetMatchId())
+	m, err := h.matchService.GetMatchByID(ctx, callerID, req.GetMatchId())
 	if err != nil || m == nil || m.ID == "" {
 		return nil, status.Error(codes.NotFound, "match not found")
 	}
@@ -125,7 +199,7 @@ func (h *MatchHandler) RejectRequest(ctx context.Context, req *pb.RejectRequestR
 		return nil, status.Error(codes.Unauthenticated, "missing auth")
 	}
 
-	if err := h.matchService.RejectRequest(ctx, callerID, req.GetMatchId()); err != nil {
+	if err := h.matchService.RejectRequest(ctx, callerID, req.GetMatchId(), req.GetReason()); err != nil {
 		msg := strings.ToLower(err.Error())
 		switch {
 		case strings.Contains(msg, "forbidden"):
@@ -139,7 +213,7 @@ func (h *MatchHandler) RejectRequest(ctx context.Context, req *pb.RejectRequestR
 		}
 	}
 
-	m, err := h.matchService.GetMatchByID(ctx, req.GetMatchId())
+	m, err := h.matchService.GetMatchByID(ctx, callerID, req.GetMatchId())
 	if err != nil || m == nil || m.ID == "" {
 		return nil, status.Error(codes.NotFound, "match not found")
 	}
@@ -152,11 +226,15 @@ func (h *MatchHandler) CompleteMatch(ctx context.Context, req *pb.CompleteMatchR
 		return nil, status.Error(codes.InvalidArgument, "match_id is required")
 	}
 
-	if err := h.matchService.CompleteMatch(ctx, req.GetMatchId()); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "complete failed: %v", err)
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	if err := h.matchService.CompleteMatch(ctx, callerID, req.GetMatchId()); err != nil {
+		return nil, matchStateError(err, "complete")
 	}
 
-	m, err := h.matchService.GetMatchByID(ctx, req.GetMatchId())
+	m, err := h.matchService.GetMatchByID(ctx, callerID, req.GetMatchId())
 	if err != nil || m == nil || m.ID == "" {
 		return nil, status.Error(codes.NotFound, "match not found")
 	}
@@ -168,8 +246,9 @@ func (h *MatchHandler) GetMatch(ctx context.Context, req *pb.GetMatchRequest) (*
 	if req == nil || strings.TrimSpace(req.GetMatchId()) == "" {
 		return nil, status.Error(codes.InvalidArgument, "match_id is required")
 	}
+	callerID, _ := middleware.UserIDFromContext(ctx)
 
-	m, err := h.matchService.GetMatchByID(ctx, req.GetMatchId())
+	m, err := h.matchService.GetMatchByID(ctx, callerID, req.GetMatchId())
 	if err != nil || m == nil || m.ID == "" {
 		return nil, status.Error(codes.NotFound, "match not found")
 	}
@@ -182,7 +261,8 @@ func (h *MatchHandler) ListMatchesByRide(ctx context.Context, req *pb.ListMatche
 		return nil, status.Error(codes.InvalidArgument, "ride_id is required")
 	}
 
-	ms, err := h.matchService.ListMatchesByRide(ctx, req.GetRideId())
+	callerID, _ := middleware.UserIDFromContext(ctx)
+	ms, err := h.matchService.ListMatchesByRide(ctx, callerID, req.GetRideId())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "list failed: %v", err)
 	}
@@ -200,7 +280,8 @@ func (h *MatchHandler) ListMatchesByRider(ctx context.Context, req *pb.ListMatch
 		return nil, status.Error(codes.InvalidArgument, "rider_id is required")
 	}
 
-	ms, err := h.matchService.ListMatchesByRider(ctx, req.GetRiderId())
+	callerID, _ := middleware.UserIDFromContext(ctx)
+	ms, err := h.matchService.ListMatchesByRider(ctx, callerID, req.GetRiderId())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "list failed: %v", err)
 	}
@@ -213,13 +294,19 @@ func (h *MatchHandler) ListMatchesByRider(ctx context.Context, req *pb.ListMatch
 	return &pb.ListMatchesByRiderResponse{Matches: out}, nil
 }
 
-func (h *MatchHandler) ListMyMatches(ctx context.Context, _ *pb.ListMyMatchesRequest) (*pb.ListMyMatchesResponse, error) {
-	riderID, ok := middleware.UserIDFromContext(ctx)
-	if !ok || riderID == "" {
+func (h *MatchHandler) ListMyMatches(ctx context.Context, req *pb.ListMyMatchesRequest) (*pb.ListMyMatchesResponse, error) {
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
 		return nil, status.Error(codes.Unauthenticated, "missing auth")
 	}
 
-	ms, err := h.matchService.ListMatchesByRider(ctx, riderID)
+	var ms []db.Match
+	var err error
+	if req.GetAsDriver() {
+		ms, err = h.matchService.ListMatchesByDriver(ctx, callerID, int(req.GetLimit()))
+	} else {
+		ms, err = h.matchService.ListMatchesByRider(ctx, callerID, callerID)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "list failed: %v", err)
 	}
@@ -231,3 +318,280 @@ func (h *MatchHandler) ListMyMatches(ctx context.Context, _ *pb.ListMyMatchesReq
 
 	return &pb.ListMyMatchesResponse{Matches: out}, nil
 }
+
+func matchStateError(err error, action string) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case strings.Contains(msg, "not found"):
+		return status.Error(codes.NotFound, err.Error())
+	case strings.Contains(msg, "invalid state"):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.InvalidArgument, "%s failed: %v", action, err)
+	}
+}
+
+func (h *MatchHandler) WithdrawRequest(ctx context.Context, req *pb.WithdrawRequestRequest) (*pb.WithdrawRequestResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetMatchId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "match_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	if err := h.matchService.WithdrawRequest(ctx, callerID, req.GetMatchId(), req.GetReason()); err != nil {
+		return nil, matchStateError(err, "withdraw")
+	}
+
+	m, err := h.matchService.GetMatchByID(ctx, callerID, req.GetMatchId())
+	if err != nil || m == nil || m.ID == "" {
+		return nil, status.Error(codes.NotFound, "match not found")
+	}
+	return &pb.WithdrawRequestResponse{Match: toMatchPB(m)}, nil
+}
+
+func (h *MatchHandler) CancelMatch(ctx context.Context, req *pb.CancelMatchRequest) (*pb.CancelMatchResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetMatchId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "match_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	if err := h.matchService.CancelMatch(ctx, callerID, req.GetMatchId(), req.GetReason()); err != nil {
+		return nil, matchStateError(err, "cancel")
+	}
+
+	m, err := h.matchService.GetMatchByID(ctx, callerID, req.GetMatchId())
+	if err != nil || m == nil || m.ID == "" {
+		return nil, status.Error(codes.NotFound, "match not found")
+	}
+	return &pb.CancelMatchResponse{Match: toMatchPB(m)}, nil
+}
+
+func (h *MatchHandler) CancelRide(ctx context.Context, req *pb.CancelRideRequest) (*pb.CancelRideResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetRideId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "ride_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	ms, err := h.matchService.CancelRide(ctx, callerID, req.GetRideId(), req.GetReason())
+	if err != nil {
+		return nil, matchStateError(err, "cancel")
+	}
+
+	out := make([]*pb.Match, 0, len(ms))
+	for i := range ms {
+		out = append(out, toMatchPB(&ms[i]))
+	}
+	return &pb.CancelRideResponse{Cancelled: out}, nil
+}
+
+func (h *MatchHandler) ReportNoShow(ctx context.Context, req *pb.ReportNoShowRequest) (*pb.ReportNoShowResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetMatchId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "match_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	m, err := h.matchService.ReportNoShow(ctx, callerID, req.GetMatchId())
+	if err != nil {
+		return nil, matchStateError(err, "report")
+	}
+	return &pb.ReportNoShowResponse{Match: toMatchPB(m)}, nil
+}
+
+func (h *MatchHandler) IssuePickupPin(ctx context.Context, req *pb.IssuePickupPinRequest) (*pb.IssuePickupPinResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetMatchId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "match_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	pin, expires, err := h.matchService.IssuePickupPin(ctx, callerID, req.GetMatchId())
+	if err != nil {
+		return nil, matchStateError(err, "pin")
+	}
+	return &pb.IssuePickupPinResponse{Pin: pin, ExpiresAt: timestamppb.New(expires)}, nil
+}
+
+func (h *MatchHandler) CheckInRider(ctx context.Context, req *pb.CheckInRiderRequest) (*pb.CheckInRiderResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetMatchId()) == "" || strings.TrimSpace(req.GetPin()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "match_id and pin are required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	m, err := h.matchService.CheckInRider(ctx, callerID, req.GetMatchId(), req.GetPin())
+	if err != nil {
+		return nil, matchStateError(err, "check-in")
+	}
+	return &pb.CheckInRiderResponse{Match: toMatchPB(m)}, nil
+}
+
+func toMatchEventPB(e *db.MatchEvent) *pb.MatchEvent {
+	return &pb.MatchEvent{
+		Id:         e.ID,
+		MatchId:    e.MatchID,
+		ActorId:    e.ActorID,
+		FromStatus: e.FromStatus,
+		ToStatus:   e.ToStatus,
+		Reason:     e.Reason,
+		CreatedAt:  timestamppb.New(e.CreatedAt),
+	}
+}
+
+func (h *MatchHandler) GetMatchHistory(ctx context.Context, req *pb.GetMatchHistoryRequest) (*pb.GetMatchHistoryResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetMatchId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "match_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	events, err := h.matchService.GetMatchHistory(ctx, callerID, req.GetMatchId())
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "not found") {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "history failed: %v", err)
+	}
+	out := make([]*pb.MatchEvent, 0, len(events))
+	for i := range events {
+		out = append(out, toMatchEventPB(&events[i]))
+	}
+	return &pb.GetMatchHistoryResponse{Events: out}, nil
+}
+
+func (h *MatchHandler) ConfirmWaitlistSeat(ctx context.Context, req *pb.ConfirmWaitlistSeatRequest) (*pb.ConfirmWaitlistSeatResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetMatchId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "match_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	m, err := h.matchService.ConfirmWaitlistSeat(ctx, callerID, req.GetMatchId())
+	if err != nil {
+		return nil, matchStateError(err, "confirm")
+	}
+	return &pb.ConfirmWaitlistSeatResponse{Match: toMatchPB(m)}, nil
+}
+
+func (h *MatchHandler) GetWaitlistPosition(ctx context.Context, req *pb.GetWaitlistPositionRequest) (*pb.GetWaitlistPositionResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetMatchId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "match_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	spot, err := h.matchService.GetWaitlistPosition(ctx, callerID, req.GetMatchId())
+	if err != nil {
+		return nil, matchStateError(err, "lookup")
+	}
+	return &pb.GetWaitlistPositionResponse{
+		Match:    toMatchPB(spot.Match),
+		Position: int32(spot.Position),
+		Length:   int32(spot.Length),
+	}, nil
+}
+
+func (h *MatchHandler) RequestRoundTrip(ctx context.Context, req *pb.RequestRoundTripRequest) (*pb.RequestRoundTripResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetOutbound().GetRideId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "outbound.ride_id is required")
+	}
+	riderID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || riderID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	out := joinMatch(riderID, req.GetOutbound())
+	back := joinMatch(riderID, req.GetReturnLeg())
+	if err := h.matchService.RequestRoundTrip(ctx, out, back, req.GetMode()); err != nil {
+		return nil, matchStateError(err, "request")
+	}
+	return &pb.RequestRoundTripResponse{Outbound: toMatchPB(out), ReturnLeg: toMatchPB(back)}, nil
+}
+
+func toFareProposalPB(p *db.FareProposal) *pb.FareProposal {
+	return &pb.FareProposal{
+		Id:        p.ID,
+		MatchId:   p.MatchID,
+		ActorId:   p.ActorID,
+		Fare:      p.Fare,
+		Note:      p.Note,
+		CreatedAt: timestamppb.New(p.CreatedAt),
+	}
+}
+
+func (h *MatchHandler) CounterFare(ctx context.Context, req *pb.CounterFareRequest) (*pb.CounterFareResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetMatchId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "match_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	m, err := h.matchService.CounterFare(ctx, callerID, req.GetMatchId(), req.GetFare(), req.GetNote())
+	if err != nil {
+		return nil, matchStateError(err, "counter")
+	}
+	return &pb.CounterFareResponse{Match: toMatchPB(m)}, nil
+}
+
+func (h *MatchHandler) AcceptFare(ctx context.Context, req *pb.AcceptFareRequest) (*pb.AcceptFareResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetMatchId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "match_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	m, err := h.matchService.AcceptFare(ctx, callerID, req.GetMatchId())
+	if err != nil {
+		return nil, matchStateError(err, "accept")
+	}
+	return &pb.AcceptFareResponse{Match: toMatchPB(m)}, nil
+}
+
+func (h *MatchHandler) GetFareHistory(ctx context.Context, req *pb.GetFareHistoryRequest) (*pb.GetFareHistoryResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetMatchId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "match_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	proposals, err := h.matchService.GetFareHistory(ctx, callerID, req.GetMatchId())
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "not found") {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "fare history failed: %v", err)
+	}
+	out := make([]*pb.FareProposal, 0, len(proposals))
+	for i := range proposals {
+		out = append(out, toFareProposalPB(&proposals[i]))
+	}
+	return &pb.GetFareHistoryResponse{Proposals: out}, nil
+}
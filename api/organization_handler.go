@@ -0,0 +1,339 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"hope/db"
+	"hope/middleware"
+	pb "hope/proto/v1/organization"
+	"hope/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type OrganizationHandler struct {
+	orgService service.OrganizationService
+	pb.UnimplementedOrganizationServiceServer
+}
+
+func NewOrganizationHandler(orgService service.OrganizationService) *OrganizationHandler {
+	return &OrganizationHandler{orgService: orgService}
+}
+
+func toOrganizationPB(o *db.Organization, domains []db.OrganizationDomain) *pb.Organization {
+	if o == nil {
+		return nil
+	}
+	var ts *timestamppb.Timestamp
+	if !o.CreatedAt.IsZero() {
+		ts = timestamppb.New(o.CreatedAt)
+	}
+	names := make([]string, 0, len(domains))
+	for _, d := range domains {
+		names = append(names, d.Domain)
+	}
+	return &pb.Organization{
+		Id:        o.ID,
+		Name:      o.Name,
+		Domains:   names,
+		CreatedAt: ts,
+	}
+}
+
+func toSharingPB(a *db.OrgSharingAgreement) *pb.SharingAgreement {
+	if a == nil {
+		return nil
+	}
+	var ts *timestamppb.Timestamp
+	if !a.CreatedAt.IsZero() {
+		ts = timestamppb.New(a.CreatedAt)
+	}
+	return &pb.SharingAgreement{
+		Id:           a.ID,
+		OrgId:        a.OrgID,
+		PartnerOrgId: a.PartnerOrgID,
+		CreatedBy:    a.CreatedBy,
+		CreatedAt:    ts,
+	}
+}
+
+func toInvitePB(i *db.Invite) *pb.Invite {
+	if i == nil {
+		return nil
+	}
+	out := &pb.Invite{
+		Id:        i.ID,
+		Code:      i.Code,
+		Email:     i.Email,
+		OrgId:     i.OrgID,
+		CreatedBy: i.CreatedBy,
+		MaxUses:   int32(i.MaxUses),
+		Uses:      int32(i.Uses),
+	}
+	if !i.ExpiresAt.IsZero() {
+		out.ExpiresAt = timestamppb.New(i.ExpiresAt)
+	}
+	if i.RevokedAt != nil {
+		out.RevokedAt = timestamppb.New(*i.RevokedAt)
+	}
+	if !i.CreatedAt.IsZero() {
+		out.CreatedAt = timestamppb.New(i.CreatedAt)
+	}
+	return out
+}
+
+func toFarePolicyPB(p *db.OrgFarePolicy) *pb.FarePolicy {
+	if p == nil {
+		return nil
+	}
+	out := &pb.FarePolicy{
+		OrgId:       p.OrgID,
+		MinRatio:    p.MinRatio,
+		MaxRatio:    p.MaxRatio,
+		MaxCounters: int32(p.MaxCounters),
+		UpdatedBy:   p.UpdatedBy,
+	}
+	if !p.UpdatedAt.IsZero() {
+		out.UpdatedAt = timestamppb.New(p.UpdatedAt)
+	}
+	return out
+}
+
+// orgStatus maps service errors to grpc codes
+func orgStatus(err error, action string) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "forbidden"):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case strings.Contains(msg, "not found"), strings.Contains(msg, "no organization"):
+		return status.Error(codes.NotFound, err.Error())
+	case strings.Contains(msg, "already"):
+		return status.Error(codes.AlreadyExists, err.Error())
+	default:
+		return status.Errorf(codes.InvalidArgument, "%s failed: %v", action, err)
+	}
+}
+
+func (h *OrganizationHandler) GetMyOrganization(ctx context.Context, _ *pb.GetMyOrganizationRequest) (*pb.GetMyOrganizationResponse, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	org, domains, err := h.orgService.GetMyOrganization(ctx, userID)
+	if err != nil {
+		return nil, orgStatus(err, "get")
+	}
+	return &pb.GetMyOrganizationResponse{Organization: toOrganizationPB(org, domains)}, nil
+}
+
+func (h *OrganizationHandler) CreateOrganization(ctx context.Context, req *pb.CreateOrganizationRequest) (*pb.CreateOrganizationResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetName()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	org, err := h.orgService.CreateOrganization(ctx, callerID, req.GetName(), req.GetDomains())
+	if err != nil {
+		return nil, orgStatus(err, "create")
+	}
+	domains := make([]db.OrganizationDomain, 0, len(req.GetDomains()))
+	for _, d := range req.GetDomains() {
+		domains = append(domains, db.OrganizationDomain{Domain: strings.ToLower(strings.TrimSpace(d))})
+	}
+	return &pb.CreateOrganizationResponse{Organization: toOrganizationPB(org, domains)}, nil
+}
+
+func (h *OrganizationHandler) ListOrganizations(ctx context.Context, req *pb.ListOrganizationsRequest) (*pb.ListOrganizationsResponse, error) {
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	orgs, err := h.orgService.ListOrganizations(ctx, callerID, int(req.GetLimit()))
+	if err != nil {
+		return nil, orgStatus(err, "list")
+	}
+	out := make([]*pb.Organization, 0, len(orgs))
+	for i := range orgs {
+		out = append(out, toOrganizationPB(&orgs[i], orgs[i].Domains))
+	}
+	return &pb.ListOrganizationsResponse{Organizations: out}, nil
+}
+
+func (h *OrganizationHandler) AddDomain(ctx context.Context, req *pb.AddDomainRequest) (*pb.AddDomainResponse, error) {
+	if req == nil || req.GetOrgId() == "" || req.GetDomain() == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id and domain are required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	if err := h.orgService.AddDomain(ctx, callerID, req.GetOrgId(), req.GetDomain()); err != nil {
+		return nil, orgStatus(err, "add domain")
+	}
+	return &pb.AddDomainResponse{Success: true}, nil
+}
+
+func (h *OrganizationHandler) RemoveDomain(ctx context.Context, req *pb.RemoveDomainRequest) (*pb.RemoveDomainResponse, error) {
+	if req == nil || req.GetDomain() == "" {
+		return nil, status.Error(codes.InvalidArgument, "domain is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	if err := h.orgService.RemoveDomain(ctx, callerID, req.GetDomain()); err != nil {
+		return nil, orgStatus(err, "remove domain")
+	}
+	return &pb.RemoveDomainResponse{Success: true}, nil
+}
+
+func (h *OrganizationHandler) AssignUser(ctx context.Context, req *pb.AssignUserRequest) (*pb.AssignUserResponse, error) {
+	if req == nil || req.GetUserId() == "" || req.GetOrgId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id and org_id are required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	if err := h.orgService.AssignUser(ctx, callerID, req.GetUserId(), req.GetOrgId()); err != nil {
+		return nil, orgStatus(err, "assign")
+	}
+	return &pb.AssignUserResponse{Success: true}, nil
+}
+
+func (h *OrganizationHandler) CreateSharingAgreement(ctx context.Context, req *pb.CreateSharingAgreementRequest) (*pb.CreateSharingAgreementResponse, error) {
+	if req == nil || req.GetOrgId() == "" || req.GetPartnerOrgId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id and partner_org_id are required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	a, err := h.orgService.CreateSharingAgreement(ctx, callerID, req.GetOrgId(), req.GetPartnerOrgId())
+	if err != nil {
+		return nil, orgStatus(err, "create agreement")
+	}
+	return &pb.CreateSharingAgreementResponse{Agreement: toSharingPB(a)}, nil
+}
+
+func (h *OrganizationHandler) DeleteSharingAgreement(ctx context.Context, req *pb.DeleteSharingAgreementRequest) (*pb.DeleteSharingAgreementResponse, error) {
+	if req == nil || req.GetOrgId() == "" || req.GetPartnerOrgId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id and partner_org_id are required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	if err := h.orgService.DeleteSharingAgreement(ctx, callerID, req.GetOrgId(), req.GetPartnerOrgId()); err != nil {
+		return nil, orgStatus(err, "delete agreement")
+	}
+	return &pb.DeleteSharingAgreementResponse{Success: true}, nil
+}
+
+func (h *OrganizationHandler) ListSharingAgreements(ctx context.Context, _ *pb.ListSharingAgreementsRequest) (*pb.ListSharingAgreementsResponse, error) {
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	list, err := h.orgService.ListSharingAgreements(ctx, callerID)
+	if err != nil {
+		return nil, orgStatus(err, "list")
+	}
+	out := make([]*pb.SharingAgreement, 0, len(list))
+	for i := range list {
+		out = append(out, toSharingPB(&list[i]))
+	}
+	return &pb.ListSharingAgreementsResponse{Agreements: out}, nil
+}
+
+func (h *OrganizationHandler) CreateInvite(ctx context.Context, req *pb.CreateInviteRequest) (*pb.CreateInviteResponse, error) {
+	if req == nil || req.GetOrgId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	inv := &db.Invite{
+		OrgID:   req.GetOrgId(),
+		Email:   req.GetEmail(),
+		MaxUses: int(req.GetMaxUses()),
+	}
+	if req.GetExpiresAt() != nil {
+		inv.ExpiresAt = req.GetExpiresAt().AsTime()
+	}
+	if err := h.orgService.CreateInvite(ctx, callerID, inv); err != nil {
+		return nil, orgStatus(err, "create invite")
+	}
+	return &pb.CreateInviteResponse{Invite: toInvitePB(inv)}, nil
+}
+
+func (h *OrganizationHandler) RevokeInvite(ctx context.Context, req *pb.RevokeInviteRequest) (*pb.RevokeInviteResponse, error) {
+	if req == nil || req.GetInviteId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "invite_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	if err := h.orgService.RevokeInvite(ctx, callerID, req.GetInviteId()); err != nil {
+		return nil, orgStatus(err, "revoke invite")
+	}
+	return &pb.RevokeInviteResponse{Success: true}, nil
+}
+
+func (h *OrganizationHandler) ListInvites(ctx context.Context, req *pb.ListInvitesRequest) (*pb.ListInvitesResponse, error) {
+	if req == nil || req.GetOrgId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	list, err := h.orgService.ListInvites(ctx, callerID, req.GetOrgId(), int(req.GetLimit()))
+	if err != nil {
+		return nil, orgStatus(err, "list invites")
+	}
+	out := make([]*pb.Invite, 0, len(list))
+	for i := range list {
+		out = append(out, toInvitePB(&list[i]))
+	}
+	return &pb.ListInvitesResponse{Invites: out}, nil
+}
+
+func (h *OrganizationHandler) GetFarePolicy(ctx context.Context, req *pb.GetFarePolicyRequest) (*pb.GetFarePolicyResponse, error) {
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	p, err := h.orgService.GetFarePolicy(ctx, callerID, req.GetOrgId())
+	if err != nil {
+		return nil, orgStatus(err, "get fare policy")
+	}
+	return &pb.GetFarePolicyResponse{Policy: toFarePolicyPB(p)}, nil
+}
+
+func (h *OrganizationHandler) SetFarePolicy(ctx context.Context, req *pb.SetFarePolicyRequest) (*pb.SetFarePolicyResponse, error) {
+	if req == nil || req.GetPolicy().GetOrgId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "policy.org_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	p := &db.OrgFarePolicy{
+		OrgID:       req.GetPolicy().GetOrgId(),
+		MinRatio:    req.GetPolicy().GetMinRatio(),
+		MaxRatio:    req.GetPolicy().GetMaxRatio(),
+		MaxCounters: int(req.GetPolicy().GetMaxCounters()),
+	}
+	if err := h.orgService.SetFarePolicy(ctx, callerID, p); err != nil {
+		return nil, orgStatus(err, "set fare policy")
+	}
+	return &pb.SetFarePolicyResponse{Policy: toFarePolicyPB(p)}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"hope/middleware"
 	pb "hope/proto/v1/review"
 	"hope/service"
+	"strings"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -68,6 +69,9 @@ func (h *ReviewHandler) SubmitReview(ctx context.Context, req *pb.SubmitReviewRe
 	}
 
 	if err := h.reviewService.SubmitReview(ctx, r); err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "forbidden") {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Errorf(codes.InvalidArgument, "submit failed: %v", err)
 	}
 	return &pb.SubmitReviewResponse{Review: toReviewPB(r)}, nil
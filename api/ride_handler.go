@@ -6,6 +6,8 @@ import (
 	"hope/middleware"
 	pb "hope/proto/v1/ride"
 	"hope/service"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -31,7 +33,7 @@ func toOfferPB(o *db.RideOffer) *pb.RideOffer {
 	if !o.Time.IsZero() {
 		ts = timestamppb.New(o.Time)
 	}
-	return &pb.RideOffer{
+	out := &pb.RideOffer{
 		Id:       o.ID,
 		DriverId: o.DriverID,
 		FromGeo:  o.FromGeo,
@@ -40,7 +42,29 @@ func toOfferPB(o *db.RideOffer) *pb.RideOffer {
 		Time:     ts,
 		Seats:    int32(o.Seats),
 		Status:   o.Status,
-	}
+		OrgId:    o.OrgID,
+
+		FromPointId: o.FromPointID,
+		ToPointId:   o.ToPointID,
+
+		MaxDetourMinutes: int32(o.MaxDetourSeconds / 60),
+
+		CancelledBy:  o.CancelledBy,
+		CancelReason: o.CancelReason,
+	}
+	if o.AutoAccept != "" {
+		out.AutoAccept = &pb.AutoAccept{
+			Rule:           o.AutoAccept,
+			MinRating:      o.AutoMinRating,
+			MinReliability: o.AutoMinReliability,
+		}
+	}
+	if o.CancelledAt != nil {
+		out.CancelledAt = timestamppb.New(*o.CancelledAt)
+	}
+	out.DepartureWindow = toTimeWindowPB(o.EarliestAt, o.LatestAt, o.ArriveBy)
+	out.PairId, out.Leg = o.PairID, o.Leg
+	return out
 }
 func toRequestPB(r *db.RideRequest) *pb.RideRequest {
 	if r == nil {
@@ -50,7 +74,7 @@ func toRequestPB(r *db.RideRequest) *pb.RideRequest {
 	if !r.Time.IsZero() {
 		ts = timestamppb.New(r.Time)
 	}
-	return &pb.RideRequest{
+	out := &pb.RideRequest{
 		Id:      r.ID,
 		UserId:  r.UserID,
 		FromGeo: r.FromGeo,
@@ -58,11 +82,51 @@ func toRequestPB(r *db.RideRequest) *pb.RideRequest {
 		Time:    ts,
 		Seats:   int32(r.Seats),
 		Status:  r.Status,
+		OrgId:   r.OrgID,
+
+		FromPointId: r.FromPointID,
+		ToPointId:   r.ToPointID,
+	}
+	out.DepartureWindow = toTimeWindowPB(r.EarliestAt, r.LatestAt, r.ArriveBy)
+	out.PairId, out.Leg, out.PairMode = r.PairID, r.Leg, r.PairMode
+	out.Fare = r.Fare
+	return out
+}
+
+// toTimeWindowPB is nil for trips without a window or arrival time
+func toTimeWindowPB(earliest, latest, arriveBy *time.Time) *pb.TimeWindow {
+	if earliest == nil && latest == nil && arriveBy == nil {
+		return nil
+	}
+	return &pb.TimeWindow{
+		Earliest: optionalTimestamp(earliest),
+		Latest:   optionalTimestamp(latest),
+		ArriveBy: optionalTimestamp(arriveBy),
+	}
+}
+
+func timeWindow(w *pb.TimeWindow) service.TimeWindow {
+	return service.TimeWindow{
+		Earliest: optionalTime(w.GetEarliest()),
+		Latest:   optionalTime(w.GetLatest()),
+		ArriveBy: optionalTime(w.GetArriveBy()),
 	}
 }
 
+// searchPrefix snaps a nearby search to a meeting point when one is given
+func (h *RideHandler) searchPrefix(ctx context.Context, callerID, prefix, pointID string) (string, error) {
+	if pointID == "" {
+		return prefix, nil
+	}
+	p, err := h.rideService.MeetingPointPrefix(ctx, callerID, pointID)
+	if err != nil {
+		return "", status.Error(codes.NotFound, err.Error())
+	}
+	return p, nil
+}
+
 func (h *RideHandler) CreateOffer(ctx context.Context, req *pb.CreateOfferRequest) (*pb.CreateOfferResponse, error) {
-	if req == nil || req.GetFromGeo() == "" || req.GetToGeo() == "" || req.GetSeats() <= 0 || req.GetTime() == nil {
+	if req == nil || (req.GetFromGeo() == "" && req.GetFromPointId() == "") || (req.GetToGeo() == "" && req.GetToPointId() == "") || req.GetSeats() <= 0 || req.GetTime() == nil {
 		return nil, status.Error(codes.InvalidArgument, "from_geo, to_geo, time, seats are required")
 	}
 
@@ -77,22 +141,86 @@ func (h *RideHandler) CreateOffer(ctx context.Context, req *pb.CreateOfferReques
 		FromGeo:  req.GetFromGeo(),
 		ToGeo:    req.GetToGeo(),
 		Fare:     req.GetFare(),
+
+		FromPointID: req.GetFromPointId(),
+		ToPointID:   req.GetToPointId(),
+
 		Time:     req.GetTime().AsTime(),
 		Seats:    int(req.GetSeats()),
 		Status:   "active",
+
+		MaxDetourSeconds: int(req.GetMaxDetourMinutes()) * 60,
+
+		AutoAccept:         req.GetAutoAccept().GetRule(),
+		AutoMinRating:      req.GetAutoAccept().GetMinRating(),
+		AutoMinReliability: req.GetAutoAccept().GetMinReliability(),
+
+		EarliestAt: optionalTime(req.GetDepartureWindow().GetEarliest()),
+		LatestAt:   optionalTime(req.GetDepartureWindow().GetLatest()),
+		ArriveBy:   optionalTime(req.GetDepartureWindow().GetArriveBy()),
+	}
+	for _, w := range req.GetWaypoints() {
+		wp := db.Waypoint{Geohash: w.GetGeohash(), PointID: w.GetPointId()}
+		if w.GetPlannedTime() != nil {
+			at := w.GetPlannedTime().AsTime()
+			wp.PlannedAt = &at
+		}
+		offer.Waypoints = append(offer.Waypoints, wp)
 	}
 
-	if err := h.rideService.CreateOffer(ctx, offer); err != nil {
+	var back *db.RideOffer
+	if rt := req.GetReturnTrip(); rt != nil {
+		if rt.GetTime() == nil {
+			return nil, status.Error(codes.InvalidArgument, "return_trip.time is required")
+		}
+		// the way back runs straight from the destination to the origin
+		back = &db.RideOffer{
+			ID:       uuid.New().String(),
+			DriverID: driverID,
+			FromGeo:  offer.ToGeo,
+			ToGeo:    offer.FromGeo,
+			Fare:     offer.Fare,
+
+			FromPointID: offer.ToPointID,
+			ToPointID:   offer.FromPointID,
+
+			Time:   rt.GetTime().AsTime(),
+			Seats:  offer.Seats,
+			Status: "active",
+
+			MaxDetourSeconds: offer.MaxDetourSeconds,
+
+			AutoAccept:         offer.AutoAccept,
+			AutoMinRating:      offer.AutoMinRating,
+			AutoMinReliability: offer.AutoMinReliability,
+
+			EarliestAt: optionalTime(rt.GetDepartureWindow().GetEarliest()),
+			LatestAt:   optionalTime(rt.GetDepartureWindow().GetLatest()),
+			ArriveBy:   optionalTime(rt.GetDepartureWindow().GetArriveBy()),
+		}
+	}
+
+	var err error
+	if back != nil {
+		err = h.rideService.CreateRoundTrip(ctx, offer, back)
+	} else {
+		err = h.rideService.CreateOffer(ctx, offer)
+	}
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid state") {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Errorf(codes.InvalidArgument, "create offer failed: %v", err)
 	}
-	return &pb.CreateOfferResponse{Offer: toOfferPB(offer)}, nil
+	return &pb.CreateOfferResponse{Offer: toOfferPB(offer), ReturnOffer: toOfferPB(back)}, nil
 }
 
 func (h *RideHandler) GetOffer(ctx context.Context, req *pb.GetOfferRequest) (*pb.GetOfferResponse, error) {
 	if req == nil || req.GetId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
-	o, err := h.rideService.GetOfferByID(ctx, req.GetId())
+	callerID, _ := middleware.UserIDFromContext(ctx)
+	o, err := h.rideService.GetOfferByID(ctx, callerID, req.GetId())
 	if err != nil || o == nil || o.ID == "" {
 		return nil, status.Error(codes.NotFound, "offer not found")
 	}
@@ -104,7 +232,7 @@ func (h *RideHandler) UpdateOffer(ctx context.Context, req *pb.UpdateOfferReques
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 	callerID, _ := middleware.UserIDFromContext(ctx)
-	current, err := h.rideService.GetOfferByID(ctx, req.GetId())
+	current, err := h.rideService.GetOfferByID(ctx, callerID, req.GetId())
 	if err != nil || current == nil || current.ID == "" {
 		return nil, status.Error(codes.NotFound, "offer not found")
 	}
@@ -119,18 +247,52 @@ func (h *RideHandler) UpdateOffer(ctx context.Context, req *pb.UpdateOfferReques
 		Status: req.GetStatus(),
 	}
 	if err := h.rideService.UpdateOffer(ctx, upd); err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "invalid state") {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Errorf(codes.InvalidArgument, "update failed: %v", err)
 	}
-	cur, _ := h.rideService.GetOfferByID(ctx, req.GetId())
+	cur, _ := h.rideService.GetOfferByID(ctx, callerID, req.GetId())
 	return &pb.UpdateOfferResponse{Offer: toOfferPB(cur)}, nil
 }
 
+func (h *RideHandler) SetAutoAccept(ctx context.Context, req *pb.SetAutoAcceptRequest) (*pb.SetAutoAcceptResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetOfferId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "offer_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	o, err := h.rideService.SetAutoAccept(ctx, callerID, &db.RideOffer{
+		ID:                 req.GetOfferId(),
+		AutoAccept:         req.GetAutoAccept().GetRule(),
+		AutoMinRating:      req.GetAutoAccept().GetMinRating(),
+		AutoMinReliability: req.GetAutoAccept().GetMinReliability(),
+	})
+	if err != nil {
+		msg := strings.ToLower(err.Error())
+		switch {
+		case strings.Contains(msg, "forbidden"):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case strings.Contains(msg, "not found"):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case strings.Contains(msg, "invalid state"):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "update failed: %v", err)
+		}
+	}
+	return &pb.SetAutoAcceptResponse{Offer: toOfferPB(o)}, nil
+}
+
 func (h *RideHandler) DeleteOffer(ctx context.Context, req *pb.DeleteOfferRequest) (*pb.DeleteOfferResponse, error) {
 	if req == nil || req.GetId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 	callerID, _ := middleware.UserIDFromContext(ctx)
-	cur, _ := h.rideService.GetOfferByID(ctx, req.GetId())
+	cur, _ := h.rideService.GetOfferByID(ctx, callerID, req.GetId())
 	if cur == nil || cur.ID == "" {
 		return nil, status.Error(codes.NotFound, "offer not found")
 	}
@@ -145,11 +307,28 @@ func (h *RideHandler) DeleteOffer(ctx context.Context, req *pb.DeleteOfferReques
 }
 
 func (h *RideHandler) ListNearbyOffers(ctx context.Context, req *pb.ListNearbyOffersRequest) (*pb.ListNearbyOffersResponse, error) {
-	if req == nil || req.GetGeohashPrefix() == "" {
-		return nil, status.Error(codes.InvalidArgument, "geohash_prefix is required")
+	if req == nil || (req.GetGeohashPrefix() == "" && req.GetMeetingPointId() == "" && req.GetRadiusMeters() == 0) {
+		return nil, status.Error(codes.InvalidArgument, "geohash_prefix, meeting_point_id or radius_meters is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	var list []db.RideOffer
+	var err error
+	if req.GetRadiusMeters() != 0 {
+		list, err = h.rideService.ListOffersWithinRadius(ctx, callerID, req.GetLatitude(), req.GetLongitude(), req.GetRadiusMeters(), int(req.GetLimit()), req.GetMinReliability(), timeWindow(req.GetDepartureWindow()))
+	} else {
+		var prefix string
+		if prefix, err = h.searchPrefix(ctx, callerID, req.GetGeohashPrefix(), req.GetMeetingPointId()); err != nil {
+			return nil, err
+		}
+		list, err = h.rideService.ListNearbyOffers(ctx, callerID, prefix, int(req.GetLimit()), req.GetMinReliability(), timeWindow(req.GetDepartureWindow()))
 	}
-	list, err := h.rideService.ListNearbyOffers(ctx, req.GetGeohashPrefix(), int(req.GetLimit()))
 	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "invalid") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "list failed: %v", err)
 	}
 	out := make([]*pb.RideOffer, 0, len(list))
@@ -176,8 +355,40 @@ func (h *RideHandler) ListMyOffers(ctx context.Context, req *pb.ListMyOffersRequ
 	return &pb.ListMyOffersResponse{Offers: out}, nil
 }
 
+func (h *RideHandler) ListOfferStops(ctx context.Context, req *pb.ListOfferStopsRequest) (*pb.ListOfferStopsResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetOfferId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "offer_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	stops, err := h.rideService.ListOfferStops(ctx, callerID, req.GetOfferId())
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "not found") {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "list failed: %v", err)
+	}
+	out := make([]*pb.Stop, 0, len(stops))
+	for _, st := range stops {
+		var ts *timestamppb.Timestamp
+		if !st.PlannedAt.IsZero() {
+			ts = timestamppb.New(st.PlannedAt)
+		}
+		out = append(out, &pb.Stop{
+			Seq:         int32(st.Seq),
+			Geohash:     st.Geohash,
+			PointId:     st.PointID,
+			PlannedTime: ts,
+			SeatsFree:   int32(st.SeatsFree),
+		})
+	}
+	return &pb.ListOfferStopsResponse{Stops: out}, nil
+}
+
 func (h *RideHandler) CreateRequest(ctx context.Context, req *pb.CreateRequestRequest) (*pb.CreateRequestResponse, error) {
-	if req == nil || req.GetFromGeo() == "" || req.GetToGeo() == "" || req.GetSeats() <= 0 || req.GetTime() == nil {
+	if req == nil || (req.GetFromGeo() == "" && req.GetFromPointId() == "") || (req.GetToGeo() == "" && req.GetToPointId() == "") || req.GetSeats() <= 0 || req.GetTime() == nil {
 		return nil, status.Error(codes.InvalidArgument, "from_geo, to_geo, time, seats are required")
 	}
 
@@ -192,24 +403,68 @@ func (h *RideHandler) CreateRequest(ctx context.Context, req *pb.CreateRequestRe
 		FromGeo: req.GetFromGeo(),
 		ToGeo:   req.GetToGeo(),
 		Time:    req.GetTime().AsTime(),
+
+		FromPointID: req.GetFromPointId(),
+		ToPointID:   req.GetToPointId(),
+
+		EarliestAt: optionalTime(req.GetDepartureWindow().GetEarliest()),
+		LatestAt:   optionalTime(req.GetDepartureWindow().GetLatest()),
+		ArriveBy:   optionalTime(req.GetDepartureWindow().GetArriveBy()),
+
 		Seats:   int(req.GetSeats()),
 		Status:  "active",
+		Fare:    req.GetFare(),
 	}
 	if s := req.GetStatus(); s != "" {
 		r.Status = s
 	}
 
-	if err := h.rideService.CreateRequest(ctx, r); err != nil {
+	var back *db.RideRequest
+	if rt := req.GetReturnTrip(); rt != nil {
+		if rt.GetTime() == nil {
+			return nil, status.Error(codes.InvalidArgument, "return_trip.time is required")
+		}
+		back = &db.RideRequest{
+			ID:      uuid.New().String(),
+			UserID:  userID,
+			FromGeo: r.ToGeo,
+			ToGeo:   r.FromGeo,
+			Time:    rt.GetTime().AsTime(),
+
+			FromPointID: r.ToPointID,
+			ToPointID:   r.FromPointID,
+
+			EarliestAt: optionalTime(rt.GetDepartureWindow().GetEarliest()),
+			LatestAt:   optionalTime(rt.GetDepartureWindow().GetLatest()),
+			ArriveBy:   optionalTime(rt.GetDepartureWindow().GetArriveBy()),
+
+			Seats:  r.Seats,
+			Status: r.Status,
+			Fare:   r.Fare,
+		}
+	}
+
+	var err error
+	if back != nil {
+		err = h.rideService.CreateRoundTripRequest(ctx, r, back, req.GetPairMode())
+	} else {
+		err = h.rideService.CreateRequest(ctx, r)
+	}
+	if err != nil {
+		if strings.Contains(err.Error(), "invalid state") {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Errorf(codes.InvalidArgument, "create request failed: %v", err)
 	}
-	return &pb.CreateRequestResponse{Request: toRequestPB(r)}, nil
+	return &pb.CreateRequestResponse{Request: toRequestPB(r), ReturnRequest: toRequestPB(back)}, nil
 }
 
 func (h *RideHandler) GetRequest(ctx context.Context, req *pb.GetRequestRequest) (*pb.GetRequestResponse, error) {
 	if req == nil || req.GetId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
-	r, err := h.rideService.GetRequestByID(ctx, req.GetId())
+	callerID, _ := middleware.UserIDFromContext(ctx)
+	r, err := h.rideService.GetRequestByID(ctx, callerID, req.GetId())
 	if err != nil || r == nil || r.ID == "" {
 		return nil, status.Error(codes.NotFound, "request not found")
 	}
@@ -221,7 +476,7 @@ func (h *RideHandler) UpdateRequestStatus(ctx context.Context, req *pb.UpdateReq
 		return nil, status.Error(codes.InvalidArgument, "id and status are required")
 	}
 	callerID, _ := middleware.UserIDFromContext(ctx)
-	cur, _ := h.rideService.GetRequestByID(ctx, req.GetId())
+	cur, _ := h.rideService.GetRequestByID(ctx, callerID, req.GetId())
 	if cur == nil || cur.ID == "" {
 		return nil, status.Error(codes.NotFound, "request not found")
 	}
@@ -229,10 +484,13 @@ func (h *RideHandler) UpdateRequestStatus(ctx context.Context, req *pb.UpdateReq
 		return nil, status.Error(codes.PermissionDenied, "not your request")
 	}
 
-	if err := h.rideService.UpdateRequestStatus(ctx, req.GetId(), req.GetStatus()); err != nil {
+	if err := h.rideService.UpdateRequestStatus(ctx, callerID, req.GetId(), req.GetStatus()); err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "invalid state") {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Errorf(codes.InvalidArgument, "update status failed: %v", err)
 	}
-	r, _ := h.rideService.GetRequestByID(ctx, req.GetId())
+	r, _ := h.rideService.GetRequestByID(ctx, callerID, req.GetId())
 	return &pb.UpdateRequestStatusResponse{Request: toRequestPB(r)}, nil
 }
 
@@ -241,7 +499,7 @@ func (h *RideHandler) DeleteRequest(ctx context.Context, req *pb.DeleteRequestRe
 		return nil, status.Error(codes.InvalidArgument, "id is required")
 	}
 	callerID, _ := middleware.UserIDFromContext(ctx)
-	cur, _ := h.rideService.GetRequestByID(ctx, req.GetId())
+	cur, _ := h.rideService.GetRequestByID(ctx, callerID, req.GetId())
 	if cur == nil || cur.ID == "" {
 		return nil, status.Error(codes.NotFound, "request not found")
 	}
@@ -256,11 +514,28 @@ func (h *RideHandler) DeleteRequest(ctx context.Context, req *pb.DeleteRequestRe
 }
 
 func (h *RideHandler) ListNearbyRequests(ctx context.Context, req *pb.ListNearbyRequestsRequest) (*pb.ListNearbyRequestsResponse, error) {
-	if req == nil || req.GetGeohashPrefix() == "" {
-		return nil, status.Error(codes.InvalidArgument, "geohash_prefix is required")
+	if req == nil || (req.GetGeohashPrefix() == "" && req.GetMeetingPointId() == "" && req.GetRadiusMeters() == 0) {
+		return nil, status.Error(codes.InvalidArgument, "geohash_prefix, meeting_point_id or radius_meters is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	var list []db.RideRequest
+	var err error
+	if req.GetRadiusMeters() != 0 {
+		list, err = h.rideService.ListRequestsWithinRadius(ctx, callerID, req.GetLatitude(), req.GetLongitude(), req.GetRadiusMeters(), int(req.GetLimit()), req.GetMinReliability(), timeWindow(req.GetDepartureWindow()))
+	} else {
+		var prefix string
+		if prefix, err = h.searchPrefix(ctx, callerID, req.GetGeohashPrefix(), req.GetMeetingPointId()); err != nil {
+			return nil, err
+		}
+		list, err = h.rideService.ListNearbyRequests(ctx, callerID, prefix, int(req.GetLimit()), req.GetMinReliability(), timeWindow(req.GetDepartureWindow()))
 	}
-	list, err := h.rideService.ListNearbyRequests(ctx, req.GetGeohashPrefix(), int(req.GetLimit()))
 	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "invalid") {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "list failed: %v", err)
 	}
 	out := make([]*pb.RideRequest, 0, len(list))
@@ -288,3 +563,61 @@ func (h *RideHandler) ListMyRequests(ctx context.Context, req *pb.ListMyRequests
 
 	return &pb.ListMyRequestsResponse{Requests: out}, nil
 }
+
+func toStatusEventPB(id uint64, c db.StatusChange) *pb.StatusEvent {
+	return &pb.StatusEvent{
+		Id:         id,
+		ActorId:    c.ActorID,
+		FromStatus: c.FromStatus,
+		ToStatus:   c.ToStatus,
+		Reason:     c.Reason,
+		CreatedAt:  timestamppb.New(c.CreatedAt),
+	}
+}
+
+func historyError(err error) error {
+	if strings.Contains(strings.ToLower(err.Error()), "not found") {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Errorf(codes.Internal, "history failed: %v", err)
+}
+
+func (h *RideHandler) GetOfferHistory(ctx context.Context, req *pb.GetOfferHistoryRequest) (*pb.GetOfferHistoryResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetOfferId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "offer_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	events, err := h.rideService.GetOfferHistory(ctx, callerID, req.GetOfferId())
+	if err != nil {
+		return nil, historyError(err)
+	}
+	out := make([]*pb.StatusEvent, 0, len(events))
+	for _, e := range events {
+		out = append(out, toStatusEventPB(e.ID, e.StatusChange))
+	}
+	return &pb.GetOfferHistoryResponse{Events: out}, nil
+}
+
+func (h *RideHandler) GetRequestHistory(ctx context.Context, req *pb.GetRequestHistoryRequest) (*pb.GetRequestHistoryResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetRequestId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "request_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	events, err := h.rideService.GetRequestHistory(ctx, callerID, req.GetRequestId())
+	if err != nil {
+		return nil, historyError(err)
+	}
+	out := make([]*pb.StatusEvent, 0, len(events))
+	for _, e := range events {
+		out = append(out, toStatusEventPB(e.ID, e.StatusChange))
+	}
+	return &pb.GetRequestHistoryResponse{Events: out}, nil
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"hope/middleware"
+	pb "hope/proto/v1/route"
+	"hope/routing"
+	"hope/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RouteHandler struct {
+	routeService service.RouteService
+	pb.UnimplementedRouteServiceServer
+}
+
+func NewRouteHandler(routeService service.RouteService) *RouteHandler {
+	return &RouteHandler{routeService: routeService}
+}
+
+func toRoutePB(r *routing.Route) *pb.Route {
+	if r == nil {
+		return nil
+	}
+	path := make([]*pb.LatLng, 0, len(r.Path))
+	for _, p := range r.Path {
+		path = append(path, &pb.LatLng{Latitude: p.Lat, Longitude: p.Lon})
+	}
+	return &pb.Route{Meters: r.Meters, Seconds: r.Seconds, Path: path}
+}
+
+func routeStatus(err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "not found"):
+		return status.Error(codes.NotFound, err.Error())
+	case strings.Contains(msg, "invalid state"):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case strings.Contains(msg, "invalid"):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "routing failed: %v", err)
+	}
+}
+
+func (h *RouteHandler) GetRoute(ctx context.Context, req *pb.GetRouteRequest) (*pb.GetRouteResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetFromGeo()) == "" || strings.TrimSpace(req.GetToGeo()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "from_geo and to_geo are required")
+	}
+	r, err := h.routeService.GetRoute(ctx, req.GetFromGeo(), req.GetToGeo())
+	if err != nil {
+		return nil, routeStatus(err)
+	}
+	return &pb.GetRouteResponse{Route: toRoutePB(r)}, nil
+}
+
+func (h *RouteHandler) EstimateDetour(ctx context.Context, req *pb.EstimateDetourRequest) (*pb.EstimateDetourResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetOfferId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "offer_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	d, err := h.routeService.EstimateDetour(ctx, callerID, req.GetOfferId(), req.GetPickupGeo(), req.GetDropoffGeo())
+	if err != nil {
+		return nil, routeStatus(err)
+	}
+	return &pb.EstimateDetourResponse{
+		ExtraMeters:  d.ExtraMeters,
+		ExtraSeconds: d.ExtraSeconds,
+		Direct:       toRoutePB(&d.Direct),
+		WithRider:    toRoutePB(&d.WithRider),
+	}, nil
+}
+
+func (h *RouteHandler) EstimateFare(ctx context.Context, req *pb.EstimateFareRequest) (*pb.EstimateFareResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetFromGeo()) == "" || strings.TrimSpace(req.GetToGeo()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "from_geo and to_geo are required")
+	}
+	est, err := h.routeService.EstimateFare(ctx, req.GetFromGeo(), req.GetToGeo())
+	if err != nil {
+		return nil, routeStatus(err)
+	}
+	return &pb.EstimateFareResponse{Fare: est.Fare, Meters: est.Meters, Seconds: est.Seconds}, nil
+}
@@ -0,0 +1,284 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"hope/db"
+	"hope/middleware"
+	pb "hope/proto/v1/trip"
+	"hope/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TripHandler struct {
+	tripService service.TripService
+	pb.UnimplementedTripServiceServer
+}
+
+func NewTripHandler(tripService service.TripService) *TripHandler {
+	return &TripHandler{tripService: tripService}
+}
+
+func toPickupETAPB(eta *service.PickupETA) *pb.PickupETA {
+	if eta == nil {
+		return nil
+	}
+	var ts *timestamppb.Timestamp
+	if !eta.LocationAt.IsZero() {
+		ts = timestamppb.New(eta.LocationAt)
+	}
+	return &pb.PickupETA{
+		Seconds:        eta.Seconds,
+		DistanceMeters: eta.DistanceMeters,
+		SpeedKmh:       eta.SpeedKMH,
+		Observed:       eta.Observed,
+		LocationAt:     ts,
+	}
+}
+
+func toTripEventPB(ev service.TripEvent) *pb.TripLocationEvent {
+	return &pb.TripLocationEvent{
+		UserId:    ev.UserID,
+		Driver:    ev.Driver,
+		Latitude:  ev.Latitude,
+		Longitude: ev.Longitude,
+		Geohash:   ev.Geohash,
+		SpeedMps:  ev.SpeedMPS,
+		At:        timestamppb.New(ev.At),
+		Ended:     ev.Ended,
+		Eta:       toPickupETAPB(ev.ETA),
+	}
+}
+
+func toManifestPB(p *db.TripPlan) *pb.TripManifest {
+	if p == nil {
+		return nil
+	}
+	out := &pb.TripManifest{
+		RideId:        p.RideID,
+		Meters:        p.Meters,
+		Seconds:       int32(p.Seconds),
+		DetourMeters:  p.DetourMeters,
+		DetourSeconds: int32(p.DetourSeconds),
+		LateSeconds:   int32(p.LateSeconds),
+		Optimal:       p.Optimal,
+		UpdatedAt:     timestamppb.New(p.UpdatedAt),
+		Stops:         make([]*pb.ManifestStop, 0, len(p.Stops)),
+	}
+	for _, st := range p.Stops {
+		out.Stops = append(out.Stops, &pb.ManifestStop{
+			Seq:         int32(st.Seq),
+			Kind:        st.Kind,
+			MatchId:     st.MatchID,
+			StopSeq:     int32(st.StopSeq),
+			Geohash:     st.Geohash,
+			ArriveAt:    timestamppb.New(st.Arrive),
+			DepartAt:    timestamppb.New(st.Depart),
+			LateSeconds: int32(st.LateSeconds),
+			LegMeters:   st.LegMeters,
+			LegSeconds:  int32(st.LegSeconds),
+			Onboard:     int32(st.Onboard),
+		})
+	}
+	return out
+}
+
+func toTripPB(t *service.Trip) *pb.Trip {
+	if t == nil {
+		return nil
+	}
+	out := &pb.Trip{SeatsUsed: int32(t.SeatsUsed), SeatsFree: int32(t.SeatsFree)}
+	if o := t.Offer; o != nil {
+		out.Offer = &pb.TripOffer{
+			Id:       o.ID,
+			DriverId: o.DriverID,
+			OrgId:    o.OrgID,
+			FromGeo:  o.FromGeo,
+			ToGeo:    o.ToGeo,
+			Time:     timestamppb.New(o.Time),
+			Seats:    int32(o.Seats),
+			Status:   o.Status,
+			Fare:     o.Fare,
+		}
+	}
+	for _, g := range t.Groups {
+		group := &pb.TripGroup{Status: g.Status}
+		for _, r := range g.Riders {
+			var ts *timestamppb.Timestamp
+			if !r.Match.CreatedAt.IsZero() {
+				ts = timestamppb.New(r.Match.CreatedAt)
+			}
+			group.Riders = append(group.Riders, &pb.TripRider{
+				MatchId:       r.Match.ID,
+				RiderId:       r.Match.RiderID,
+				Name:          r.Name,
+				PhotoUrl:      r.PhotoURL,
+				Rating:        r.Rating,
+				ReviewCount:   int32(r.Reviews),
+				Status:        r.Match.Status,
+				PickupGeo:     r.Match.PickupGeo,
+				DropoffGeo:    r.Match.DropoffGeo,
+				PickupStop:    int32(r.Match.PickupStop),
+				DropoffStop:   int32(r.Match.DropoffStop),
+				Seats:         int32(r.Match.Seats),
+				DetourSeconds: int32(r.Match.DetourSeconds),
+				CreatedAt:     ts,
+			})
+		}
+		out.Groups = append(out.Groups, group)
+	}
+	return out
+}
+
+func tripStatus(err error) error {
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "not found"):
+		return status.Error(codes.NotFound, err.Error())
+	case strings.Contains(msg, "forbidden"):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case strings.Contains(msg, "invalid state"):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case strings.Contains(msg, "invalid"), strings.Contains(msg, "missing"):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "trip failed: %v", err)
+	}
+}
+
+func (h *TripHandler) ShareTripLocation(stream pb.TripService_ShareTripLocationServer) error {
+	ctx := stream.Context()
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if first.GetJoin() == nil || strings.TrimSpace(first.GetJoin().GetMatchId()) == "" {
+		return status.Error(codes.InvalidArgument, "first message must join a match")
+	}
+	sess, err := h.tripService.OpenSession(ctx, callerID, first.GetJoin().GetMatchId())
+	if err != nil {
+		return tripStatus(err)
+	}
+	defer sess.Close()
+
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			u, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			pos := u.GetPosition()
+			if pos == nil {
+				continue
+			}
+			if err := sess.Report(ctx, pos.GetLatitude(), pos.GetLongitude(), pos.GetGeohash()); err != nil {
+				recvErr <- tripStatus(err)
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case ev, ok := <-sess.Events:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(toTripEventPB(ev)); err != nil {
+				return err
+			}
+			if ev.Ended {
+				return nil
+			}
+		case err := <-recvErr:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (h *TripHandler) ExportTrip(ctx context.Context, req *pb.ExportTripRequest) (*pb.ExportTripResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetMatchId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "match_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	exp, err := h.tripService.ExportTrip(ctx, callerID, req.GetMatchId(), req.GetFormat())
+	if err != nil {
+		return nil, tripStatus(err)
+	}
+	return &pb.ExportTripResponse{
+		Filename:    exp.Filename,
+		ContentType: exp.ContentType,
+		Data:        exp.Data,
+	}, nil
+}
+
+func (h *TripHandler) GetPickupETA(ctx context.Context, req *pb.GetPickupETARequest) (*pb.GetPickupETAResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetMatchId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "match_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	eta, err := h.tripService.GetPickupETA(ctx, callerID, req.GetMatchId())
+	if err != nil {
+		return nil, tripStatus(err)
+	}
+	return &pb.GetPickupETAResponse{Eta: toPickupETAPB(eta)}, nil
+}
+
+func (h *TripHandler) GetTripManifest(ctx context.Context, req *pb.GetTripManifestRequest) (*pb.GetTripManifestResponse, error) {
+	if req == nil || strings.TrimSpace(req.GetRideId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "ride_id is required")
+	}
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	trip, err := h.tripService.GetTripManifest(ctx, callerID, req.GetRideId())
+	if err != nil {
+		return nil, tripStatus(err)
+	}
+	return &pb.GetTripManifestResponse{Manifest: toManifestPB(trip.Plan), Trip: toTripPB(trip)}, nil
+}
+
+func (h *TripHandler) ListMyTrips(ctx context.Context, req *pb.ListMyTripsRequest) (*pb.ListMyTripsResponse, error) {
+	callerID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || callerID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	trips, err := h.tripService.ListMyTrips(ctx, callerID, int(req.GetLimit()))
+	if err != nil {
+		return nil, tripStatus(err)
+	}
+	out := make([]*pb.Trip, 0, len(trips))
+	for i := range trips {
+		out = append(out, toTripPB(&trips[i]))
+	}
+	return &pb.ListMyTripsResponse{Trips: out}, nil
+}
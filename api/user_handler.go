@@ -6,9 +6,11 @@ import (
 	"hope/middleware"
 	pb "hope/proto/v1/user"
 	"hope/service"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type UserHandler struct {
@@ -32,9 +34,33 @@ func toUserPB(u *db.User) *pb.User {
 		PhotoUrl: u.PhotoURL,
 		Geohash:  u.Geohash,
 		LastSeen: u.LastSeen.Unix(),
+		OrgId:    u.OrgID,
+		Role:     u.Role,
 	}
 }
 
+// withReliability adds each user's reliability score to their profile
+func (h *UserHandler) withReliability(ctx context.Context, users ...*pb.User) error {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.Id)
+	}
+	scores, err := h.userService.Reliability(ctx, ids)
+	if err != nil {
+		return status.Errorf(codes.Internal, "reliability failed: %v", err)
+	}
+	for _, u := range users {
+		r := scores[u.Id]
+		u.Reliability = &pb.Reliability{
+			Score:       r.Score,
+			Completed:   int32(r.Completed),
+			LateCancels: int32(r.LateCancels),
+			NoShows:     int32(r.NoShows),
+		}
+	}
+	return nil
+}
+
 func (h *UserHandler) GetMe(ctx context.Context, _ *pb.GetMeRequest) (*pb.GetMeResponse, error) {
 	userID, ok := middleware.UserIDFromContext(ctx)
 	if !ok || userID == "" {
@@ -46,8 +72,12 @@ func (h *UserHandler) GetMe(ctx context.Context, _ *pb.GetMeRequest) (*pb.GetMeR
 		return nil, status.Error(codes.NotFound, "user not found")
 	}
 
+	out := toUserPB(u)
+	if err := h.withReliability(ctx, out); err != nil {
+		return nil, err
+	}
 	return &pb.GetMeResponse{
-		User: toUserPB(u),
+		User: out,
 	}, nil
 }
 
@@ -61,7 +91,11 @@ func (h *UserHandler) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.
 		return nil, status.Error(codes.NotFound, "user not found")
 	}
 
-	return &pb.GetUserResponse{User: toUserPB(u)}, nil
+	out := toUserPB(u)
+	if err := h.withReliability(ctx, out); err != nil {
+		return nil, err
+	}
+	return &pb.GetUserResponse{User: out}, nil
 }
 
 func (h *UserHandler) UpdateMe(ctx context.Context, req *pb.UpdateMeRequest) (*pb.UpdateMeResponse, error) {
@@ -111,6 +145,128 @@ func (h *UserHandler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (
 			out = append(out, toUserPB(u))
 		}
 	}
+	if err := h.withReliability(ctx, out...); err != nil {
+		return nil, err
+	}
 
 	return &pb.ListUsersResponse{Users: out}, nil
 }
+
+func (h *UserHandler) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	if req == nil || req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	if err := h.userService.BlockUser(ctx, userID, req.GetUserId(), req.GetMute()); err != nil {
+		msg := strings.ToLower(err.Error())
+		switch {
+		case strings.Contains(msg, "not found"):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case strings.Contains(msg, "yourself"), strings.Contains(msg, "missing"):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "block failed: %v", err)
+		}
+	}
+	return &pb.BlockUserResponse{Success: true}, nil
+}
+
+func (h *UserHandler) UnblockUser(ctx context.Context, req *pb.UnblockUserRequest) (*pb.UnblockUserResponse, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	if req == nil || req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	if err := h.userService.UnblockUser(ctx, userID, req.GetUserId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "unblock failed: %v", err)
+	}
+	return &pb.UnblockUserResponse{Success: true}, nil
+}
+
+func (h *UserHandler) ListBlocked(ctx context.Context, req *pb.ListBlockedRequest) (*pb.ListBlockedResponse, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	blocks, err := h.userService.ListBlocked(ctx, userID, int(req.GetLimit()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list failed: %v", err)
+	}
+
+	out := make([]*pb.BlockedUser, 0, len(blocks))
+	for _, b := range blocks {
+		out = append(out, &pb.BlockedUser{
+			UserId:    b.BlockedID,
+			Kind:      b.Kind,
+			CreatedAt: timestamppb.New(b.CreatedAt),
+		})
+	}
+	return &pb.ListBlockedResponse{Users: out}, nil
+}
+
+func (h *UserHandler) AddFavorite(ctx context.Context, req *pb.AddFavoriteRequest) (*pb.AddFavoriteResponse, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	if req == nil || req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	if err := h.userService.AddFavorite(ctx, userID, req.GetUserId()); err != nil {
+		msg := strings.ToLower(err.Error())
+		switch {
+		case strings.Contains(msg, "not found"):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case strings.Contains(msg, "yourself"), strings.Contains(msg, "missing"):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			return nil, status.Errorf(codes.Internal, "favorite failed: %v", err)
+		}
+	}
+	return &pb.AddFavoriteResponse{Success: true}, nil
+}
+
+func (h *UserHandler) RemoveFavorite(ctx context.Context, req *pb.RemoveFavoriteRequest) (*pb.RemoveFavoriteResponse, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+	if req == nil || req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	if err := h.userService.RemoveFavorite(ctx, userID, req.GetUserId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "unfavorite failed: %v", err)
+	}
+	return &pb.RemoveFavoriteResponse{Success: true}, nil
+}
+
+func (h *UserHandler) ListFavorites(ctx context.Context, req *pb.ListFavoritesRequest) (*pb.ListFavoritesResponse, error) {
+	userID, ok := middleware.UserIDFromContext(ctx)
+	if !ok || userID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing auth")
+	}
+
+	favs, err := h.userService.ListFavorites(ctx, userID, int(req.GetLimit()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "list failed: %v", err)
+	}
+
+	out := make([]*pb.FavoriteUser, 0, len(favs))
+	for _, f := range favs {
+		out = append(out, &pb.FavoriteUser{
+			UserId:    f.FavoriteID,
+			CreatedAt: timestamppb.New(f.CreatedAt),
+		})
+	}
+	return &pb.ListFavoritesResponse{Users: out}, nil
+}
@@ -1,8 +1,13 @@
 package config
 
 import (
+	"fmt"
+	"log"
+	"math"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // to get the map of allowed domains, key is string and value type is empty struct
@@ -28,6 +33,21 @@ func GetAllowedDomains() map[string]struct{} {
 	return allowed
 }
 
+// AdminEmails is its own type so wire can tell it apart from the domain map
+type AdminEmails map[string]struct{}
+
+// ADMIN_EMAILS is a comma separated list of emails promoted to admin on login
+func GetAdminEmails() AdminEmails {
+	admins := make(AdminEmails)
+	for _, e := range strings.Split(os.Getenv("ADMIN_EMAILS"), ",") {
+		e = strings.ToLower(strings.TrimSpace(e))
+		if e != "" {
+			admins[e] = struct{}{}
+		}
+	}
+	return admins
+}
+
 func GetJWTSecret() []byte {
 	jwtSecret := os.Getenv("JWT_SECRET")
 	return []byte(jwtSecret)
@@ -36,3 +56,339 @@ func GetJWTSecret() []byte {
 func ProvideGoogleClientID() string {
 	return os.Getenv("GOOGLE_CLIENT_ID")
 }
+
+// default deadline for unary calls that arrive without one, GRPC_REQUEST_TIMEOUT
+// takes a go duration like "15s"
+func GetRequestTimeout() time.Duration {
+	return getDuration("GRPC_REQUEST_TIMEOUT", 15*time.Second)
+}
+
+// upper bound on how long a stream may stay open, GRPC_STREAM_TIMEOUT, 0 disables it
+func GetStreamTimeout() time.Duration {
+	return getDuration("GRPC_STREAM_TIMEOUT", 2*time.Hour)
+}
+
+// TrackRetention is how long trip points are kept (TRIP_TRACK_RETENTION,
+// 0 keeps them forever) and how often the purge runs (TRIP_TRACK_PURGE_INTERVAL)
+type TrackRetention struct {
+	MaxAge   time.Duration
+	Interval time.Duration
+}
+
+func GetTrackRetention() TrackRetention {
+	return TrackRetention{
+		MaxAge:   getDuration("TRIP_TRACK_RETENTION", 90*24*time.Hour),
+		Interval: getDuration("TRIP_TRACK_PURGE_INTERVAL", time.Hour),
+	}
+}
+
+// SpeedModel drives pickup ETAs. Observed speed (from the driver's recent
+// trip points over Window) is clamped to MinKMH..MaxKMH, DefaultKMH is used
+// when there isn't enough history, and RoadFactor stretches the straight
+// line distance to roughly what the roads add
+type SpeedModel struct {
+	DefaultKMH float64
+	MinKMH     float64
+	MaxKMH     float64
+	RoadFactor float64
+	Window     time.Duration
+}
+
+func GetSpeedModel() SpeedModel {
+	return SpeedModel{
+		DefaultKMH: getFloat("ETA_DEFAULT_SPEED_KMH", 30),
+		MinKMH:     getFloat("ETA_MIN_SPEED_KMH", 8),
+		MaxKMH:     getFloat("ETA_MAX_SPEED_KMH", 90),
+		RoadFactor: getFloat("ETA_ROAD_FACTOR", 1.3),
+		Window:     getDuration("ETA_SPEED_WINDOW", 5*time.Minute),
+	}
+}
+
+// Routing points at the OpenStreetMap extract (ROUTING_OSM_FILE, .osm,
+// .osm.gz or .osm.bz2) routes are computed on. Without one, routes are
+// estimated from straight lines using the speed model
+type Routing struct {
+	OSMFile string
+}
+
+func GetRouting() Routing {
+	return Routing{OSMFile: strings.TrimSpace(os.Getenv("ROUTING_OSM_FILE"))}
+}
+
+// FareModel prices a route for fare estimates: a flat FARE_BASE plus
+// FARE_PER_KM and FARE_PER_MINUTE of driving
+type FareModel struct {
+	Base      float64
+	PerKM     float64
+	PerMinute float64
+}
+
+func GetFareModel() FareModel {
+	return FareModel{
+		Base:      getNonNegFloat("FARE_BASE", 20),
+		PerKM:     getFloat("FARE_PER_KM", 8),
+		PerMinute: getFloat("FARE_PER_MINUTE", 1),
+	}
+}
+
+// FarePolicy bounds fare negotiation for orgs that did not set their own:
+// a fare proposed on a ride has to lie between MinRatio and MaxRatio times
+// the offer's listed fare, or the fare model's estimate of the rider's
+// trip when the offer lists none, and can be countered at most
+// MaxCounters times per match. MinRatio 0 sets no floor and MaxCounters 0
+// allows no counters
+type FarePolicy struct {
+	MinRatio    float64
+	MaxRatio    float64
+	MaxCounters int
+}
+
+func GetFarePolicy() FarePolicy {
+	return FarePolicy{
+		MinRatio:    getNonNegFloat("FARE_MIN_RATIO", 0.5),
+		MaxRatio:    getFloat("FARE_MAX_RATIO", 1.5),
+		MaxCounters: getNonNegInt("FARE_MAX_COUNTERS", 4),
+	}
+}
+
+// TripPlanning tunes how pickups and dropoffs are ordered. A driver may
+// reach a stop with a planned time up to Slack late before it counts
+// against the plan
+type TripPlanning struct {
+	Slack time.Duration
+}
+
+func GetTripPlanning() TripPlanning {
+	return TripPlanning{Slack: getDuration("TRIP_PLAN_SLACK", 10*time.Minute)}
+}
+
+// CancellationPolicy decides what backing out of a ride costs. Cancelling
+// an accepted match less than Grace before the rider's pickup is a late
+// cancel. The reliability score weighs each late cancel as LatePenalty and
+// each no-show as NoShowPenalty trips gone wrong, 0 disables a penalty
+type CancellationPolicy struct {
+	Grace         time.Duration
+	LatePenalty   float64
+	NoShowPenalty float64
+}
+
+func GetCancellationPolicy() CancellationPolicy {
+	return CancellationPolicy{
+		Grace:         getDuration("CANCEL_GRACE", 2*time.Hour),
+		LatePenalty:   getNonNegFloat("CANCEL_LATE_PENALTY", 1),
+		NoShowPenalty: getNonNegFloat("NO_SHOW_PENALTY", 3),
+	}
+}
+
+// CheckIn configures pickup check-in. The PIN a rider shows the driver
+// stops working PinTTL after it was issued
+type CheckIn struct {
+	PinTTL time.Duration
+}
+
+func GetCheckIn() CheckIn {
+	return CheckIn{PinTTL: getDuration("CHECKIN_PIN_TTL", 5*time.Minute)}
+}
+
+// Booking configures double-booking checks. A trip keeps its people busy
+// from departure for as long as its route takes to drive, or
+// DefaultDuration when the route can't be estimated, plus Buffer to get
+// to whatever comes next
+type Booking struct {
+	Buffer          time.Duration
+	DefaultDuration time.Duration
+}
+
+func GetBooking() Booking {
+	return Booking{
+		Buffer:          getDuration("BOOKING_BUFFER", 15*time.Minute),
+		DefaultDuration: getDuration("BOOKING_DEFAULT_DURATION", time.Hour),
+	}
+}
+
+// Waitlist configures waitlists on full offers. A waitlisted rider
+// promoted to a free seat has ConfirmWindow to confirm it (never past
+// departure) before it goes to the next in line. Lapsed holds are swept
+// every SweepInterval, 0 leaves them until the offer next changes
+type Waitlist struct {
+	ConfirmWindow time.Duration
+	SweepInterval time.Duration
+}
+
+func GetWaitlist() Waitlist {
+	return Waitlist{
+		ConfirmWindow: getDuration("WAITLIST_CONFIRM_WINDOW", 30*time.Minute),
+		SweepInterval: getDuration("WAITLIST_SWEEP_INTERVAL", time.Minute),
+	}
+}
+
+// NearbyIndex configures the in-memory index behind nearby searches.
+// NEARBY_INDEX=off serves everything from the database, which is what a
+// deployment running more than one instance needs since the index only
+// sees writes made by its own process. Locations not reported within
+// NEARBY_LOCATION_TTL are left out of nearby results, 0 keeps them all
+type NearbyIndex struct {
+	Enabled     bool
+	LocationTTL time.Duration
+}
+
+func GetNearbyIndex() NearbyIndex {
+	return NearbyIndex{
+		Enabled:     !strings.EqualFold(strings.TrimSpace(os.Getenv("NEARBY_INDEX")), "off"),
+		LocationTTL: getDuration("NEARBY_LOCATION_TTL", 30*time.Minute),
+	}
+}
+
+// reads a positive float from env, falling back to def when unset or unparsable
+func getFloat(key string, def float64) float64 {
+	v := strings.TrimSpace(os.Getenv(key))
+	if v == "" {
+		return def
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f <= 0 {
+		return def
+	}
+	return f
+}
+
+// reads a float from env like getFloat but takes 0 as a setting, for values
+// where 0 turns something off
+func getNonNegFloat(key string, def float64) float64 {
+	v := strings.TrimSpace(os.Getenv(key))
+	if v == "" {
+		return def
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < 0 {
+		return def
+	}
+	return f
+}
+
+// reads a non-negative int from env, falling back to def when unset or
+// unparsable
+func getNonNegInt(key string, def int) int {
+	v := strings.TrimSpace(os.Getenv(key))
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return def
+	}
+	return n
+}
+
+// reads a duration from env, falling back to def when unset or unparsable
+func getDuration(key string, def time.Duration) time.Duration {
+	v := strings.TrimSpace(os.Getenv(key))
+	if v == "" {
+		return def
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return def
+	}
+	return d
+}
+
+// methods that are worth abusing get tighter limits out of the box,
+// RATE_LIMIT_METHODS overrides or extends these
+var defaultMethodLimits = map[string]string{
+	"/proto.v1.AuthService/Login":          "10/m:5",
+	"/proto.v1.MatchService/RequestToJoin": "20/m:5",
+	"/proto.v1.ChatService/SendMessage":    "30/m:10",
+}
+
+// Limit is a token bucket: Rate tokens are refilled per second up to Burst
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// ParseLimit reads "<n>/<unit>[:burst]" where unit is s, m or h,
+// e.g. "5/m:3" allows bursts of 3 and refills 5 tokens per minute.
+// Without an explicit burst the bucket holds one unit worth of tokens
+func ParseLimit(s string) (Limit, error) {
+	s = strings.TrimSpace(s)
+	rateStr, burstStr, hasBurst := strings.Cut(s, ":")
+	nStr, unit, ok := strings.Cut(rateStr, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid rate limit %q", s)
+	}
+	n, err := strconv.ParseFloat(strings.TrimSpace(nStr), 64)
+	if err != nil || n <= 0 {
+		return Limit{}, fmt.Errorf("invalid rate limit %q", s)
+	}
+	var per time.Duration
+	switch strings.TrimSpace(unit) {
+	case "s":
+		per = time.Second
+	case "m":
+		per = time.Minute
+	case "h":
+		per = time.Hour
+	default:
+		return Limit{}, fmt.Errorf("invalid rate limit unit in %q", s)
+	}
+	l := Limit{Rate: n / per.Seconds(), Burst: int(math.Ceil(n))}
+	if hasBurst {
+		b, err := strconv.Atoi(strings.TrimSpace(burstStr))
+		if err != nil || b <= 0 {
+			return Limit{}, fmt.Errorf("invalid burst in %q", s)
+		}
+		l.Burst = b
+	}
+	return l, nil
+}
+
+// RateLimit holds the default limit plus per-method overrides keyed by full
+// method name, a zero Limit means unlimited
+type RateLimit struct {
+	Default Limit
+	Methods map[string]Limit
+}
+
+// GetRateLimit builds the per-user / per-method limits.
+// RATE_LIMIT_DEFAULT applies to every method, e.g. "20/s:40".
+// RATE_LIMIT_METHODS is a comma separated list of method=limit,
+// e.g. "/proto.v1.ChatService/SendMessage=60/m:10".
+// RATE_LIMIT_DISABLED=true turns throttling off
+func GetRateLimit() *RateLimit {
+	if strings.EqualFold(strings.TrimSpace(os.Getenv("RATE_LIMIT_DISABLED")), "true") {
+		return nil
+	}
+	cfg := &RateLimit{
+		Default: Limit{Rate: 20, Burst: 40},
+		Methods: make(map[string]Limit),
+	}
+	if v := strings.TrimSpace(os.Getenv("RATE_LIMIT_DEFAULT")); v != "" {
+		if l, err := ParseLimit(v); err == nil {
+			cfg.Default = l
+		} else {
+			log.Printf("ignoring RATE_LIMIT_DEFAULT: %v", err)
+		}
+	}
+
+	specs := make(map[string]string, len(defaultMethodLimits))
+	for m, l := range defaultMethodLimits {
+		specs[m] = l
+	}
+	for _, entry := range strings.Split(os.Getenv("RATE_LIMIT_METHODS"), ",") {
+		method, limit, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			continue
+		}
+		specs[strings.TrimSpace(method)] = limit
+	}
+	for method, spec := range specs {
+		l, err := ParseLimit(spec)
+		if err != nil {
+			log.Printf("ignoring rate limit for %s: %v", method, err)
+			continue
+		}
+		cfg.Methods[method] = l
+	}
+	return cfg
+}
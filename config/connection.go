@@ -46,13 +46,32 @@ func InitDatabase(config DatabaseConfig) (*gorm.DB, error) {
 	}
 
 	if err := database.AutoMigrate(
+		&db.Organization{},
+		&db.OrganizationDomain{},
+		&db.OrgSharingAgreement{},
+		&db.OrgFarePolicy{},
+		&db.Invite{},
 		&db.User{},
 		&db.RideOffer{},
+		&db.Waypoint{},
+		&db.TripPlan{},
+		&db.TripPlanStop{},
 		&db.RideRequest{},
 		&db.Match{},
+		&db.MatchEvent{},
+		&db.FareProposal{},
+		&db.OfferEvent{},
+		&db.RequestEvent{},
 		&db.ChatMessage{},
 		&db.Review{},
 		&db.UserLocation{},
+		&db.UserBlock{},
+		&db.UserFavorite{},
+		&db.LocationSetting{},
+		&db.LocationView{},
+		&db.TripPoint{},
+		&db.ServiceZone{},
+		&db.MeetingPoint{},
 	); err != nil {
 		return nil, fmt.Errorf("failed to migrate database: %w", err)
 	}
@@ -8,6 +8,9 @@ type ChatMessage struct {
 	SenderID  string    `gorm:"size:191"`
 	Content   string    `gorm:"type:text"`
 	Timestamp time.Time `gorm:"index"`
+	// posted by the server about the ride, e.g. a cancellation. SenderID
+	// is the user whose action it reports
+	System bool
 
 	Ride   *RideOffer `gorm:"foreignKey:RideID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Sender *User      `gorm:"foreignKey:SenderID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
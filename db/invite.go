@@ -0,0 +1,46 @@
+package db
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Invite admits a specific external user (e.g. a contractor on gmail) into an
+// org without opening up their whole domain. An invite bound to an email is
+// applied automatically at login, otherwise the client sends the code
+type Invite struct {
+	ID        string `gorm:"primaryKey;size:191"`
+	Code      string `gorm:"uniqueIndex;size:64"`
+	Email     string `gorm:"size:191;index"`
+	OrgID     string `gorm:"size:191;index"`
+	CreatedBy string `gorm:"size:191"`
+	MaxUses   int    // 0 means unlimited
+	Uses      int
+	ExpiresAt time.Time `gorm:"index"`
+	RevokedAt *time.Time
+	CreatedAt time.Time `gorm:"index"`
+
+	Org *Organization `gorm:"foreignKey:OrgID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (i *Invite) BeforeSave(tx *gorm.DB) (err error) {
+	i.Email = strings.ToLower(strings.TrimSpace(i.Email))
+	i.Code = strings.TrimSpace(i.Code)
+	if i.CreatedAt.IsZero() {
+		i.CreatedAt = time.Now()
+	}
+	return nil
+}
+
+// Usable reports whether the invite can still admit someone at time now
+func (i *Invite) Usable(now time.Time) bool {
+	if i.RevokedAt != nil {
+		return false
+	}
+	if !i.ExpiresAt.IsZero() && now.After(i.ExpiresAt) {
+		return false
+	}
+	return i.MaxUses == 0 || i.Uses < i.MaxUses
+}
@@ -0,0 +1,26 @@
+package db
+
+import "time"
+
+// LocationSetting is who may see a user's live location. Users without a row
+// get the "org" default.
+type LocationSetting struct {
+	UserID     string `gorm:"primaryKey;size:191"`
+	Visibility string `gorm:"size:16"` // nobody, matched, org, everyone
+	UpdatedAt  time.Time
+
+	User *User `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// LocationView is one audit entry: ViewerID was shown OwnerID's location,
+// Precise says whether it was the exact point or the coarsened one
+type LocationView struct {
+	ID       string `gorm:"primaryKey;size:191"`
+	OwnerID  string `gorm:"size:191;index:idx_location_view_owner,priority:1"`
+	ViewerID string `gorm:"size:191;index"`
+	Precise  bool
+	ViewedAt time.Time `gorm:"index:idx_location_view_owner,priority:2"`
+
+	Owner  *User `gorm:"foreignKey:OwnerID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Viewer *User `gorm:"foreignKey:ViewerID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
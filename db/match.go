@@ -12,14 +12,84 @@ type Match struct {
 	RiderID   string    `gorm:"size:191;index"      json:"rider_id"`
 	DriverID  string    `gorm:"size:191;index"      json:"driver_id"`
 	RideID    string    `gorm:"size:191;index"      json:"ride_id"`
+	OrgID     string    `gorm:"size:191;index"      json:"org_id"`
+	PickupGeo string    `gorm:"size:64"             json:"pickup_geo"` // where the driver collects the rider
 	Status    string    `gorm:"size:32;index"       json:"status"`
 	CreatedAt time.Time `gorm:"index"               json:"created_at"`
 
+	// where the rider gets off, and what the pickup and dropoff add to the
+	// driver's route (zero when it could not be routed)
+	DropoffGeo    string  `gorm:"size:64" json:"dropoff_geo"`
+	DetourSeconds int     `json:"detour_seconds"`
+	DetourMeters  float64 `json:"detour_meters"`
+	// stops of the offer the rider boards and leaves at, both 0 on matches
+	// that ride the whole route
+	PickupStop  int `json:"pickup_stop"`
+	DropoffStop int `json:"dropoff_stop"`
+	Seats       int `json:"seats"`
+	// who withdrew or cancelled the match, when and why
+	CancelledBy  string     `gorm:"size:191" json:"cancelled_by"`
+	CancelledAt  *time.Time `json:"cancelled_at"`
+	CancelReason string     `gorm:"size:500" json:"cancel_reason"`
+	// cancelled inside the policy's grace period before departure, counts
+	// against CancelledBy
+	LateCancel bool `gorm:"index" json:"late_cancel"`
+	// the side that did not turn up, reported by the other one
+	NoShowUserID     string     `gorm:"size:191;index" json:"no_show_user_id"`
+	NoShowReportedAt *time.Time `json:"no_show_reported_at"`
+	// pickup check-in: the rider's current PIN (hashed), when it lapses
+	// and the wrong guesses made at it
+	PinHash     string     `gorm:"size:64" json:"-"`
+	PinExpires  *time.Time `json:"-"`
+	PinAttempts int        `json:"-"`
+	// when the driver checked the rider in and when the trip was completed
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	// the offer's auto-accept rule when the rider asked to join, whether
+	// it let them in and why
+	AcceptRule   string `gorm:"size:16"  json:"accept_rule"`
+	AutoAccepted bool   `json:"auto_accepted"`
+	AcceptNote   string `gorm:"size:191" json:"accept_note"`
+	// a waitlisted rider promoted to a free seat holds it until ConfirmBy
+	ConfirmBy *time.Time `gorm:"index" json:"confirm_by"`
+	// the rider's pickup window and when they have to be dropped off, nil
+	// leaves that side open
+	EarliestPickup *time.Time `json:"earliest_pickup"`
+	LatestPickup   *time.Time `json:"latest_pickup"`
+	ArriveBy       *time.Time `json:"arrive_by"`
+	// the pickup time proposed with the request, agreed once accepted, and
+	// the departure of the offer it assumes
+	PickupAt *time.Time `json:"pickup_at"`
+	DepartAt *time.Time `json:"depart_at"`
+	// the two legs of a rider's round trip share a PairID. With PairMode
+	// together, one leg falling through takes the other with it
+	PairID   string `gorm:"size:191;index" json:"pair_id"`
+	Leg      string `gorm:"size:16"        json:"leg"`
+	PairMode string `gorm:"size:16"        json:"pair_mode"`
+	// the fare per rider on the table and who proposed it, empty for the
+	// offer's listed fare; agreed once the match is accepted. FareCounters
+	// is how often it was countered
+	Fare         float64 `json:"fare"`
+	FareBy       string  `gorm:"size:191" json:"fare_by"`
+	FareCounters int     `json:"fare_counters"`
+
 	Rider  *User      `gorm:"foreignKey:RiderID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL" json:"-"`
 	Driver *User      `gorm:"foreignKey:DriverID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL" json:"-"`
 	Ride   *RideOffer `gorm:"foreignKey:RideID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"   json:"-"`
 }
 
+// FareProposal is one step of a match's fare negotiation: a fare the
+// rider or the driver put on the table, and why. Like status history it
+// is only appended to and outlives the match
+type FareProposal struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement" json:"id"`
+	MatchID   string    `gorm:"size:191;index"           json:"match_id"`
+	ActorID   string    `gorm:"size:191"                 json:"actor_id"`
+	Fare      float64   `json:"fare"`
+	Note      string    `gorm:"size:500"                 json:"note"`
+	CreatedAt time.Time `gorm:"index"                    json:"created_at"`
+}
+
 func (m *Match) BeforeCreate(tx *gorm.DB) (err error) {
 
 	if strings.TrimSpace(m.Status) == "" {
@@ -29,6 +99,9 @@ func (m *Match) BeforeCreate(tx *gorm.DB) (err error) {
 	if m.CreatedAt.IsZero() {
 		m.CreatedAt = time.Now()
 	}
+	if m.Seats <= 0 {
+		m.Seats = 1
+	}
 	return nil
 }
 
@@ -37,5 +110,7 @@ func (m *Match) BeforeSave(tx *gorm.DB) (err error) {
 	m.DriverID = strings.TrimSpace(m.DriverID)
 	m.RideID = strings.TrimSpace(m.RideID)
 	m.Status = strings.TrimSpace(m.Status)
+	m.PickupGeo = strings.TrimSpace(m.PickupGeo)
+	m.DropoffGeo = strings.TrimSpace(m.DropoffGeo)
 	return nil
 }
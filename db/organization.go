@@ -0,0 +1,71 @@
+package db
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Organization is a tenant, users of one org only see rides, matches and
+// locations of their own org and of orgs it has a sharing agreement with
+type Organization struct {
+	ID        string    `gorm:"primaryKey;size:191"`
+	Name      string    `gorm:"size:191"`
+	CreatedAt time.Time `gorm:"index"`
+
+	Domains []OrganizationDomain `gorm:"foreignKey:OrgID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// OrganizationDomain maps an email domain to the org that owns it,
+// a domain belongs to at most one org
+type OrganizationDomain struct {
+	Domain    string    `gorm:"primaryKey;size:191"`
+	OrgID     string    `gorm:"size:191;index"`
+	CreatedAt time.Time `gorm:"index"`
+
+	Org *Organization `gorm:"foreignKey:OrgID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// OrgSharingAgreement lets two orgs see each other's rides, it is symmetric
+type OrgSharingAgreement struct {
+	ID           string    `gorm:"primaryKey;size:191"`
+	OrgID        string    `gorm:"size:191;index"`
+	PartnerOrgID string    `gorm:"size:191;index"`
+	CreatedBy    string    `gorm:"size:191"`
+	CreatedAt    time.Time `gorm:"index"`
+
+	Org        *Organization `gorm:"foreignKey:OrgID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	PartnerOrg *Organization `gorm:"foreignKey:PartnerOrgID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// OrgFarePolicy bounds the fares riders and drivers of an org's rides
+// can propose: between MinRatio and MaxRatio times the listed fare, with
+// at most MaxCounters counters per match. Orgs without one use the
+// configured defaults
+type OrgFarePolicy struct {
+	OrgID       string `gorm:"primaryKey;size:191"`
+	MinRatio    float64
+	MaxRatio    float64
+	MaxCounters int
+	UpdatedBy   string `gorm:"size:191"`
+	UpdatedAt   time.Time
+
+	Org *Organization `gorm:"foreignKey:OrgID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+func (o *Organization) BeforeCreate(tx *gorm.DB) (err error) {
+	o.Name = strings.TrimSpace(o.Name)
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = time.Now()
+	}
+	return nil
+}
+
+func (d *OrganizationDomain) BeforeSave(tx *gorm.DB) (err error) {
+	d.Domain = strings.ToLower(strings.TrimSpace(d.Domain))
+	if d.CreatedAt.IsZero() {
+		d.CreatedAt = time.Now()
+	}
+	return nil
+}
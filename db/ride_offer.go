@@ -10,15 +10,41 @@ import (
 type RideOffer struct {
 	ID       string `gorm:"primaryKey;size:191"`
 	DriverID string `gorm:"size:191;index"`
+	OrgID    string `gorm:"size:191;index"`
 	FromGeo  string `gorm:"size:64;index"`
 	ToGeo    string `gorm:"size:64;index"`
-	Fare     float64
-	Time     time.Time `gorm:"index"`
-	Seats    int
-	Status   string `gorm:"size:32;index"` // active, matched, completed
+	// set when from/to came from the meeting point catalog
+	FromPointID string `gorm:"size:191"`
+	ToPointID   string `gorm:"size:191"`
+	Fare        float64
+	Time        time.Time `gorm:"index"`
+	// how far the driver lets departure move off Time to suit riders, and
+	// when they have to be at the destination; nil leaves that side open
+	EarliestAt *time.Time
+	LatestAt   *time.Time
+	ArriveBy   *time.Time
+	Seats      int
+	Status     string `gorm:"size:32;index"` // active, matched, completed, cancelled
+	// riders whose pickup/dropoff would add more than this are turned
+	// away, 0 accepts any detour
+	MaxDetourSeconds int
+	// join requests accepted without asking the driver: "" for none,
+	// everyone, favorites (the driver's) or trusted (riders of the
+	// driver's org rated and scoring at least the minimums, 0 ignores one)
+	AutoAccept         string `gorm:"size:16"`
+	AutoMinRating      float64
+	AutoMinReliability float64
+	// the outbound and return offers of a round trip share a PairID
+	PairID string `gorm:"size:191;index"`
+	Leg    string `gorm:"size:16"` // outbound or return
+	// set when the driver cancelled the ride
+	CancelledBy  string `gorm:"size:191"`
+	CancelledAt  *time.Time
+	CancelReason string `gorm:"size:500"`
 
 	Driver *User `gorm:"foreignKey:DriverID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
 
+	Waypoints    []Waypoint    `gorm:"foreignKey:RideID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Matches      []Match       `gorm:"foreignKey:RideID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	ChatMessages []ChatMessage `gorm:"foreignKey:RideID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 	Reviews      []Review      `gorm:"foreignKey:RideID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
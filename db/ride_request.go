@@ -9,12 +9,26 @@ import (
 type RideRequest struct {
 	ID      string `gorm:"primaryKey;size:191"`
 	UserID  string `gorm:"size:191;index"`
+	OrgID   string `gorm:"size:191;index"`
 	FromGeo string `gorm:"size:64;index"`
 	ToGeo   string `gorm:"size:64;index"`
-	Fare    float64
-	Time    time.Time `gorm:"index"`
-	Seats   int
-	Status  string `gorm:"size:32;index"`
+	// set when from/to came from the meeting point catalog
+	FromPointID string `gorm:"size:191"`
+	ToPointID   string `gorm:"size:191"`
+	Fare        float64
+	Time        time.Time `gorm:"index"`
+	// how far the rider lets departure move off Time, and when they have
+	// to be there; nil leaves that side open
+	EarliestAt *time.Time
+	LatestAt   *time.Time
+	ArriveBy   *time.Time
+	Seats      int
+	Status     string `gorm:"size:32;index"`
+	// the outbound and return requests of a round trip share a PairID.
+	// PairMode together has drivers take both legs or neither
+	PairID   string `gorm:"size:191;index"`
+	Leg      string `gorm:"size:16"`
+	PairMode string `gorm:"size:16"`
 
 	Rider *User `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
 }
@@ -0,0 +1,40 @@
+package db
+
+import "time"
+
+// ServiceZone is an admin drawn area rides of an org must stay in. Once an
+// org has zones, offer and request origins (and/or destinations, see
+// AppliesTo) must fall inside one of them. Orgs without zones are unrestricted
+type ServiceZone struct {
+	ID        string `gorm:"primaryKey;size:191"`
+	OrgID     string `gorm:"size:191;index"`
+	Name      string `gorm:"size:191"`
+	AppliesTo string `gorm:"size:16"` // origin, destination, both
+	// Polygons is the parsed shape as JSON ([]geo.Polygon), the bounding
+	// box lets most points be rejected without looking at it
+	Polygons  string `gorm:"type:longtext"`
+	MinLat    float64
+	MaxLat    float64
+	MinLon    float64
+	MaxLon    float64
+	CreatedBy string    `gorm:"size:191"`
+	CreatedAt time.Time `gorm:"index"`
+
+	Org *Organization `gorm:"foreignKey:OrgID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// MeetingPoint is a named, known-safe pickup/dropoff spot. Offers and
+// requests may reference one instead of a raw geohash
+type MeetingPoint struct {
+	ID          string `gorm:"primaryKey;size:191"`
+	OrgID       string `gorm:"size:191;index"`
+	Name        string `gorm:"size:191"`
+	Description string `gorm:"size:512"`
+	Latitude    float64
+	Longitude   float64
+	Geohash     string    `gorm:"size:64;index"`
+	CreatedBy   string    `gorm:"size:191"`
+	CreatedAt   time.Time `gorm:"index"`
+
+	Org *Organization `gorm:"foreignKey:OrgID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,33 @@
+package db
+
+import "time"
+
+// StatusChange is one step in a row's status history: who moved it from
+// which status to which, when and why. History is only ever appended to.
+type StatusChange struct {
+	ActorID    string    `gorm:"size:191;index" json:"actor_id"`
+	FromStatus string    `gorm:"size:32"        json:"from_status"` // empty when the row was created
+	ToStatus   string    `gorm:"size:32"        json:"to_status"`   // "deleted" when the row was removed
+	Reason     string    `gorm:"size:500"       json:"reason"`
+	CreatedAt  time.Time `gorm:"index"          json:"created_at"`
+}
+
+// The event tables have no foreign keys so a row's history outlives it.
+
+type MatchEvent struct {
+	ID           uint64 `gorm:"primaryKey;autoIncrement" json:"id"`
+	MatchID      string `gorm:"size:191;index"           json:"match_id"`
+	StatusChange `gorm:"embedded"`
+}
+
+type OfferEvent struct {
+	ID           uint64 `gorm:"primaryKey;autoIncrement" json:"id"`
+	OfferID      string `gorm:"size:191;index"           json:"offer_id"`
+	StatusChange `gorm:"embedded"`
+}
+
+type RequestEvent struct {
+	ID           uint64 `gorm:"primaryKey;autoIncrement" json:"id"`
+	RequestID    string `gorm:"size:191;index"           json:"request_id"`
+	StatusChange `gorm:"embedded"`
+}
@@ -0,0 +1,44 @@
+package db
+
+import "time"
+
+// TripPlan is the order a driver calls at their own stops and at their
+// riders' pickups and dropoffs. It is rebuilt whenever the offer's
+// accepted matches change.
+type TripPlan struct {
+	RideID        string  `gorm:"primaryKey;size:191"`
+	Meters        float64 // the whole planned drive
+	Seconds       int
+	DetourMeters  float64 // over the offer's stops driven without riders
+	DetourSeconds int
+	LateSeconds   int
+	// false when the search ran out of budget and the order is the best
+	// found rather than the best there is
+	Optimal   bool
+	UpdatedAt time.Time
+
+	Stops []TripPlanStop `gorm:"foreignKey:RideID;references:RideID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Ride  *RideOffer     `gorm:"foreignKey:RideID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TripPlanStop is one call of a TripPlan. Seq 0 is the origin.
+type TripPlanStop struct {
+	ID     string `gorm:"primaryKey;size:191"`
+	RideID string `gorm:"size:191;uniqueIndex:idx_plan_seq,priority:1"`
+	Seq    int    `gorm:"uniqueIndex:idx_plan_seq,priority:2"`
+	Kind   string `gorm:"size:32"` // origin, waypoint, pickup, dropoff, destination
+	// the match picked up or dropped off, empty for the driver's own stops
+	MatchID string `gorm:"size:191;index"`
+	// the offer's stop seq for origin, waypoint and destination
+	StopSeq int
+	Geohash string `gorm:"size:64"`
+	Arrive  time.Time
+	Depart  time.Time // later than Arrive when the driver waits for a planned time
+	// past the latest time the stop was promised for
+	LateSeconds int
+	// the leg from the previous stop
+	LegMeters  float64
+	LegSeconds int
+	// seats taken when leaving
+	Onboard int
+}
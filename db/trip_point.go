@@ -0,0 +1,19 @@
+package db
+
+import "time"
+
+// TripPoint is one persisted position of a participant while a ride is under
+// way. Points are downsampled before they get here, see service.TripHub
+type TripPoint struct {
+	ID         string `gorm:"primaryKey;size:191"`
+	RideID     string `gorm:"size:191;index:idx_trip_point_track,priority:1"`
+	UserID     string `gorm:"size:191;index:idx_trip_point_track,priority:2"`
+	Latitude   float64
+	Longitude  float64
+	Geohash    string    `gorm:"size:64"`
+	SpeedMPS   float64   // derived from the previous point, 0 when unknown
+	RecordedAt time.Time `gorm:"index:idx_trip_point_track,priority:3;index"`
+
+	Ride *RideOffer `gorm:"foreignKey:RideID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	User *User      `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
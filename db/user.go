@@ -13,6 +13,9 @@ type User struct {
 	PhotoURL string    `gorm:"size:191"`
 	Geohash  string    `gorm:"size:64;index"`
 	LastSeen time.Time `gorm:"index"`
+	OrgID    string    `gorm:"size:191;index"`
+	Role     string    `gorm:"size:32;default:user"` // user, admin
+	InviteID string    `gorm:"size:191;index"`       // set when admitted by invite rather than domain
 
 	Location *UserLocation `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 
@@ -26,6 +29,9 @@ func (u *User) BeforeCreate(tx *gorm.DB) (err error) {
 	if u.LastSeen.IsZero() {
 		u.LastSeen = time.Now()
 	}
+	if strings.TrimSpace(u.Role) == "" {
+		u.Role = "user"
+	}
 	return nil
 }
 
@@ -0,0 +1,16 @@
+package db
+
+import "time"
+
+// UserBlock records that BlockerID blocked or muted BlockedID.
+// A block cuts every interaction in both directions (matching, search, chat,
+// location, reviews), a mute only hides the muted user's chat messages
+type UserBlock struct {
+	BlockerID string    `gorm:"primaryKey;size:191"`
+	BlockedID string    `gorm:"primaryKey;size:191;index"`
+	Kind      string    `gorm:"size:16;index"` // block, mute
+	CreatedAt time.Time `gorm:"index"`
+
+	Blocker *User `gorm:"foreignKey:BlockerID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Blocked *User `gorm:"foreignKey:BlockedID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -0,0 +1,14 @@
+package db
+
+import "time"
+
+// UserFavorite records that UserID marked FavoriteID as a favorite, which
+// offers can use to accept their join requests without asking the driver
+type UserFavorite struct {
+	UserID     string    `gorm:"primaryKey;size:191"`
+	FavoriteID string    `gorm:"primaryKey;size:191;index"`
+	CreatedAt  time.Time `gorm:"index"`
+
+	User     *User `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Favorite *User `gorm:"foreignKey:FavoriteID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -7,7 +7,11 @@ type UserLocation struct {
 	Latitude  float64
 	Longitude float64
 	Geohash   string    `gorm:"size:64;index"`
+	OrgID     string    `gorm:"size:191;index"`
 	UpdatedAt time.Time `gorm:"index"`
 
+	// Coarse is set on copies handed to viewers that only get the fuzzed point
+	Coarse bool `gorm:"-"`
+
 	User *User `gorm:"foreignKey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
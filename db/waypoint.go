@@ -0,0 +1,25 @@
+package db
+
+import "time"
+
+// Waypoint is one stop on an offer's route. Seq 0 is the origin and the
+// highest seq the destination, offers created before waypoints existed have
+// no rows and are treated as origin -> destination
+type Waypoint struct {
+	ID      string `gorm:"primaryKey;size:191"`
+	RideID  string `gorm:"size:191;uniqueIndex:idx_waypoint_seq,priority:1"`
+	Seq     int    `gorm:"uniqueIndex:idx_waypoint_seq,priority:2"`
+	Geohash string `gorm:"size:64;index"`
+	// set when the stop came from the meeting point catalog
+	PointID string `gorm:"size:191"`
+	// when the driver expects to be here, nil when not planned
+	PlannedAt *time.Time
+}
+
+// Planned is PlannedAt, zero when not planned
+func (w Waypoint) Planned() time.Time {
+	if w.PlannedAt == nil {
+		return time.Time{}
+	}
+	return *w.PlannedAt
+}
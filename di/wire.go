@@ -19,6 +19,14 @@ type Handlers struct {
 	ReviewHandler   *api.ReviewHandler
 	RideHandler     *api.RideHandler
 	UserHandler     *api.UserHandler
+	OrgHandler      *api.OrganizationHandler
+	TripHandler     *api.TripHandler
+	AreaHandler     *api.AreaHandler
+	RouteHandler    *api.RouteHandler
+
+	// background jobs started by main
+	TrackPurger *service.TrackPurger
+	Waitlist    *service.Waitlist
 }
 
 
@@ -28,14 +36,37 @@ var ProviderSetService = wire.NewSet(
 	config.GetJWTSecret,
 	config.GetDatabaseConfig,
 	config.ProvideGoogleClientID,
+	config.GetAdminEmails,
+	config.GetTrackRetention,
+	config.GetSpeedModel,
+	config.GetNearbyIndex,
+	config.GetRouting,
+	config.GetFareModel,
+	config.GetFarePolicy,
+	config.GetTripPlanning,
+	config.GetCancellationPolicy,
+	config.GetCheckIn,
+	config.GetWaitlist,
+	config.GetBooking,
 
 	repository.NewUserRepository,
-	repository.NewRideRequestRepository,
-	repository.NewrideOfferRepository,
-	repository.NewUserLocationRepository,
+	repository.NewIndexedRideRequestRepository,
+	repository.NewIndexedRideOfferRepository,
+	repository.NewIndexedUserLocationRepository,
 	repository.NewMatchRepository,
 	repository.NewChatMessageRepository,
 	repository.NewReviewRepository,
+	repository.NewOrganizationRepository,
+	repository.NewInviteRepository,
+	repository.NewUserBlockRepository,
+	repository.NewUserFavoriteRepository,
+	repository.NewLocationSettingRepository,
+	repository.NewLocationViewRepository,
+	repository.NewTripPointRepository,
+	repository.NewServiceZoneRepository,
+	repository.NewMeetingPointRepository,
+	repository.NewWaypointRepository,
+	repository.NewTripPlanRepository,
 
 	service.NewAuthService,
 	service.NewUserService,
@@ -44,6 +75,17 @@ var ProviderSetService = wire.NewSet(
 	service.NewChatService,
 	service.NewReviewService,
 	service.NewLocationService,
+	service.NewOrganizationService,
+	service.NewAccessCache,
+	service.NewTripHub,
+	service.NewTripService,
+	service.NewTrackPurger,
+	service.NewAreaService,
+	service.NewRouter,
+	service.NewRouteService,
+	service.NewTripPlanner,
+	service.NewWaitlist,
+	wire.Struct(new(service.MatchRules), "*"),
 
 	api.NewAuthHandler,
 	api.NewChatHandler,
@@ -52,6 +94,10 @@ var ProviderSetService = wire.NewSet(
 	api.NewReviewHandler,
 	api.NewRideHandler,
 	api.NewUserHandler,
+	api.NewOrganizationHandler,
+	api.NewTripHandler,
+	api.NewAreaHandler,
+	api.NewRouteHandler,
 
 	wire.Struct(new(Handlers), "*"),
 )
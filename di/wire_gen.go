@@ -23,29 +23,76 @@ func InitApp() (*Handlers, error) {
 		return nil, err
 	}
 	userRepository := repository.NewUserRepository(db)
+	organizationRepository := repository.NewOrganizationRepository(db)
+	inviteRepository := repository.NewInviteRepository(db)
+	accessCache := service.NewAccessCache()
 	v := config.GetAllowedDomains()
+	adminEmails := config.GetAdminEmails()
 	v2 := config.GetJWTSecret()
 	string2 := config.ProvideGoogleClientID()
-	authService := service.NewAuthService(userRepository, v, v2, string2)
+	authService := service.NewAuthService(userRepository, organizationRepository, inviteRepository, accessCache, v, adminEmails, v2, string2)
 	authHandler := api.NewAuthHandler(authService)
 	chatMessageRepository := repository.NewChatMessageRepository(db)
 	matchRepository := repository.NewMatchRepository(db)
-	chatService := service.NewChatService(chatMessageRepository, matchRepository)
+	nearbyIndex := config.GetNearbyIndex()
+	rideOfferRepository := repository.NewIndexedRideOfferRepository(db, nearbyIndex)
+	userBlockRepository := repository.NewUserBlockRepository(db)
+	chatService := service.NewChatService(chatMessageRepository, matchRepository, rideOfferRepository, userRepository, organizationRepository, userBlockRepository)
 	chatHandler := api.NewChatHandler(chatService)
-	userLocationRepository := repository.NewUserLocationRepository(db)
-	locationService := service.NewLocationService(userLocationRepository)
+	userLocationRepository := repository.NewIndexedUserLocationRepository(db, nearbyIndex)
+	locationSettingRepository := repository.NewLocationSettingRepository(db)
+	locationViewRepository := repository.NewLocationViewRepository(db)
+	locationService := service.NewLocationService(userLocationRepository, locationSettingRepository, locationViewRepository, matchRepository, userRepository, organizationRepository, userBlockRepository)
 	locationHandler := api.NewLocationHandler(locationService)
-	rideOfferRepository := repository.NewrideOfferRepository(db)
-	rideRequestRepository := repository.NewRideRequestRepository(db)
-	matchService := service.NewMatchService(matchRepository, rideOfferRepository, rideRequestRepository)
-	matchHandler := api.NewMatchHandler(matchService)
+	rideRequestRepository := repository.NewIndexedRideRequestRepository(db, nearbyIndex)
+	waypointRepository := repository.NewWaypointRepository(db)
+	userFavoriteRepository := repository.NewUserFavoriteRepository(db)
 	reviewRepository := repository.NewReviewRepository(db)
-	reviewService := service.NewReviewService(reviewRepository)
+	tripHub := service.NewTripHub()
+	routing := config.GetRouting()
+	speedModel := config.GetSpeedModel()
+	router, err := service.NewRouter(routing, speedModel)
+	if err != nil {
+		return nil, err
+	}
+	tripPlanRepository := repository.NewTripPlanRepository(db)
+	tripPlanning := config.GetTripPlanning()
+	tripPlanner := service.NewTripPlanner(router, tripPlanRepository, waypointRepository, matchRepository, tripPlanning, speedModel)
+	waitlist := config.GetWaitlist()
+	serviceWaitlist := service.NewWaitlist(matchRepository, rideOfferRepository, waypointRepository, waitlist)
+	cancellationPolicy := config.GetCancellationPolicy()
+	checkIn := config.GetCheckIn()
+	booking := config.GetBooking()
+	fareModel := config.GetFareModel()
+	farePolicy := config.GetFarePolicy()
+	matchRules := service.MatchRules{
+		Cancellation: cancellationPolicy,
+		CheckIn:      checkIn,
+		Booking:      booking,
+		FareModel:    fareModel,
+		FarePolicy:   farePolicy,
+	}
+	matchService := service.NewMatchService(matchRepository, rideOfferRepository, rideRequestRepository, userRepository, organizationRepository, userBlockRepository, waypointRepository, chatMessageRepository, userFavoriteRepository, reviewRepository, tripHub, router, tripPlanner, serviceWaitlist, matchRules)
+	matchHandler := api.NewMatchHandler(matchService)
+	reviewService := service.NewReviewService(reviewRepository, userBlockRepository, matchRepository)
 	reviewHandler := api.NewReviewHandler(reviewService)
-	rideService := service.NewRideService(rideOfferRepository, rideRequestRepository, userRepository)
+	serviceZoneRepository := repository.NewServiceZoneRepository(db)
+	meetingPointRepository := repository.NewMeetingPointRepository(db)
+	rideService := service.NewRideService(rideOfferRepository, rideRequestRepository, userRepository, organizationRepository, userBlockRepository, serviceZoneRepository, meetingPointRepository, waypointRepository, matchRepository, cancellationPolicy, router, booking, fareModel, farePolicy, serviceWaitlist)
 	rideHandler := api.NewRideHandler(rideService)
-	userService := service.NewUserService(userRepository)
+	userService := service.NewUserService(userRepository, organizationRepository, userBlockRepository, userFavoriteRepository, matchRepository, cancellationPolicy)
 	userHandler := api.NewUserHandler(userService)
+	organizationService := service.NewOrganizationService(organizationRepository, userRepository, inviteRepository, userLocationRepository, rideOfferRepository, rideRequestRepository, accessCache, farePolicy)
+	organizationHandler := api.NewOrganizationHandler(organizationService)
+	tripPointRepository := repository.NewTripPointRepository(db)
+	tripService := service.NewTripService(matchRepository, rideOfferRepository, tripPointRepository, userLocationRepository, userRepository, organizationRepository, reviewRepository, waypointRepository, tripHub, speedModel, tripPlanner)
+	tripHandler := api.NewTripHandler(tripService)
+	areaService := service.NewAreaService(serviceZoneRepository, meetingPointRepository, organizationRepository, userRepository)
+	areaHandler := api.NewAreaHandler(areaService)
+	routeService := service.NewRouteService(router, rideOfferRepository, waypointRepository, userRepository, organizationRepository, fareModel)
+	routeHandler := api.NewRouteHandler(routeService)
+	trackRetention := config.GetTrackRetention()
+	trackPurger := service.NewTrackPurger(tripPointRepository, trackRetention)
 	handlers := &Handlers{
 		AuthHandler:     authHandler,
 		ChatHandler:     chatHandler,
@@ -54,6 +101,12 @@ func InitApp() (*Handlers, error) {
 		ReviewHandler:   reviewHandler,
 		RideHandler:     rideHandler,
 		UserHandler:     userHandler,
+		OrgHandler:      organizationHandler,
+		TripHandler:     tripHandler,
+		AreaHandler:     areaHandler,
+		RouteHandler:    routeHandler,
+		TrackPurger:     trackPurger,
+		Waitlist:        serviceWaitlist,
 	}
 	return handlers, nil
 }
@@ -68,7 +121,15 @@ type Handlers struct {
 	ReviewHandler   *api.ReviewHandler
 	RideHandler     *api.RideHandler
 	UserHandler     *api.UserHandler
+	OrgHandler      *api.OrganizationHandler
+	TripHandler     *api.TripHandler
+	AreaHandler     *api.AreaHandler
+	RouteHandler    *api.RouteHandler
+
+	// background jobs started by main
+	TrackPurger *service.TrackPurger
+	Waitlist    *service.Waitlist
 }
 
 // Provider Set
-var ProviderSetService = wire.NewSet(config.GetAllowedDomains, config.InitDatabase, config.GetJWTSecret, config.GetDatabaseConfig, config.ProvideGoogleClientID, repository.NewUserRepository, repository.NewRideRequestRepository, repository.NewrideOfferRepository, repository.NewUserLocationRepository, repository.NewMatchRepository, repository.NewChatMessageRepository, repository.NewReviewRepository, service.NewAuthService, service.NewUserService, service.NewRideService, service.NewMatchService, service.NewChatService, service.NewReviewService, service.NewLocationService, api.NewAuthHandler, api.NewChatHandler, api.NewLocationHandler, api.NewMatchHandler, api.NewReviewHandler, api.NewRideHandler, api.NewUserHandler, wire.Struct(new(Handlers), "*"))
+var ProviderSetService = wire.NewSet(config.GetAllowedDomains, config.InitDatabase, config.GetJWTSecret, config.GetDatabaseConfig, config.ProvideGoogleClientID, config.GetAdminEmails, config.GetTrackRetention, config.GetSpeedModel, config.GetNearbyIndex, config.GetRouting, config.GetFareModel, config.GetFarePolicy, config.GetTripPlanning, config.GetCancellationPolicy, config.GetCheckIn, config.GetWaitlist, config.GetBooking, repository.NewUserRepository, repository.NewIndexedRideRequestRepository, repository.NewIndexedRideOfferRepository, repository.NewIndexedUserLocationRepository, repository.NewMatchRepository, repository.NewChatMessageRepository, repository.NewReviewRepository, repository.NewOrganizationRepository, repository.NewInviteRepository, repository.NewUserBlockRepository, repository.NewUserFavoriteRepository, repository.NewLocationSettingRepository, repository.NewLocationViewRepository, repository.NewTripPointRepository, repository.NewServiceZoneRepository, repository.NewMeetingPointRepository, repository.NewWaypointRepository, repository.NewTripPlanRepository, service.NewAuthService, service.NewUserService, service.NewRideService, service.NewMatchService, service.NewChatService, service.NewReviewService, service.NewLocationService, service.NewOrganizationService, service.NewAccessCache, service.NewTripHub, service.NewTripService, service.NewTrackPurger, service.NewAreaService, service.NewRouter, service.NewRouteService, service.NewTripPlanner, service.NewWaitlist, wire.Struct(new(service.MatchRules), "*"), api.NewAuthHandler, api.NewChatHandler, api.NewLocationHandler, api.NewMatchHandler, api.NewReviewHandler, api.NewRideHandler, api.NewUserHandler, api.NewOrganizationHandler, api.NewTripHandler, api.NewAreaHandler, api.NewRouteHandler, wire.Struct(new(Handlers), "*"))
@@ -0,0 +1,56 @@
+package geo
+
+import "math"
+
+// cellSize is the height and width in degrees of a geohash cell of the
+// given precision
+func cellSize(precision int) (latDeg, lonDeg float64) {
+	bits := precision * 5
+	lonBits := (bits + 1) / 2
+	latBits := bits / 2
+	return 180 / math.Pow(2, float64(latBits)), 360 / math.Pow(2, float64(lonBits))
+}
+
+// CircleBounds is a box that contains every point within meters of lat/lon.
+func CircleBounds(lat, lon, meters float64) (minLat, maxLat, minLon, maxLon float64) {
+	dLat := meters / earthRadiusMeters * 180 / math.Pi
+	minLat, maxLat = math.Max(-90, lat-dLat), math.Min(90, lat+dLat)
+	// the circle is widest in longitude at its edge nearest the pole
+	cos := math.Cos(math.Max(math.Abs(minLat), math.Abs(maxLat)) * math.Pi / 180)
+	if cos < 1e-9 || dLat/cos >= 180 {
+		return minLat, maxLat, -180, 180
+	}
+	dLon := dLat / cos
+	return minLat, maxLat, math.Max(-180, lon-dLon), math.Min(180, lon+dLon)
+}
+
+// Cover returns geohash prefixes whose cells together cover the circle of
+// radius meters around lat/lon. It picks the finest precision that needs at
+// most 9 cells, so callers can query each prefix and filter by Distance.
+func Cover(lat, lon, meters float64) []string {
+	minLat, maxLat, minLon, maxLon := CircleBounds(lat, lon, meters)
+
+	for p := 9; p >= 1; p-- {
+		h, w := cellSize(p)
+		rows := int(math.Floor(maxLat/h) - math.Floor(minLat/h) + 1)
+		cols := int(math.Floor(maxLon/w) - math.Floor(minLon/w) + 1)
+		if rows*cols > 9 && p > 1 {
+			continue
+		}
+		seen := make(map[string]struct{}, rows*cols)
+		out := make([]string, 0, rows*cols)
+		for i := 0; i < rows; i++ {
+			y := math.Min(maxLat, minLat+float64(i)*h)
+			for j := 0; j < cols; j++ {
+				x := math.Min(maxLon, minLon+float64(j)*w)
+				hash := Encode(y, x, p)
+				if _, ok := seen[hash]; !ok {
+					seen[hash] = struct{}{}
+					out = append(out, hash)
+				}
+			}
+		}
+		return out
+	}
+	return nil
+}
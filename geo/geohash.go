@@ -0,0 +1,110 @@
+// Package geo has the small bits of geometry the services need: geohash
+// encoding/decoding and great-circle distances.
+package geo
+
+import (
+	"errors"
+	"math"
+	"strings"
+)
+
+const base32 = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+var errInvalidGeohash = errors.New("invalid geohash")
+
+// Encode returns the geohash of lat/lon with precision characters.
+func Encode(lat, lon float64, precision int) string {
+	if precision <= 0 {
+		precision = 9
+	}
+	latLo, latHi := -90.0, 90.0
+	lonLo, lonHi := -180.0, 180.0
+
+	var sb strings.Builder
+	sb.Grow(precision)
+	bit, ch, even := 0, 0, true
+	for sb.Len() < precision {
+		if even {
+			mid := (lonLo + lonHi) / 2
+			if lon >= mid {
+				ch = ch<<1 | 1
+				lonLo = mid
+			} else {
+				ch <<= 1
+				lonHi = mid
+			}
+		} else {
+			mid := (latLo + latHi) / 2
+			if lat >= mid {
+				ch = ch<<1 | 1
+				latLo = mid
+			} else {
+				ch <<= 1
+				latHi = mid
+			}
+		}
+		even = !even
+		if bit++; bit == 5 {
+			sb.WriteByte(base32[ch])
+			bit, ch = 0, 0
+		}
+	}
+	return sb.String()
+}
+
+// Decode returns the center of the geohash cell.
+func Decode(hash string) (lat, lon float64, err error) {
+	latLo, latHi, lonLo, lonHi, err := Bounds(hash)
+	if err != nil {
+		return 0, 0, err
+	}
+	return (latLo + latHi) / 2, (lonLo + lonHi) / 2, nil
+}
+
+// Bounds returns the cell of hash as min/max latitude and longitude.
+func Bounds(hash string) (latLo, latHi, lonLo, lonHi float64, err error) {
+	hash = strings.ToLower(strings.TrimSpace(hash))
+	if hash == "" {
+		return 0, 0, 0, 0, errInvalidGeohash
+	}
+	latLo, latHi = -90.0, 90.0
+	lonLo, lonHi = -180.0, 180.0
+	even := true
+	for i := 0; i < len(hash); i++ {
+		idx := strings.IndexByte(base32, hash[i])
+		if idx < 0 {
+			return 0, 0, 0, 0, errInvalidGeohash
+		}
+		for mask := 16; mask > 0; mask >>= 1 {
+			if even {
+				mid := (lonLo + lonHi) / 2
+				if idx&mask != 0 {
+					lonLo = mid
+				} else {
+					lonHi = mid
+				}
+			} else {
+				mid := (latLo + latHi) / 2
+				if idx&mask != 0 {
+					latLo = mid
+				} else {
+					latHi = mid
+				}
+			}
+			even = !even
+		}
+	}
+	return latLo, latHi, lonLo, lonHi, nil
+}
+
+const earthRadiusMeters = 6371000.0
+
+// Distance is the haversine distance in meters.
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	toRad := func(d float64) float64 { return d * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLon := toRad(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(a))
+}
@@ -0,0 +1,136 @@
+package geo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Polygon is an outer ring followed by optional holes. Points are
+// [lon, lat] like GeoJSON.
+type Polygon [][][2]float64
+
+var errNoPolygons = errors.New("geojson has no polygons")
+
+// Contains reports whether lat/lon is inside the outer ring and outside
+// every hole.
+func (p Polygon) Contains(lat, lon float64) bool {
+	if len(p) == 0 || !ringContains(p[0], lat, lon) {
+		return false
+	}
+	for _, hole := range p[1:] {
+		if ringContains(hole, lat, lon) {
+			return false
+		}
+	}
+	return true
+}
+
+// ray casting, edges count as outside
+func ringContains(ring [][2]float64, lat, lon float64) bool {
+	in := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		xi, yi := ring[i][0], ring[i][1]
+		xj, yj := ring[j][0], ring[j][1]
+		if (yi > lat) != (yj > lat) && lon < (xj-xi)*(lat-yi)/(yj-yi)+xi {
+			in = !in
+		}
+	}
+	return in
+}
+
+// Bounds of the outer ring.
+func (p Polygon) Bounds() (minLat, maxLat, minLon, maxLon float64) {
+	minLat, minLon = math.Inf(1), math.Inf(1)
+	maxLat, maxLon = math.Inf(-1), math.Inf(-1)
+	if len(p) == 0 {
+		return 0, 0, 0, 0
+	}
+	for _, pt := range p[0] {
+		minLon, maxLon = math.Min(minLon, pt[0]), math.Max(maxLon, pt[0])
+		minLat, maxLat = math.Min(minLat, pt[1]), math.Max(maxLat, pt[1])
+	}
+	return minLat, maxLat, minLon, maxLon
+}
+
+type geoJSON struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+	Geometry    *geoJSON        `json:"geometry"`
+	Geometries  []geoJSON       `json:"geometries"`
+	Features    []geoJSON       `json:"features"`
+}
+
+// ParseGeoJSON pulls every polygon out of a Polygon, MultiPolygon, Feature,
+// FeatureCollection or GeometryCollection. Other geometry types are ignored.
+func ParseGeoJSON(data []byte) ([]Polygon, error) {
+	var g geoJSON
+	if err := json.Unmarshal(data, &g); err != nil {
+		return nil, fmt.Errorf("invalid geojson: %w", err)
+	}
+	var out []Polygon
+	if err := collect(&g, &out); err != nil {
+		return nil, err
+	}
+	if len(out) == 0 {
+		return nil, errNoPolygons
+	}
+	return out, nil
+}
+
+func collect(g *geoJSON, out *[]Polygon) error {
+	switch g.Type {
+	case "Polygon":
+		var p Polygon
+		if err := json.Unmarshal(g.Coordinates, &p); err != nil {
+			return fmt.Errorf("invalid polygon: %w", err)
+		}
+		if err := validRing(p); err != nil {
+			return err
+		}
+		*out = append(*out, p)
+	case "MultiPolygon":
+		var ps []Polygon
+		if err := json.Unmarshal(g.Coordinates, &ps); err != nil {
+			return fmt.Errorf("invalid multipolygon: %w", err)
+		}
+		for _, p := range ps {
+			if err := validRing(p); err != nil {
+				return err
+			}
+		}
+		*out = append(*out, ps...)
+	case "Feature":
+		if g.Geometry != nil {
+			return collect(g.Geometry, out)
+		}
+	case "FeatureCollection":
+		for i := range g.Features {
+			if err := collect(&g.Features[i], out); err != nil {
+				return err
+			}
+		}
+	case "GeometryCollection":
+		for i := range g.Geometries {
+			if err := collect(&g.Geometries[i], out); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validRing(p Polygon) error {
+	if len(p) == 0 || len(p[0]) < 4 {
+		return errors.New("invalid polygon: outer ring needs at least 4 points")
+	}
+	for _, ring := range p {
+		for _, pt := range ring {
+			if pt[1] < -90 || pt[1] > 90 || pt[0] < -180 || pt[0] > 180 {
+				return errors.New("invalid polygon: coordinate out of range")
+			}
+		}
+	}
+	return nil
+}
@@ -1,11 +1,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"os"
 
+	"hope/config"
 	"hope/di"
 	"hope/middleware"
 
@@ -13,12 +15,16 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	
+	areav1 "hope/proto/v1/area"
 	authv1 "hope/proto/v1/auth"
 	chatv1 "hope/proto/v1/chat"
 	locationv1 "hope/proto/v1/location"
 	matchv1 "hope/proto/v1/match"
+	organizationv1 "hope/proto/v1/organization"
 	reviewv1 "hope/proto/v1/review"
 	ridev1 "hope/proto/v1/ride"
+	routev1 "hope/proto/v1/route"
+	tripv1 "hope/proto/v1/trip"
 	userv1 "hope/proto/v1/user"
 
 	"github.com/joho/godotenv"
@@ -32,16 +38,22 @@ func main() {
 		log.Fatalf("DI bootstrap failed: %v", err)
 	}
 
+	go handlers.TrackPurger.Run(context.Background())
+	go handlers.Waitlist.Run(context.Background())
+
 	authConfig := middleware.Config{
 		JWTSecret: []byte(os.Getenv("JWT_SECRET")),
 		PublicMethods: map[string]bool{
 			"/proto.v1.AuthService/Login": true,
 		},
+		RequestTimeout: config.GetRequestTimeout(),
+		StreamTimeout:  config.GetStreamTimeout(),
+		RateLimit:      middleware.NewRateLimitConfig(config.GetRateLimit()),
 	}
 
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(middleware.AuthInterceptor(authConfig)),
-	)
+	// unary and stream interceptors share one chain: request id, logging,
+	// panic recovery, deadlines, auth and rate limiting
+	grpcServer := grpc.NewServer(middleware.ServerOptions(authConfig)...)
 
 	authv1.RegisterAuthServiceServer(grpcServer, handlers.AuthHandler)
 	chatv1.RegisterChatServiceServer(grpcServer, handlers.ChatHandler)
@@ -50,6 +62,10 @@ func main() {
 	reviewv1.RegisterReviewServiceServer(grpcServer, handlers.ReviewHandler)
 	ridev1.RegisterRideServiceServer(grpcServer, handlers.RideHandler)
 	userv1.RegisterUserServiceServer(grpcServer, handlers.UserHandler)
+	organizationv1.RegisterOrganizationServiceServer(grpcServer, handlers.OrgHandler)
+	tripv1.RegisterTripServiceServer(grpcServer, handlers.TripHandler)
+	areav1.RegisterAreaServiceServer(grpcServer, handlers.AreaHandler)
+	routev1.RegisterRouteServiceServer(grpcServer, handlers.RouteHandler)
 
 	
 	reflection.Register(grpcServer)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/golang-jwt/jwt"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -27,6 +28,14 @@ const (
 type Config struct {
 	JWTSecret     []byte
 	PublicMethods map[string]bool //map["proto/v1/auth.AuthService/Login"]=true
+
+	// RequestTimeout is applied to unary calls that arrive without a deadline,
+	// StreamTimeout caps how long a single stream may stay open, zero disables
+	RequestTimeout time.Duration
+	StreamTimeout  time.Duration
+
+	// RateLimit is optional, nil turns throttling off
+	RateLimit *RateLimitConfig
 }
 
 // Identity extracted after validating backend JWT
@@ -84,42 +93,66 @@ func EmailFromContext(ctx context.Context) (string, bool) {
 	return s, ok && s != ""
 }
 
-// AuthInterceptor is like a central gatekeeper for all non-public RPC
-func AuthInterceptor(cfg Config) grpc.UnaryServerInterceptor {
-	secret := cfg.JWTSecret
+// ContextWithIdentity injects the identity the same way the interceptors do,
+// handy for background jobs and streams that build their own context
+func ContextWithIdentity(ctx context.Context, id Identity) context.Context {
+	ctx = context.WithValue(ctx, ctxUserIDKey, id.UserID)
+	if id.Email != "" {
+		ctx = context.WithValue(ctx, ctxEmailKey, id.Email)
+	}
+	return ctx
+}
 
-	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		//bypass for public method
-		if cfg.PublicMethods[info.FullMethod] {
-			return handler(ctx, req)
-		}
+// authenticate reads the bearer token from incoming metadata and returns a
+// context carrying the identity, shared by the unary and stream interceptors
+func authenticate(ctx context.Context, cfg Config, fullMethod string) (context.Context, error) {
+	//bypass for public method
+	if cfg.PublicMethods[fullMethod] {
+		return ctx, nil
+	}
 
-		//extracting authorization
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
-		}
-		vals := md.Get("authorization")
-		if len(vals) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "authorization header required")
-		}
+	//extracting authorization
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	vals := md.Get("authorization")
+	if len(vals) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization header required")
+	}
 
-		parts := strings.Fields(vals[0])
-		if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
-			return nil, status.Error(codes.Unauthenticated, "invalid authorization header")
-		}
-		tokenStr := parts[1]
+	parts := strings.Fields(vals[0])
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+		return nil, status.Error(codes.Unauthenticated, "invalid authorization header")
+	}
+	tokenStr := parts[1]
 
-		id, err := ValidateToken(ctx, tokenStr, secret)
+	id, err := ValidateToken(ctx, tokenStr, cfg.JWTSecret)
+	if err != nil {
+		return nil, err
+	}
+	return ContextWithIdentity(ctx, id), nil
+}
+
+// AuthInterceptor is like a central gatekeeper for all non-public RPC
+func AuthInterceptor(cfg Config) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, cfg, info.FullMethod)
 		if err != nil {
 			return nil, err
 		}
-
-		ctx = context.WithValue(ctx, ctxUserIDKey, id.UserID)
-		if id.Email != "" {
-			ctx = context.WithValue(ctx, ctxEmailKey, id.Email)
-		}
 		return handler(ctx, req)
+	}
+}
 
+// StreamAuthInterceptor is the streaming twin of AuthInterceptor, the identity
+// is visible to handlers through stream.Context()
+func StreamAuthInterceptor(cfg Config) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), cfg, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, wrapServerStream(ss, ctx))
 	}
 }
@@ -0,0 +1,35 @@
+package middleware
+
+import "google.golang.org/grpc"
+
+// ServerOptions builds the interceptor chain for both unary and streaming RPCs
+// so every method, whatever its kind, goes through the same gatekeeping.
+// Order matters: request id first so every later step can log it, logging
+// wraps recovery so panics show up as Internal, auth runs next and rate
+// limiting after it so buckets can be keyed by user id
+func ServerOptions(cfg Config) []grpc.ServerOption {
+	unary := []grpc.UnaryServerInterceptor{
+		RequestIDInterceptor(),
+		LoggingInterceptor(),
+		RecoveryInterceptor(),
+		DeadlineInterceptor(cfg.RequestTimeout),
+		AuthInterceptor(cfg),
+	}
+	if cfg.RateLimit != nil {
+		unary = append(unary, RateLimitInterceptor(cfg.RateLimit))
+	}
+	stream := []grpc.StreamServerInterceptor{
+		StreamRequestIDInterceptor(),
+		StreamLoggingInterceptor(),
+		StreamRecoveryInterceptor(),
+		StreamDeadlineInterceptor(cfg.StreamTimeout),
+		StreamAuthInterceptor(cfg),
+	}
+	if cfg.RateLimit != nil {
+		stream = append(stream, StreamRateLimitInterceptor(cfg.RateLimit))
+	}
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+}
@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// LoggingInterceptor writes one line per unary call with method, status code,
+// latency and request id
+func LoggingInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logCall(ctx, "unary", info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamLoggingInterceptor logs once the stream has finished
+func StreamLoggingInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logCall(ss.Context(), "stream", info.FullMethod, start, err)
+		return err
+	}
+}
+
+func logCall(ctx context.Context, kind, method string, start time.Time, err error) {
+	reqID, _ := RequestIDFromContext(ctx)
+	log.Printf("grpc %s %s code=%s duration=%s request_id=%s",
+		kind, method, status.Code(err), time.Since(start), reqID)
+}
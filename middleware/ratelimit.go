@@ -0,0 +1,166 @@
+package middleware
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"hope/config"
+)
+
+// RetryAfterHeader carries the number of seconds a throttled client should
+// wait before trying again
+const RetryAfterHeader = "retry-after"
+
+// RateLimitStore keeps the buckets, the in-memory one works for a single
+// instance, a shared implementation (e.g. redis) can be dropped in later
+type RateLimitStore interface {
+	// Allow takes one token from the bucket identified by key, when none are
+	// left it reports how long until the next token is available
+	Allow(ctx context.Context, key string, limit config.Limit) (bool, time.Duration, error)
+}
+
+// RateLimitConfig pairs the configured limits with the store keeping the
+// buckets
+type RateLimitConfig struct {
+	config.RateLimit
+	Store RateLimitStore
+}
+
+// NewRateLimitConfig keeps the buckets in process memory, nil limits turn
+// throttling off
+func NewRateLimitConfig(limits *config.RateLimit) *RateLimitConfig {
+	if limits == nil {
+		return nil
+	}
+	return &RateLimitConfig{RateLimit: *limits, Store: NewMemoryRateLimitStore()}
+}
+
+func (c *RateLimitConfig) limitFor(method string) config.Limit {
+	if l, ok := c.Methods[method]; ok {
+		return l
+	}
+	return c.Default
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+type memoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// NewMemoryRateLimitStore returns a process local token bucket store
+func NewMemoryRateLimitStore() RateLimitStore {
+	return &memoryRateLimitStore{buckets: make(map[string]*bucket), lastSweep: time.Now()}
+}
+
+func (s *memoryRateLimitStore) Allow(_ context.Context, key string, limit config.Limit) (bool, time.Duration, error) {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		s.buckets[key] = b
+	}
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+now.Sub(b.last).Seconds()*limit.Rate)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0, nil
+	}
+	wait := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+	return false, wait, nil
+}
+
+// sweep drops buckets idle for a while, an idle bucket is full again anyway
+// so forgetting it changes nothing for the client
+func (s *memoryRateLimitStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < time.Minute {
+		return
+	}
+	s.lastSweep = now
+	for k, b := range s.buckets {
+		if now.Sub(b.last) > time.Hour {
+			delete(s.buckets, k)
+		}
+	}
+}
+
+// rateLimitKey identifies the caller: user id when authenticated, peer ip
+// for public methods such as Login
+func rateLimitKey(ctx context.Context, method string) string {
+	if uid, ok := UserIDFromContext(ctx); ok {
+		return method + "|user:" + uid
+	}
+	ip := "unknown"
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+	}
+	return method + "|ip:" + ip
+}
+
+// checkRateLimit returns ResourceExhausted with retry-after metadata when
+// the caller is over the limit for this method
+func checkRateLimit(ctx context.Context, cfg *RateLimitConfig, method string) error {
+	limit := cfg.limitFor(method)
+	if limit.Rate <= 0 || limit.Burst <= 0 {
+		return nil
+	}
+	ok, wait, err := cfg.Store.Allow(ctx, rateLimitKey(ctx, method), limit)
+	if err != nil {
+		// fail open, a broken limiter store should not take the api down
+		return nil
+	}
+	if ok {
+		return nil
+	}
+	secs := int(math.Ceil(wait.Seconds()))
+	if secs < 1 {
+		secs = 1
+	}
+	_ = grpc.SetTrailer(ctx, metadata.Pairs(RetryAfterHeader, strconv.Itoa(secs)))
+	return status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %ds", secs)
+}
+
+// RateLimitInterceptor throttles unary calls, it must run after auth so the
+// user id is available in the context
+func RateLimitInterceptor(cfg *RateLimitConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkRateLimit(ctx, cfg, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamRateLimitInterceptor throttles opening new streams
+func StreamRateLimitInterceptor(cfg *RateLimitConfig) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkRateLimit(ss.Context(), cfg, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
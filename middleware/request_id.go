@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDHeader is read from incoming metadata and echoed back in the
+// response header, a new id is generated when the client did not send one
+const RequestIDHeader = "x-request-id"
+
+const ctxRequestIDKey ctxKey = "request_id"
+
+// RequestIDFromContext returns the id assigned by the request id interceptor
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	v := ctx.Value(ctxRequestIDKey)
+	s, ok := v.(string)
+	return s, ok && s != ""
+}
+
+func requestIDContext(ctx context.Context) (context.Context, string) {
+	id := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(RequestIDHeader); len(vals) > 0 {
+			id = strings.TrimSpace(vals[0])
+		}
+	}
+	// cap client supplied ids so they cannot bloat our logs
+	if id == "" || len(id) > 128 {
+		id = uuid.New().String()
+	}
+	return context.WithValue(ctx, ctxRequestIDKey, id), id
+}
+
+// RequestIDInterceptor tags every unary call with a request id
+func RequestIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, id := requestIDContext(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id))
+		return handler(ctx, req)
+	}
+}
+
+// StreamRequestIDInterceptor tags every stream with a request id
+func StreamRequestIDInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, id := requestIDContext(ss.Context())
+		_ = ss.SetHeader(metadata.Pairs(RequestIDHeader, id))
+		return handler(srv, wrapServerStream(ss, ctx))
+	}
+}
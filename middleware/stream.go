@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// grpc.ServerStream does not let us swap its context, so stream interceptors
+// wrap it to hand the enriched context (identity, request id, deadline) down
+type wrappedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedServerStream) Context() context.Context {
+	return w.ctx
+}
+
+func wrapServerStream(ss grpc.ServerStream, ctx context.Context) grpc.ServerStream {
+	if w, ok := ss.(*wrappedServerStream); ok {
+		return &wrappedServerStream{ServerStream: w.ServerStream, ctx: ctx}
+	}
+	return &wrappedServerStream{ServerStream: ss, ctx: ctx}
+}
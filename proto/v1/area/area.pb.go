@@ -0,0 +1,1092 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: proto/v1/area.proto
+
+package area
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ServiceZone struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// origin, destination or both
+	AppliesTo     string                 `protobuf:"bytes,4,opt,name=applies_to,json=appliesTo,proto3" json:"applies_to,omitempty"`
+	MinLat        float64                `protobuf:"fixed64,5,opt,name=min_lat,json=minLat,proto3" json:"min_lat,omitempty"`
+	MaxLat        float64                `protobuf:"fixed64,6,opt,name=max_lat,json=maxLat,proto3" json:"max_lat,omitempty"`
+	MinLon        float64                `protobuf:"fixed64,7,opt,name=min_lon,json=minLon,proto3" json:"min_lon,omitempty"`
+	MaxLon        float64                `protobuf:"fixed64,8,opt,name=max_lon,json=maxLon,proto3" json:"max_lon,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceZone) Reset() {
+	*x = ServiceZone{}
+	mi := &file_proto_v1_area_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceZone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceZone) ProtoMessage() {}
+
+func (x *ServiceZone) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceZone.ProtoReflect.Descriptor instead.
+func (*ServiceZone) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServiceZone) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ServiceZone) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ServiceZone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceZone) GetAppliesTo() string {
+	if x != nil {
+		return x.AppliesTo
+	}
+	return ""
+}
+
+func (x *ServiceZone) GetMinLat() float64 {
+	if x != nil {
+		return x.MinLat
+	}
+	return 0
+}
+
+func (x *ServiceZone) GetMaxLat() float64 {
+	if x != nil {
+		return x.MaxLat
+	}
+	return 0
+}
+
+func (x *ServiceZone) GetMinLon() float64 {
+	if x != nil {
+		return x.MinLon
+	}
+	return 0
+}
+
+func (x *ServiceZone) GetMaxLon() float64 {
+	if x != nil {
+		return x.MaxLon
+	}
+	return 0
+}
+
+func (x *ServiceZone) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type MeetingPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId         string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,5,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,6,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Geohash       string                 `protobuf:"bytes,7,opt,name=geohash,proto3" json:"geohash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeetingPoint) Reset() {
+	*x = MeetingPoint{}
+	mi := &file_proto_v1_area_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeetingPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeetingPoint) ProtoMessage() {}
+
+func (x *MeetingPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeetingPoint.ProtoReflect.Descriptor instead.
+func (*MeetingPoint) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MeetingPoint) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MeetingPoint) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *MeetingPoint) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MeetingPoint) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MeetingPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *MeetingPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *MeetingPoint) GetGeohash() string {
+	if x != nil {
+		return x.Geohash
+	}
+	return ""
+}
+
+type CreateZoneRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	OrgId     string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AppliesTo string                 `protobuf:"bytes,3,opt,name=applies_to,json=appliesTo,proto3" json:"applies_to,omitempty"`
+	// Polygon, MultiPolygon, Feature or FeatureCollection
+	Geojson       string `protobuf:"bytes,4,opt,name=geojson,proto3" json:"geojson,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateZoneRequest) Reset() {
+	*x = CreateZoneRequest{}
+	mi := &file_proto_v1_area_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateZoneRequest) ProtoMessage() {}
+
+func (x *CreateZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateZoneRequest.ProtoReflect.Descriptor instead.
+func (*CreateZoneRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateZoneRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreateZoneRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateZoneRequest) GetAppliesTo() string {
+	if x != nil {
+		return x.AppliesTo
+	}
+	return ""
+}
+
+func (x *CreateZoneRequest) GetGeojson() string {
+	if x != nil {
+		return x.Geojson
+	}
+	return ""
+}
+
+type CreateZoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zone          *ServiceZone           `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateZoneResponse) Reset() {
+	*x = CreateZoneResponse{}
+	mi := &file_proto_v1_area_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateZoneResponse) ProtoMessage() {}
+
+func (x *CreateZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateZoneResponse.ProtoReflect.Descriptor instead.
+func (*CreateZoneResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateZoneResponse) GetZone() *ServiceZone {
+	if x != nil {
+		return x.Zone
+	}
+	return nil
+}
+
+type ListZonesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// defaults to the caller's org
+	OrgId         string `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListZonesRequest) Reset() {
+	*x = ListZonesRequest{}
+	mi := &file_proto_v1_area_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListZonesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListZonesRequest) ProtoMessage() {}
+
+func (x *ListZonesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListZonesRequest.ProtoReflect.Descriptor instead.
+func (*ListZonesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListZonesRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type ListZonesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Zones         []*ServiceZone         `protobuf:"bytes,1,rep,name=zones,proto3" json:"zones,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListZonesResponse) Reset() {
+	*x = ListZonesResponse{}
+	mi := &file_proto_v1_area_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListZonesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListZonesResponse) ProtoMessage() {}
+
+func (x *ListZonesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListZonesResponse.ProtoReflect.Descriptor instead.
+func (*ListZonesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListZonesResponse) GetZones() []*ServiceZone {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+type DeleteZoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteZoneRequest) Reset() {
+	*x = DeleteZoneRequest{}
+	mi := &file_proto_v1_area_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteZoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteZoneRequest) ProtoMessage() {}
+
+func (x *DeleteZoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteZoneRequest.ProtoReflect.Descriptor instead.
+func (*DeleteZoneRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteZoneRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteZoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteZoneResponse) Reset() {
+	*x = DeleteZoneResponse{}
+	mi := &file_proto_v1_area_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteZoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteZoneResponse) ProtoMessage() {}
+
+func (x *DeleteZoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteZoneResponse.ProtoReflect.Descriptor instead.
+func (*DeleteZoneResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteZoneResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreateMeetingPointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMeetingPointRequest) Reset() {
+	*x = CreateMeetingPointRequest{}
+	mi := &file_proto_v1_area_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMeetingPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMeetingPointRequest) ProtoMessage() {}
+
+func (x *CreateMeetingPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMeetingPointRequest.ProtoReflect.Descriptor instead.
+func (*CreateMeetingPointRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateMeetingPointRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreateMeetingPointRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMeetingPointRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateMeetingPointRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *CreateMeetingPointRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type CreateMeetingPointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Point         *MeetingPoint          `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMeetingPointResponse) Reset() {
+	*x = CreateMeetingPointResponse{}
+	mi := &file_proto_v1_area_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMeetingPointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMeetingPointResponse) ProtoMessage() {}
+
+func (x *CreateMeetingPointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMeetingPointResponse.ProtoReflect.Descriptor instead.
+func (*CreateMeetingPointResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateMeetingPointResponse) GetPoint() *MeetingPoint {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+type ListMeetingPointsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GeohashPrefix string                 `protobuf:"bytes,1,opt,name=geohash_prefix,json=geohashPrefix,proto3" json:"geohash_prefix,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMeetingPointsRequest) Reset() {
+	*x = ListMeetingPointsRequest{}
+	mi := &file_proto_v1_area_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMeetingPointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMeetingPointsRequest) ProtoMessage() {}
+
+func (x *ListMeetingPointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMeetingPointsRequest.ProtoReflect.Descriptor instead.
+func (*ListMeetingPointsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListMeetingPointsRequest) GetGeohashPrefix() string {
+	if x != nil {
+		return x.GeohashPrefix
+	}
+	return ""
+}
+
+func (x *ListMeetingPointsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListMeetingPointsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*MeetingPoint        `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMeetingPointsResponse) Reset() {
+	*x = ListMeetingPointsResponse{}
+	mi := &file_proto_v1_area_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMeetingPointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMeetingPointsResponse) ProtoMessage() {}
+
+func (x *ListMeetingPointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMeetingPointsResponse.ProtoReflect.Descriptor instead.
+func (*ListMeetingPointsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListMeetingPointsResponse) GetPoints() []*MeetingPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type DeleteMeetingPointRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMeetingPointRequest) Reset() {
+	*x = DeleteMeetingPointRequest{}
+	mi := &file_proto_v1_area_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMeetingPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMeetingPointRequest) ProtoMessage() {}
+
+func (x *DeleteMeetingPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMeetingPointRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMeetingPointRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteMeetingPointRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteMeetingPointResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMeetingPointResponse) Reset() {
+	*x = DeleteMeetingPointResponse{}
+	mi := &file_proto_v1_area_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMeetingPointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMeetingPointResponse) ProtoMessage() {}
+
+func (x *DeleteMeetingPointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMeetingPointResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMeetingPointResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteMeetingPointResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SnapToMeetingPointRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Latitude  float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// search radius, 500m when 0
+	MaxMeters     float64 `protobuf:"fixed64,3,opt,name=max_meters,json=maxMeters,proto3" json:"max_meters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapToMeetingPointRequest) Reset() {
+	*x = SnapToMeetingPointRequest{}
+	mi := &file_proto_v1_area_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapToMeetingPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapToMeetingPointRequest) ProtoMessage() {}
+
+func (x *SnapToMeetingPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapToMeetingPointRequest.ProtoReflect.Descriptor instead.
+func (*SnapToMeetingPointRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SnapToMeetingPointRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *SnapToMeetingPointRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *SnapToMeetingPointRequest) GetMaxMeters() float64 {
+	if x != nil {
+		return x.MaxMeters
+	}
+	return 0
+}
+
+type SnapToMeetingPointResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Point          *MeetingPoint          `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	DistanceMeters float64                `protobuf:"fixed64,2,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SnapToMeetingPointResponse) Reset() {
+	*x = SnapToMeetingPointResponse{}
+	mi := &file_proto_v1_area_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapToMeetingPointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapToMeetingPointResponse) ProtoMessage() {}
+
+func (x *SnapToMeetingPointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_area_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapToMeetingPointResponse.ProtoReflect.Descriptor instead.
+func (*SnapToMeetingPointResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_area_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SnapToMeetingPointResponse) GetPoint() *MeetingPoint {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *SnapToMeetingPointResponse) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+var File_proto_v1_area_proto protoreflect.FileDescriptor
+
+const file_proto_v1_area_proto_rawDesc = "" +
+	"\n" +
+	"\x13proto/v1/area.proto\x12\bproto.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x86\x02\n" +
+	"\vServiceZone\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"applies_to\x18\x04 \x01(\tR\tappliesTo\x12\x17\n" +
+	"\amin_lat\x18\x05 \x01(\x01R\x06minLat\x12\x17\n" +
+	"\amax_lat\x18\x06 \x01(\x01R\x06maxLat\x12\x17\n" +
+	"\amin_lon\x18\a \x01(\x01R\x06minLon\x12\x17\n" +
+	"\amax_lon\x18\b \x01(\x01R\x06maxLon\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xbf\x01\n" +
+	"\fMeetingPoint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\blatitude\x18\x05 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x06 \x01(\x01R\tlongitude\x12\x18\n" +
+	"\ageohash\x18\a \x01(\tR\ageohash\"w\n" +
+	"\x11CreateZoneRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"applies_to\x18\x03 \x01(\tR\tappliesTo\x12\x18\n" +
+	"\ageojson\x18\x04 \x01(\tR\ageojson\"?\n" +
+	"\x12CreateZoneResponse\x12)\n" +
+	"\x04zone\x18\x01 \x01(\v2\x15.proto.v1.ServiceZoneR\x04zone\")\n" +
+	"\x10ListZonesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"@\n" +
+	"\x11ListZonesResponse\x12+\n" +
+	"\x05zones\x18\x01 \x03(\v2\x15.proto.v1.ServiceZoneR\x05zones\"#\n" +
+	"\x11DeleteZoneRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\".\n" +
+	"\x12DeleteZoneResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa2\x01\n" +
+	"\x19CreateMeetingPointRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\"J\n" +
+	"\x1aCreateMeetingPointResponse\x12,\n" +
+	"\x05point\x18\x01 \x01(\v2\x16.proto.v1.MeetingPointR\x05point\"W\n" +
+	"\x18ListMeetingPointsRequest\x12%\n" +
+	"\x0egeohash_prefix\x18\x01 \x01(\tR\rgeohashPrefix\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"K\n" +
+	"\x19ListMeetingPointsResponse\x12.\n" +
+	"\x06points\x18\x01 \x03(\v2\x16.proto.v1.MeetingPointR\x06points\"+\n" +
+	"\x19DeleteMeetingPointRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"6\n" +
+	"\x1aDeleteMeetingPointResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"t\n" +
+	"\x19SnapToMeetingPointRequest\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x1d\n" +
+	"\n" +
+	"max_meters\x18\x03 \x01(\x01R\tmaxMeters\"s\n" +
+	"\x1aSnapToMeetingPointResponse\x12,\n" +
+	"\x05point\x18\x01 \x01(\v2\x16.proto.v1.MeetingPointR\x05point\x12'\n" +
+	"\x0fdistance_meters\x18\x02 \x01(\x01R\x0edistanceMeters2\xf4\x04\n" +
+	"\vAreaService\x12I\n" +
+	"\n" +
+	"CreateZone\x12\x1b.proto.v1.CreateZoneRequest\x1a\x1c.proto.v1.CreateZoneResponse\"\x00\x12F\n" +
+	"\tListZones\x12\x1a.proto.v1.ListZonesRequest\x1a\x1b.proto.v1.ListZonesResponse\"\x00\x12I\n" +
+	"\n" +
+	"DeleteZone\x12\x1b.proto.v1.DeleteZoneRequest\x1a\x1c.proto.v1.DeleteZoneResponse\"\x00\x12a\n" +
+	"\x12CreateMeetingPoint\x12#.proto.v1.CreateMeetingPointRequest\x1a$.proto.v1.CreateMeetingPointResponse\"\x00\x12^\n" +
+	"\x11ListMeetingPoints\x12\".proto.v1.ListMeetingPointsRequest\x1a#.proto.v1.ListMeetingPointsResponse\"\x00\x12a\n" +
+	"\x12DeleteMeetingPoint\x12#.proto.v1.DeleteMeetingPointRequest\x1a$.proto.v1.DeleteMeetingPointResponse\"\x00\x12a\n" +
+	"\x12SnapToMeetingPoint\x12#.proto.v1.SnapToMeetingPointRequest\x1a$.proto.v1.SnapToMeetingPointResponse\"\x00B\x11Z\x0f./proto/v1/areab\x06proto3"
+
+var (
+	file_proto_v1_area_proto_rawDescOnce sync.Once
+	file_proto_v1_area_proto_rawDescData []byte
+)
+
+func file_proto_v1_area_proto_rawDescGZIP() []byte {
+	file_proto_v1_area_proto_rawDescOnce.Do(func() {
+		file_proto_v1_area_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v1_area_proto_rawDesc), len(file_proto_v1_area_proto_rawDesc)))
+	})
+	return file_proto_v1_area_proto_rawDescData
+}
+
+var file_proto_v1_area_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_proto_v1_area_proto_goTypes = []any{
+	(*ServiceZone)(nil),                // 0: proto.v1.ServiceZone
+	(*MeetingPoint)(nil),               // 1: proto.v1.MeetingPoint
+	(*CreateZoneRequest)(nil),          // 2: proto.v1.CreateZoneRequest
+	(*CreateZoneResponse)(nil),         // 3: proto.v1.CreateZoneResponse
+	(*ListZonesRequest)(nil),           // 4: proto.v1.ListZonesRequest
+	(*ListZonesResponse)(nil),          // 5: proto.v1.ListZonesResponse
+	(*DeleteZoneRequest)(nil),          // 6: proto.v1.DeleteZoneRequest
+	(*DeleteZoneResponse)(nil),         // 7: proto.v1.DeleteZoneResponse
+	(*CreateMeetingPointRequest)(nil),  // 8: proto.v1.CreateMeetingPointRequest
+	(*CreateMeetingPointResponse)(nil), // 9: proto.v1.CreateMeetingPointResponse
+	(*ListMeetingPointsRequest)(nil),   // 10: proto.v1.ListMeetingPointsRequest
+	(*ListMeetingPointsResponse)(nil),  // 11: proto.v1.ListMeetingPointsResponse
+	(*DeleteMeetingPointRequest)(nil),  // 12: proto.v1.DeleteMeetingPointRequest
+	(*DeleteMeetingPointResponse)(nil), // 13: proto.v1.DeleteMeetingPointResponse
+	(*SnapToMeetingPointRequest)(nil),  // 14: proto.v1.SnapToMeetingPointRequest
+	(*SnapToMeetingPointResponse)(nil), // 15: proto.v1.SnapToMeetingPointResponse
+	(*timestamppb.Timestamp)(nil),      // 16: google.protobuf.Timestamp
+}
+var file_proto_v1_area_proto_depIdxs = []int32{
+	16, // 0: proto.v1.ServiceZone.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: proto.v1.CreateZoneResponse.zone:type_name -> proto.v1.ServiceZone
+	0,  // 2: proto.v1.ListZonesResponse.zones:type_name -> proto.v1.ServiceZone
+	1,  // 3: proto.v1.CreateMeetingPointResponse.point:type_name -> proto.v1.MeetingPoint
+	1,  // 4: proto.v1.ListMeetingPointsResponse.points:type_name -> proto.v1.MeetingPoint
+	1,  // 5: proto.v1.SnapToMeetingPointResponse.point:type_name -> proto.v1.MeetingPoint
+	2,  // 6: proto.v1.AreaService.CreateZone:input_type -> proto.v1.CreateZoneRequest
+	4,  // 7: proto.v1.AreaService.ListZones:input_type -> proto.v1.ListZonesRequest
+	6,  // 8: proto.v1.AreaService.DeleteZone:input_type -> proto.v1.DeleteZoneRequest
+	8,  // 9: proto.v1.AreaService.CreateMeetingPoint:input_type -> proto.v1.CreateMeetingPointRequest
+	10, // 10: proto.v1.AreaService.ListMeetingPoints:input_type -> proto.v1.ListMeetingPointsRequest
+	12, // 11: proto.v1.AreaService.DeleteMeetingPoint:input_type -> proto.v1.DeleteMeetingPointRequest
+	14, // 12: proto.v1.AreaService.SnapToMeetingPoint:input_type -> proto.v1.SnapToMeetingPointRequest
+	3,  // 13: proto.v1.AreaService.CreateZone:output_type -> proto.v1.CreateZoneResponse
+	5,  // 14: proto.v1.AreaService.ListZones:output_type -> proto.v1.ListZonesResponse
+	7,  // 15: proto.v1.AreaService.DeleteZone:output_type -> proto.v1.DeleteZoneResponse
+	9,  // 16: proto.v1.AreaService.CreateMeetingPoint:output_type -> proto.v1.CreateMeetingPointResponse
+	11, // 17: proto.v1.AreaService.ListMeetingPoints:output_type -> proto.v1.ListMeetingPointsResponse
+	13, // 18: proto.v1.AreaService.DeleteMeetingPoint:output_type -> proto.v1.DeleteMeetingPointResponse
+	15, // 19: proto.v1.AreaService.SnapToMeetingPoint:output_type -> proto.v1.SnapToMeetingPointResponse
+	13, // [13:20] is the sub-list for method output_type
+	6,  // [6:13] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_area_proto_init() }
+func file_proto_v1_area_proto_init() {
+	if File_proto_v1_area_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_area_proto_rawDesc), len(file_proto_v1_area_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_area_proto_goTypes,
+		DependencyIndexes: file_proto_v1_area_proto_depIdxs,
+		MessageInfos:      file_proto_v1_area_proto_msgTypes,
+	}.Build()
+	File_proto_v1_area_proto = out.File
+	file_proto_v1_area_proto_goTypes = nil
+	file_proto_v1_area_proto_depIdxs = nil
+}
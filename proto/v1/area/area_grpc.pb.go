@@ -0,0 +1,357 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: proto/v1/area.proto
+
+package area
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AreaService_CreateZone_FullMethodName         = "/proto.v1.AreaService/CreateZone"
+	AreaService_ListZones_FullMethodName          = "/proto.v1.AreaService/ListZones"
+	AreaService_DeleteZone_FullMethodName         = "/proto.v1.AreaService/DeleteZone"
+	AreaService_CreateMeetingPoint_FullMethodName = "/proto.v1.AreaService/CreateMeetingPoint"
+	AreaService_ListMeetingPoints_FullMethodName  = "/proto.v1.AreaService/ListMeetingPoints"
+	AreaService_DeleteMeetingPoint_FullMethodName = "/proto.v1.AreaService/DeleteMeetingPoint"
+	AreaService_SnapToMeetingPoint_FullMethodName = "/proto.v1.AreaService/SnapToMeetingPoint"
+)
+
+// AreaServiceClient is the client API for AreaService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AreaService manages where rides may go: service zones restrict offer and
+// request origins/destinations per org, meeting points are named pickup
+// spots rides can reference. Writes are admin only.
+type AreaServiceClient interface {
+	CreateZone(ctx context.Context, in *CreateZoneRequest, opts ...grpc.CallOption) (*CreateZoneResponse, error)
+	ListZones(ctx context.Context, in *ListZonesRequest, opts ...grpc.CallOption) (*ListZonesResponse, error)
+	DeleteZone(ctx context.Context, in *DeleteZoneRequest, opts ...grpc.CallOption) (*DeleteZoneResponse, error)
+	CreateMeetingPoint(ctx context.Context, in *CreateMeetingPointRequest, opts ...grpc.CallOption) (*CreateMeetingPointResponse, error)
+	ListMeetingPoints(ctx context.Context, in *ListMeetingPointsRequest, opts ...grpc.CallOption) (*ListMeetingPointsResponse, error)
+	DeleteMeetingPoint(ctx context.Context, in *DeleteMeetingPointRequest, opts ...grpc.CallOption) (*DeleteMeetingPointResponse, error)
+	SnapToMeetingPoint(ctx context.Context, in *SnapToMeetingPointRequest, opts ...grpc.CallOption) (*SnapToMeetingPointResponse, error)
+}
+
+type areaServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAreaServiceClient(cc grpc.ClientConnInterface) AreaServiceClient {
+	return &areaServiceClient{cc}
+}
+
+func (c *areaServiceClient) CreateZone(ctx context.Context, in *CreateZoneRequest, opts ...grpc.CallOption) (*CreateZoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateZoneResponse)
+	err := c.cc.Invoke(ctx, AreaService_CreateZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *areaServiceClient) ListZones(ctx context.Context, in *ListZonesRequest, opts ...grpc.CallOption) (*ListZonesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListZonesResponse)
+	err := c.cc.Invoke(ctx, AreaService_ListZones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *areaServiceClient) DeleteZone(ctx context.Context, in *DeleteZoneRequest, opts ...grpc.CallOption) (*DeleteZoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteZoneResponse)
+	err := c.cc.Invoke(ctx, AreaService_DeleteZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *areaServiceClient) CreateMeetingPoint(ctx context.Context, in *CreateMeetingPointRequest, opts ...grpc.CallOption) (*CreateMeetingPointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMeetingPointResponse)
+	err := c.cc.Invoke(ctx, AreaService_CreateMeetingPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *areaServiceClient) ListMeetingPoints(ctx context.Context, in *ListMeetingPointsRequest, opts ...grpc.CallOption) (*ListMeetingPointsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMeetingPointsResponse)
+	err := c.cc.Invoke(ctx, AreaService_ListMeetingPoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *areaServiceClient) DeleteMeetingPoint(ctx context.Context, in *DeleteMeetingPointRequest, opts ...grpc.CallOption) (*DeleteMeetingPointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMeetingPointResponse)
+	err := c.cc.Invoke(ctx, AreaService_DeleteMeetingPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *areaServiceClient) SnapToMeetingPoint(ctx context.Context, in *SnapToMeetingPointRequest, opts ...grpc.CallOption) (*SnapToMeetingPointResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnapToMeetingPointResponse)
+	err := c.cc.Invoke(ctx, AreaService_SnapToMeetingPoint_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AreaServiceServer is the server API for AreaService service.
+// All implementations must embed UnimplementedAreaServiceServer
+// for forward compatibility.
+//
+// AreaService manages where rides may go: service zones restrict offer and
+// request origins/destinations per org, meeting points are named pickup
+// spots rides can reference. Writes are admin only.
+type AreaServiceServer interface {
+	CreateZone(context.Context, *CreateZoneRequest) (*CreateZoneResponse, error)
+	ListZones(context.Context, *ListZonesRequest) (*ListZonesResponse, error)
+	DeleteZone(context.Context, *DeleteZoneRequest) (*DeleteZoneResponse, error)
+	CreateMeetingPoint(context.Context, *CreateMeetingPointRequest) (*CreateMeetingPointResponse, error)
+	ListMeetingPoints(context.Context, *ListMeetingPointsRequest) (*ListMeetingPointsResponse, error)
+	DeleteMeetingPoint(context.Context, *DeleteMeetingPointRequest) (*DeleteMeetingPointResponse, error)
+	SnapToMeetingPoint(context.Context, *SnapToMeetingPointRequest) (*SnapToMeetingPointResponse, error)
+	mustEmbedUnimplementedAreaServiceServer()
+}
+
+// UnimplementedAreaServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAreaServiceServer struct{}
+
+func (UnimplementedAreaServiceServer) CreateZone(context.Context, *CreateZoneRequest) (*CreateZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateZone not implemented")
+}
+func (UnimplementedAreaServiceServer) ListZones(context.Context, *ListZonesRequest) (*ListZonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListZones not implemented")
+}
+func (UnimplementedAreaServiceServer) DeleteZone(context.Context, *DeleteZoneRequest) (*DeleteZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteZone not implemented")
+}
+func (UnimplementedAreaServiceServer) CreateMeetingPoint(context.Context, *CreateMeetingPointRequest) (*CreateMeetingPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMeetingPoint not implemented")
+}
+func (UnimplementedAreaServiceServer) ListMeetingPoints(context.Context, *ListMeetingPointsRequest) (*ListMeetingPointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMeetingPoints not implemented")
+}
+func (UnimplementedAreaServiceServer) DeleteMeetingPoint(context.Context, *DeleteMeetingPointRequest) (*DeleteMeetingPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMeetingPoint not implemented")
+}
+func (UnimplementedAreaServiceServer) SnapToMeetingPoint(context.Context, *SnapToMeetingPointRequest) (*SnapToMeetingPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapToMeetingPoint not implemented")
+}
+func (UnimplementedAreaServiceServer) mustEmbedUnimplementedAreaServiceServer() {}
+func (UnimplementedAreaServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeAreaServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AreaServiceServer will
+// result in compilation errors.
+type UnsafeAreaServiceServer interface {
+	mustEmbedUnimplementedAreaServiceServer()
+}
+
+func RegisterAreaServiceServer(s grpc.ServiceRegistrar, srv AreaServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAreaServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AreaService_ServiceDesc, srv)
+}
+
+func _AreaService_CreateZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AreaServiceServer).CreateZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AreaService_CreateZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AreaServiceServer).CreateZone(ctx, req.(*CreateZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AreaService_ListZones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListZonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AreaServiceServer).ListZones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AreaService_ListZones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AreaServiceServer).ListZones(ctx, req.(*ListZonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AreaService_DeleteZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AreaServiceServer).DeleteZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AreaService_DeleteZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AreaServiceServer).DeleteZone(ctx, req.(*DeleteZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AreaService_CreateMeetingPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMeetingPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AreaServiceServer).CreateMeetingPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AreaService_CreateMeetingPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AreaServiceServer).CreateMeetingPoint(ctx, req.(*CreateMeetingPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AreaService_ListMeetingPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMeetingPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AreaServiceServer).ListMeetingPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AreaService_ListMeetingPoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AreaServiceServer).ListMeetingPoints(ctx, req.(*ListMeetingPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AreaService_DeleteMeetingPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMeetingPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AreaServiceServer).DeleteMeetingPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AreaService_DeleteMeetingPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AreaServiceServer).DeleteMeetingPoint(ctx, req.(*DeleteMeetingPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AreaService_SnapToMeetingPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapToMeetingPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AreaServiceServer).SnapToMeetingPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AreaService_SnapToMeetingPoint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AreaServiceServer).SnapToMeetingPoint(ctx, req.(*SnapToMeetingPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AreaService_ServiceDesc is the grpc.ServiceDesc for AreaService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AreaService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.v1.AreaService",
+	HandlerType: (*AreaServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateZone",
+			Handler:    _AreaService_CreateZone_Handler,
+		},
+		{
+			MethodName: "ListZones",
+			Handler:    _AreaService_ListZones_Handler,
+		},
+		{
+			MethodName: "DeleteZone",
+			Handler:    _AreaService_DeleteZone_Handler,
+		},
+		{
+			MethodName: "CreateMeetingPoint",
+			Handler:    _AreaService_CreateMeetingPoint_Handler,
+		},
+		{
+			MethodName: "ListMeetingPoints",
+			Handler:    _AreaService_ListMeetingPoints_Handler,
+		},
+		{
+			MethodName: "DeleteMeetingPoint",
+			Handler:    _AreaService_DeleteMeetingPoint_Handler,
+		},
+		{
+			MethodName: "SnapToMeetingPoint",
+			Handler:    _AreaService_SnapToMeetingPoint_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/v1/area.proto",
+}
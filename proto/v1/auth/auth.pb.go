@@ -22,9 +22,11 @@ const (
 )
 
 // login LoginRequest has googleid token obtained by the client
+// invite_code is only needed for users outside the allowlisted domains
 type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	IdToken       string                 `protobuf:"bytes,1,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	InviteCode    string                 `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,6 +68,13 @@ func (x *LoginRequest) GetIdToken() string {
 	return ""
 }
 
+func (x *LoginRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
 // on login response, it gives jwt along with details extracted after validating googleid token
 // after extracting it creates a account in the db, and sends user details and jwt token as a response
 type LoginResponse struct {
@@ -140,9 +149,11 @@ var File_proto_v1_auth_proto protoreflect.FileDescriptor
 
 const file_proto_v1_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/v1/auth.proto\x12\bproto.v1\")\n" +
+	"\x13proto/v1/auth.proto\x12\bproto.v1\"J\n" +
 	"\fLoginRequest\x12\x19\n" +
-	"\bid_token\x18\x01 \x01(\tR\aidToken\"l\n" +
+	"\bid_token\x18\x01 \x01(\tR\aidToken\x12\x1f\n" +
+	"\vinvite_code\x18\x02 \x01(\tR\n" +
+	"inviteCode\"l\n" +
 	"\rLoginResponse\x12\x10\n" +
 	"\x03jwt\x18\x01 \x01(\tR\x03jwt\x12\x16\n" +
 	"\x06userid\x18\x02 \x01(\tR\x06userid\x12\x14\n" +
@@ -23,12 +23,14 @@ const (
 )
 
 type UserLocation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Geohash       string                 `protobuf:"bytes,4,opt,name=geohash,proto3" json:"geohash,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Latitude  float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Geohash   string                 `protobuf:"bytes,4,opt,name=geohash,proto3" json:"geohash,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// coarse is true when the viewer only gets the fuzzed point
+	Coarse        bool `protobuf:"varint,6,opt,name=coarse,proto3" json:"coarse,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,6 +100,126 @@ func (x *UserLocation) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UserLocation) GetCoarse() bool {
+	if x != nil {
+		return x.Coarse
+	}
+	return false
+}
+
+// visibility is one of nobody, matched, org, everyone
+type LocationSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Visibility    string                 `protobuf:"bytes,1,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationSettings) Reset() {
+	*x = LocationSettings{}
+	mi := &file_proto_v1_location_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationSettings) ProtoMessage() {}
+
+func (x *LocationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_location_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationSettings.ProtoReflect.Descriptor instead.
+func (*LocationSettings) Descriptor() ([]byte, []int) {
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LocationSettings) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *LocationSettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type LocationView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ViewerId      string                 `protobuf:"bytes,1,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`
+	Precise       bool                   `protobuf:"varint,2,opt,name=precise,proto3" json:"precise,omitempty"`
+	ViewedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=viewed_at,json=viewedAt,proto3" json:"viewed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationView) Reset() {
+	*x = LocationView{}
+	mi := &file_proto_v1_location_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationView) ProtoMessage() {}
+
+func (x *LocationView) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_location_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationView.ProtoReflect.Descriptor instead.
+func (*LocationView) Descriptor() ([]byte, []int) {
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LocationView) GetViewerId() string {
+	if x != nil {
+		return x.ViewerId
+	}
+	return ""
+}
+
+func (x *LocationView) GetPrecise() bool {
+	if x != nil {
+		return x.Precise
+	}
+	return false
+}
+
+func (x *LocationView) GetViewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ViewedAt
+	}
+	return nil
+}
+
 type UpsertLocationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
@@ -109,7 +231,7 @@ type UpsertLocationRequest struct {
 
 func (x *UpsertLocationRequest) Reset() {
 	*x = UpsertLocationRequest{}
-	mi := &file_proto_v1_location_proto_msgTypes[1]
+	mi := &file_proto_v1_location_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -121,7 +243,7 @@ func (x *UpsertLocationRequest) String() string {
 func (*UpsertLocationRequest) ProtoMessage() {}
 
 func (x *UpsertLocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_location_proto_msgTypes[1]
+	mi := &file_proto_v1_location_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -134,7 +256,7 @@ func (x *UpsertLocationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertLocationRequest.ProtoReflect.Descriptor instead.
 func (*UpsertLocationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_location_proto_rawDescGZIP(), []int{1}
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UpsertLocationRequest) GetLatitude() float64 {
@@ -167,7 +289,7 @@ type UpsertLocationResponse struct {
 
 func (x *UpsertLocationResponse) Reset() {
 	*x = UpsertLocationResponse{}
-	mi := &file_proto_v1_location_proto_msgTypes[2]
+	mi := &file_proto_v1_location_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -179,7 +301,7 @@ func (x *UpsertLocationResponse) String() string {
 func (*UpsertLocationResponse) ProtoMessage() {}
 
 func (x *UpsertLocationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_location_proto_msgTypes[2]
+	mi := &file_proto_v1_location_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -192,7 +314,7 @@ func (x *UpsertLocationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpsertLocationResponse.ProtoReflect.Descriptor instead.
 func (*UpsertLocationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_location_proto_rawDescGZIP(), []int{2}
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpsertLocationResponse) GetLocation() *UserLocation {
@@ -211,7 +333,7 @@ type GetLocationByUserRequest struct {
 
 func (x *GetLocationByUserRequest) Reset() {
 	*x = GetLocationByUserRequest{}
-	mi := &file_proto_v1_location_proto_msgTypes[3]
+	mi := &file_proto_v1_location_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -223,7 +345,7 @@ func (x *GetLocationByUserRequest) String() string {
 func (*GetLocationByUserRequest) ProtoMessage() {}
 
 func (x *GetLocationByUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_location_proto_msgTypes[3]
+	mi := &file_proto_v1_location_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -236,7 +358,7 @@ func (x *GetLocationByUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLocationByUserRequest.ProtoReflect.Descriptor instead.
 func (*GetLocationByUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_location_proto_rawDescGZIP(), []int{3}
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetLocationByUserRequest) GetUserId() string {
@@ -255,7 +377,7 @@ type GetLocationByUserResponse struct {
 
 func (x *GetLocationByUserResponse) Reset() {
 	*x = GetLocationByUserResponse{}
-	mi := &file_proto_v1_location_proto_msgTypes[4]
+	mi := &file_proto_v1_location_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -267,7 +389,7 @@ func (x *GetLocationByUserResponse) String() string {
 func (*GetLocationByUserResponse) ProtoMessage() {}
 
 func (x *GetLocationByUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_location_proto_msgTypes[4]
+	mi := &file_proto_v1_location_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,7 +402,7 @@ func (x *GetLocationByUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLocationByUserResponse.ProtoReflect.Descriptor instead.
 func (*GetLocationByUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_location_proto_rawDescGZIP(), []int{4}
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetLocationByUserResponse) GetLocation() *UserLocation {
@@ -294,13 +416,17 @@ type ListNearbyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GeohashPrefix string                 `protobuf:"bytes,1,opt,name=geohash_prefix,json=geohashPrefix,proto3" json:"geohash_prefix,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// with radius_meters set, search this circle instead, closest first
+	Latitude      float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64 `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusMeters  float64 `protobuf:"fixed64,5,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListNearbyRequest) Reset() {
 	*x = ListNearbyRequest{}
-	mi := &file_proto_v1_location_proto_msgTypes[5]
+	mi := &file_proto_v1_location_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -312,7 +438,7 @@ func (x *ListNearbyRequest) String() string {
 func (*ListNearbyRequest) ProtoMessage() {}
 
 func (x *ListNearbyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_location_proto_msgTypes[5]
+	mi := &file_proto_v1_location_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -325,7 +451,7 @@ func (x *ListNearbyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNearbyRequest.ProtoReflect.Descriptor instead.
 func (*ListNearbyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_location_proto_rawDescGZIP(), []int{5}
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListNearbyRequest) GetGeohashPrefix() string {
@@ -342,6 +468,27 @@ func (x *ListNearbyRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListNearbyRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *ListNearbyRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *ListNearbyRequest) GetRadiusMeters() float64 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
 type ListNearbyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Locations     []*UserLocation        `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
@@ -351,7 +498,7 @@ type ListNearbyResponse struct {
 
 func (x *ListNearbyResponse) Reset() {
 	*x = ListNearbyResponse{}
-	mi := &file_proto_v1_location_proto_msgTypes[6]
+	mi := &file_proto_v1_location_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -363,7 +510,7 @@ func (x *ListNearbyResponse) String() string {
 func (*ListNearbyResponse) ProtoMessage() {}
 
 func (x *ListNearbyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_location_proto_msgTypes[6]
+	mi := &file_proto_v1_location_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -376,7 +523,7 @@ func (x *ListNearbyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNearbyResponse.ProtoReflect.Descriptor instead.
 func (*ListNearbyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_location_proto_rawDescGZIP(), []int{6}
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListNearbyResponse) GetLocations() []*UserLocation {
@@ -394,7 +541,7 @@ type DeleteMyLocationRequest struct {
 
 func (x *DeleteMyLocationRequest) Reset() {
 	*x = DeleteMyLocationRequest{}
-	mi := &file_proto_v1_location_proto_msgTypes[7]
+	mi := &file_proto_v1_location_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -406,7 +553,7 @@ func (x *DeleteMyLocationRequest) String() string {
 func (*DeleteMyLocationRequest) ProtoMessage() {}
 
 func (x *DeleteMyLocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_location_proto_msgTypes[7]
+	mi := &file_proto_v1_location_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +566,7 @@ func (x *DeleteMyLocationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMyLocationRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMyLocationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_location_proto_rawDescGZIP(), []int{7}
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{9}
 }
 
 type DeleteMyLocationResponse struct {
@@ -431,7 +578,7 @@ type DeleteMyLocationResponse struct {
 
 func (x *DeleteMyLocationResponse) Reset() {
 	*x = DeleteMyLocationResponse{}
-	mi := &file_proto_v1_location_proto_msgTypes[8]
+	mi := &file_proto_v1_location_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -443,7 +590,7 @@ func (x *DeleteMyLocationResponse) String() string {
 func (*DeleteMyLocationResponse) ProtoMessage() {}
 
 func (x *DeleteMyLocationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_location_proto_msgTypes[8]
+	mi := &file_proto_v1_location_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,7 +603,7 @@ func (x *DeleteMyLocationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMyLocationResponse.ProtoReflect.Descriptor instead.
 func (*DeleteMyLocationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_location_proto_rawDescGZIP(), []int{8}
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DeleteMyLocationResponse) GetSuccess() bool {
@@ -466,18 +613,293 @@ func (x *DeleteMyLocationResponse) GetSuccess() bool {
 	return false
 }
 
+type GetLocationSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocationSettingsRequest) Reset() {
+	*x = GetLocationSettingsRequest{}
+	mi := &file_proto_v1_location_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocationSettingsRequest) ProtoMessage() {}
+
+func (x *GetLocationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_location_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetLocationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{11}
+}
+
+type GetLocationSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *LocationSettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocationSettingsResponse) Reset() {
+	*x = GetLocationSettingsResponse{}
+	mi := &file_proto_v1_location_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocationSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocationSettingsResponse) ProtoMessage() {}
+
+func (x *GetLocationSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_location_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocationSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetLocationSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetLocationSettingsResponse) GetSettings() *LocationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateLocationSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Visibility    string                 `protobuf:"bytes,1,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLocationSettingsRequest) Reset() {
+	*x = UpdateLocationSettingsRequest{}
+	mi := &file_proto_v1_location_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLocationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocationSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateLocationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_location_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLocationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateLocationSettingsRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+type UpdateLocationSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *LocationSettings      `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLocationSettingsResponse) Reset() {
+	*x = UpdateLocationSettingsResponse{}
+	mi := &file_proto_v1_location_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLocationSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocationSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateLocationSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_location_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocationSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLocationSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateLocationSettingsResponse) GetSettings() *LocationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type ListLocationViewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Before        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLocationViewsRequest) Reset() {
+	*x = ListLocationViewsRequest{}
+	mi := &file_proto_v1_location_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLocationViewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocationViewsRequest) ProtoMessage() {}
+
+func (x *ListLocationViewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_location_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocationViewsRequest.ProtoReflect.Descriptor instead.
+func (*ListLocationViewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListLocationViewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListLocationViewsRequest) GetBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+type ListLocationViewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Views         []*LocationView        `protobuf:"bytes,1,rep,name=views,proto3" json:"views,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLocationViewsResponse) Reset() {
+	*x = ListLocationViewsResponse{}
+	mi := &file_proto_v1_location_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLocationViewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocationViewsResponse) ProtoMessage() {}
+
+func (x *ListLocationViewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_location_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocationViewsResponse.ProtoReflect.Descriptor instead.
+func (*ListLocationViewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_location_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListLocationViewsResponse) GetViews() []*LocationView {
+	if x != nil {
+		return x.Views
+	}
+	return nil
+}
+
 var File_proto_v1_location_proto protoreflect.FileDescriptor
 
 const file_proto_v1_location_proto_rawDesc = "" +
 	"\n" +
-	"\x17proto/v1/location.proto\x12\bproto.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb6\x01\n" +
+	"\x17proto/v1/location.proto\x12\bproto.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xce\x01\n" +
 	"\fUserLocation\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
 	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
 	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x12\x18\n" +
 	"\ageohash\x18\x04 \x01(\tR\ageohash\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"k\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06coarse\x18\x06 \x01(\bR\x06coarse\"m\n" +
+	"\x10LocationSettings\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x01 \x01(\tR\n" +
+	"visibility\x129\n" +
+	"\n" +
+	"updated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"~\n" +
+	"\fLocationView\x12\x1b\n" +
+	"\tviewer_id\x18\x01 \x01(\tR\bviewerId\x12\x18\n" +
+	"\aprecise\x18\x02 \x01(\bR\aprecise\x127\n" +
+	"\tviewed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bviewedAt\"k\n" +
 	"\x15UpsertLocationRequest\x12\x1a\n" +
 	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
 	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x18\n" +
@@ -487,21 +909,41 @@ const file_proto_v1_location_proto_rawDesc = "" +
 	"\x18GetLocationByUserRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"O\n" +
 	"\x19GetLocationByUserResponse\x122\n" +
-	"\blocation\x18\x01 \x01(\v2\x16.proto.v1.UserLocationR\blocation\"P\n" +
+	"\blocation\x18\x01 \x01(\v2\x16.proto.v1.UserLocationR\blocation\"\xaf\x01\n" +
 	"\x11ListNearbyRequest\x12%\n" +
 	"\x0egeohash_prefix\x18\x01 \x01(\tR\rgeohashPrefix\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"J\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\x12#\n" +
+	"\rradius_meters\x18\x05 \x01(\x01R\fradiusMeters\"J\n" +
 	"\x12ListNearbyResponse\x124\n" +
 	"\tlocations\x18\x01 \x03(\v2\x16.proto.v1.UserLocationR\tlocations\"\x19\n" +
 	"\x17DeleteMyLocationRequest\"4\n" +
 	"\x18DeleteMyLocationResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xf0\x02\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1c\n" +
+	"\x1aGetLocationSettingsRequest\"U\n" +
+	"\x1bGetLocationSettingsResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.proto.v1.LocationSettingsR\bsettings\"?\n" +
+	"\x1dUpdateLocationSettingsRequest\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x01 \x01(\tR\n" +
+	"visibility\"X\n" +
+	"\x1eUpdateLocationSettingsResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.proto.v1.LocationSettingsR\bsettings\"d\n" +
+	"\x18ListLocationViewsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x122\n" +
+	"\x06before\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06before\"I\n" +
+	"\x19ListLocationViewsResponse\x12,\n" +
+	"\x05views\x18\x01 \x03(\v2\x16.proto.v1.LocationViewR\x05views2\xa5\x05\n" +
 	"\x0fLocationService\x12U\n" +
 	"\x0eUpsertLocation\x12\x1f.proto.v1.UpsertLocationRequest\x1a .proto.v1.UpsertLocationResponse\"\x00\x12^\n" +
 	"\x11GetLocationByUser\x12\".proto.v1.GetLocationByUserRequest\x1a#.proto.v1.GetLocationByUserResponse\"\x00\x12I\n" +
 	"\n" +
 	"ListNearby\x12\x1b.proto.v1.ListNearbyRequest\x1a\x1c.proto.v1.ListNearbyResponse\"\x00\x12[\n" +
-	"\x10DeleteMyLocation\x12!.proto.v1.DeleteMyLocationRequest\x1a\".proto.v1.DeleteMyLocationResponse\"\x00B\x15Z\x13./proto/v1/locationb\x06proto3"
+	"\x10DeleteMyLocation\x12!.proto.v1.DeleteMyLocationRequest\x1a\".proto.v1.DeleteMyLocationResponse\"\x00\x12d\n" +
+	"\x13GetLocationSettings\x12$.proto.v1.GetLocationSettingsRequest\x1a%.proto.v1.GetLocationSettingsResponse\"\x00\x12m\n" +
+	"\x16UpdateLocationSettings\x12'.proto.v1.UpdateLocationSettingsRequest\x1a(.proto.v1.UpdateLocationSettingsResponse\"\x00\x12^\n" +
+	"\x11ListLocationViews\x12\".proto.v1.ListLocationViewsRequest\x1a#.proto.v1.ListLocationViewsResponse\"\x00B\x15Z\x13./proto/v1/locationb\x06proto3"
 
 var (
 	file_proto_v1_location_proto_rawDescOnce sync.Once
@@ -515,37 +957,57 @@ func file_proto_v1_location_proto_rawDescGZIP() []byte {
 	return file_proto_v1_location_proto_rawDescData
 }
 
-var file_proto_v1_location_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_v1_location_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_proto_v1_location_proto_goTypes = []any{
-	(*UserLocation)(nil),              // 0: proto.v1.UserLocation
-	(*UpsertLocationRequest)(nil),     // 1: proto.v1.UpsertLocationRequest
-	(*UpsertLocationResponse)(nil),    // 2: proto.v1.UpsertLocationResponse
-	(*GetLocationByUserRequest)(nil),  // 3: proto.v1.GetLocationByUserRequest
-	(*GetLocationByUserResponse)(nil), // 4: proto.v1.GetLocationByUserResponse
-	(*ListNearbyRequest)(nil),         // 5: proto.v1.ListNearbyRequest
-	(*ListNearbyResponse)(nil),        // 6: proto.v1.ListNearbyResponse
-	(*DeleteMyLocationRequest)(nil),   // 7: proto.v1.DeleteMyLocationRequest
-	(*DeleteMyLocationResponse)(nil),  // 8: proto.v1.DeleteMyLocationResponse
-	(*timestamppb.Timestamp)(nil),     // 9: google.protobuf.Timestamp
+	(*UserLocation)(nil),                   // 0: proto.v1.UserLocation
+	(*LocationSettings)(nil),               // 1: proto.v1.LocationSettings
+	(*LocationView)(nil),                   // 2: proto.v1.LocationView
+	(*UpsertLocationRequest)(nil),          // 3: proto.v1.UpsertLocationRequest
+	(*UpsertLocationResponse)(nil),         // 4: proto.v1.UpsertLocationResponse
+	(*GetLocationByUserRequest)(nil),       // 5: proto.v1.GetLocationByUserRequest
+	(*GetLocationByUserResponse)(nil),      // 6: proto.v1.GetLocationByUserResponse
+	(*ListNearbyRequest)(nil),              // 7: proto.v1.ListNearbyRequest
+	(*ListNearbyResponse)(nil),             // 8: proto.v1.ListNearbyResponse
+	(*DeleteMyLocationRequest)(nil),        // 9: proto.v1.DeleteMyLocationRequest
+	(*DeleteMyLocationResponse)(nil),       // 10: proto.v1.DeleteMyLocationResponse
+	(*GetLocationSettingsRequest)(nil),     // 11: proto.v1.GetLocationSettingsRequest
+	(*GetLocationSettingsResponse)(nil),    // 12: proto.v1.GetLocationSettingsResponse
+	(*UpdateLocationSettingsRequest)(nil),  // 13: proto.v1.UpdateLocationSettingsRequest
+	(*UpdateLocationSettingsResponse)(nil), // 14: proto.v1.UpdateLocationSettingsResponse
+	(*ListLocationViewsRequest)(nil),       // 15: proto.v1.ListLocationViewsRequest
+	(*ListLocationViewsResponse)(nil),      // 16: proto.v1.ListLocationViewsResponse
+	(*timestamppb.Timestamp)(nil),          // 17: google.protobuf.Timestamp
 }
 var file_proto_v1_location_proto_depIdxs = []int32{
-	9, // 0: proto.v1.UserLocation.updated_at:type_name -> google.protobuf.Timestamp
-	0, // 1: proto.v1.UpsertLocationResponse.location:type_name -> proto.v1.UserLocation
-	0, // 2: proto.v1.GetLocationByUserResponse.location:type_name -> proto.v1.UserLocation
-	0, // 3: proto.v1.ListNearbyResponse.locations:type_name -> proto.v1.UserLocation
-	1, // 4: proto.v1.LocationService.UpsertLocation:input_type -> proto.v1.UpsertLocationRequest
-	3, // 5: proto.v1.LocationService.GetLocationByUser:input_type -> proto.v1.GetLocationByUserRequest
-	5, // 6: proto.v1.LocationService.ListNearby:input_type -> proto.v1.ListNearbyRequest
-	7, // 7: proto.v1.LocationService.DeleteMyLocation:input_type -> proto.v1.DeleteMyLocationRequest
-	2, // 8: proto.v1.LocationService.UpsertLocation:output_type -> proto.v1.UpsertLocationResponse
-	4, // 9: proto.v1.LocationService.GetLocationByUser:output_type -> proto.v1.GetLocationByUserResponse
-	6, // 10: proto.v1.LocationService.ListNearby:output_type -> proto.v1.ListNearbyResponse
-	8, // 11: proto.v1.LocationService.DeleteMyLocation:output_type -> proto.v1.DeleteMyLocationResponse
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	17, // 0: proto.v1.UserLocation.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 1: proto.v1.LocationSettings.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 2: proto.v1.LocationView.viewed_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: proto.v1.UpsertLocationResponse.location:type_name -> proto.v1.UserLocation
+	0,  // 4: proto.v1.GetLocationByUserResponse.location:type_name -> proto.v1.UserLocation
+	0,  // 5: proto.v1.ListNearbyResponse.locations:type_name -> proto.v1.UserLocation
+	1,  // 6: proto.v1.GetLocationSettingsResponse.settings:type_name -> proto.v1.LocationSettings
+	1,  // 7: proto.v1.UpdateLocationSettingsResponse.settings:type_name -> proto.v1.LocationSettings
+	17, // 8: proto.v1.ListLocationViewsRequest.before:type_name -> google.protobuf.Timestamp
+	2,  // 9: proto.v1.ListLocationViewsResponse.views:type_name -> proto.v1.LocationView
+	3,  // 10: proto.v1.LocationService.UpsertLocation:input_type -> proto.v1.UpsertLocationRequest
+	5,  // 11: proto.v1.LocationService.GetLocationByUser:input_type -> proto.v1.GetLocationByUserRequest
+	7,  // 12: proto.v1.LocationService.ListNearby:input_type -> proto.v1.ListNearbyRequest
+	9,  // 13: proto.v1.LocationService.DeleteMyLocation:input_type -> proto.v1.DeleteMyLocationRequest
+	11, // 14: proto.v1.LocationService.GetLocationSettings:input_type -> proto.v1.GetLocationSettingsRequest
+	13, // 15: proto.v1.LocationService.UpdateLocationSettings:input_type -> proto.v1.UpdateLocationSettingsRequest
+	15, // 16: proto.v1.LocationService.ListLocationViews:input_type -> proto.v1.ListLocationViewsRequest
+	4,  // 17: proto.v1.LocationService.UpsertLocation:output_type -> proto.v1.UpsertLocationResponse
+	6,  // 18: proto.v1.LocationService.GetLocationByUser:output_type -> proto.v1.GetLocationByUserResponse
+	8,  // 19: proto.v1.LocationService.ListNearby:output_type -> proto.v1.ListNearbyResponse
+	10, // 20: proto.v1.LocationService.DeleteMyLocation:output_type -> proto.v1.DeleteMyLocationResponse
+	12, // 21: proto.v1.LocationService.GetLocationSettings:output_type -> proto.v1.GetLocationSettingsResponse
+	14, // 22: proto.v1.LocationService.UpdateLocationSettings:output_type -> proto.v1.UpdateLocationSettingsResponse
+	16, // 23: proto.v1.LocationService.ListLocationViews:output_type -> proto.v1.ListLocationViewsResponse
+	17, // [17:24] is the sub-list for method output_type
+	10, // [10:17] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_location_proto_init() }
@@ -559,7 +1021,7 @@ func file_proto_v1_location_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_location_proto_rawDesc), len(file_proto_v1_location_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
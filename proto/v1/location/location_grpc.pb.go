@@ -19,10 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LocationService_UpsertLocation_FullMethodName    = "/proto.v1.LocationService/UpsertLocation"
-	LocationService_GetLocationByUser_FullMethodName = "/proto.v1.LocationService/GetLocationByUser"
-	LocationService_ListNearby_FullMethodName        = "/proto.v1.LocationService/ListNearby"
-	LocationService_DeleteMyLocation_FullMethodName  = "/proto.v1.LocationService/DeleteMyLocation"
+	LocationService_UpsertLocation_FullMethodName         = "/proto.v1.LocationService/UpsertLocation"
+	LocationService_GetLocationByUser_FullMethodName      = "/proto.v1.LocationService/GetLocationByUser"
+	LocationService_ListNearby_FullMethodName             = "/proto.v1.LocationService/ListNearby"
+	LocationService_DeleteMyLocation_FullMethodName       = "/proto.v1.LocationService/DeleteMyLocation"
+	LocationService_GetLocationSettings_FullMethodName    = "/proto.v1.LocationService/GetLocationSettings"
+	LocationService_UpdateLocationSettings_FullMethodName = "/proto.v1.LocationService/UpdateLocationSettings"
+	LocationService_ListLocationViews_FullMethodName      = "/proto.v1.LocationService/ListLocationViews"
 )
 
 // LocationServiceClient is the client API for LocationService service.
@@ -33,6 +36,9 @@ type LocationServiceClient interface {
 	GetLocationByUser(ctx context.Context, in *GetLocationByUserRequest, opts ...grpc.CallOption) (*GetLocationByUserResponse, error)
 	ListNearby(ctx context.Context, in *ListNearbyRequest, opts ...grpc.CallOption) (*ListNearbyResponse, error)
 	DeleteMyLocation(ctx context.Context, in *DeleteMyLocationRequest, opts ...grpc.CallOption) (*DeleteMyLocationResponse, error)
+	GetLocationSettings(ctx context.Context, in *GetLocationSettingsRequest, opts ...grpc.CallOption) (*GetLocationSettingsResponse, error)
+	UpdateLocationSettings(ctx context.Context, in *UpdateLocationSettingsRequest, opts ...grpc.CallOption) (*UpdateLocationSettingsResponse, error)
+	ListLocationViews(ctx context.Context, in *ListLocationViewsRequest, opts ...grpc.CallOption) (*ListLocationViewsResponse, error)
 }
 
 type locationServiceClient struct {
@@ -83,6 +89,36 @@ func (c *locationServiceClient) DeleteMyLocation(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *locationServiceClient) GetLocationSettings(ctx context.Context, in *GetLocationSettingsRequest, opts ...grpc.CallOption) (*GetLocationSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLocationSettingsResponse)
+	err := c.cc.Invoke(ctx, LocationService_GetLocationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) UpdateLocationSettings(ctx context.Context, in *UpdateLocationSettingsRequest, opts ...grpc.CallOption) (*UpdateLocationSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLocationSettingsResponse)
+	err := c.cc.Invoke(ctx, LocationService_UpdateLocationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationServiceClient) ListLocationViews(ctx context.Context, in *ListLocationViewsRequest, opts ...grpc.CallOption) (*ListLocationViewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLocationViewsResponse)
+	err := c.cc.Invoke(ctx, LocationService_ListLocationViews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LocationServiceServer is the server API for LocationService service.
 // All implementations must embed UnimplementedLocationServiceServer
 // for forward compatibility.
@@ -91,6 +127,9 @@ type LocationServiceServer interface {
 	GetLocationByUser(context.Context, *GetLocationByUserRequest) (*GetLocationByUserResponse, error)
 	ListNearby(context.Context, *ListNearbyRequest) (*ListNearbyResponse, error)
 	DeleteMyLocation(context.Context, *DeleteMyLocationRequest) (*DeleteMyLocationResponse, error)
+	GetLocationSettings(context.Context, *GetLocationSettingsRequest) (*GetLocationSettingsResponse, error)
+	UpdateLocationSettings(context.Context, *UpdateLocationSettingsRequest) (*UpdateLocationSettingsResponse, error)
+	ListLocationViews(context.Context, *ListLocationViewsRequest) (*ListLocationViewsResponse, error)
 	mustEmbedUnimplementedLocationServiceServer()
 }
 
@@ -113,6 +152,15 @@ func (UnimplementedLocationServiceServer) ListNearby(context.Context, *ListNearb
 func (UnimplementedLocationServiceServer) DeleteMyLocation(context.Context, *DeleteMyLocationRequest) (*DeleteMyLocationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMyLocation not implemented")
 }
+func (UnimplementedLocationServiceServer) GetLocationSettings(context.Context, *GetLocationSettingsRequest) (*GetLocationSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLocationSettings not implemented")
+}
+func (UnimplementedLocationServiceServer) UpdateLocationSettings(context.Context, *UpdateLocationSettingsRequest) (*UpdateLocationSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLocationSettings not implemented")
+}
+func (UnimplementedLocationServiceServer) ListLocationViews(context.Context, *ListLocationViewsRequest) (*ListLocationViewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLocationViews not implemented")
+}
 func (UnimplementedLocationServiceServer) mustEmbedUnimplementedLocationServiceServer() {}
 func (UnimplementedLocationServiceServer) testEmbeddedByValue()                         {}
 
@@ -206,6 +254,60 @@ func _LocationService_DeleteMyLocation_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LocationService_GetLocationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).GetLocationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_GetLocationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).GetLocationSettings(ctx, req.(*GetLocationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_UpdateLocationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLocationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).UpdateLocationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_UpdateLocationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).UpdateLocationSettings(ctx, req.(*UpdateLocationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationService_ListLocationViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLocationViewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationServiceServer).ListLocationViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LocationService_ListLocationViews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationServiceServer).ListLocationViews(ctx, req.(*ListLocationViewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LocationService_ServiceDesc is the grpc.ServiceDesc for LocationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +331,18 @@ var LocationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMyLocation",
 			Handler:    _LocationService_DeleteMyLocation_Handler,
 		},
+		{
+			MethodName: "GetLocationSettings",
+			Handler:    _LocationService_GetLocationSettings_Handler,
+		},
+		{
+			MethodName: "UpdateLocationSettings",
+			Handler:    _LocationService_UpdateLocationSettings_Handler,
+		},
+		{
+			MethodName: "ListLocationViews",
+			Handler:    _LocationService_ListLocationViews_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/v1/location.proto",
@@ -23,13 +23,60 @@ const (
 )
 
 type Match struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	RiderId       string                 `protobuf:"bytes,2,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
-	DriverId      string                 `protobuf:"bytes,3,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
-	RideId        string                 `protobuf:"bytes,4,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RiderId    string                 `protobuf:"bytes,2,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	DriverId   string                 `protobuf:"bytes,3,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	RideId     string                 `protobuf:"bytes,4,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	Status     string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	OrgId      string                 `protobuf:"bytes,7,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	PickupGeo  string                 `protobuf:"bytes,8,opt,name=pickup_geo,json=pickupGeo,proto3" json:"pickup_geo,omitempty"`
+	DropoffGeo string                 `protobuf:"bytes,9,opt,name=dropoff_geo,json=dropoffGeo,proto3" json:"dropoff_geo,omitempty"`
+	// what this rider adds to the driver's route, 0 when it could not be routed
+	DetourSeconds int32   `protobuf:"varint,10,opt,name=detour_seconds,json=detourSeconds,proto3" json:"detour_seconds,omitempty"`
+	DetourMeters  float64 `protobuf:"fixed64,11,opt,name=detour_meters,json=detourMeters,proto3" json:"detour_meters,omitempty"`
+	// offer stops the rider boards and leaves at
+	PickupStop  int32 `protobuf:"varint,12,opt,name=pickup_stop,json=pickupStop,proto3" json:"pickup_stop,omitempty"`
+	DropoffStop int32 `protobuf:"varint,13,opt,name=dropoff_stop,json=dropoffStop,proto3" json:"dropoff_stop,omitempty"`
+	Seats       int32 `protobuf:"varint,14,opt,name=seats,proto3" json:"seats,omitempty"`
+	// set once the match is withdrawn or cancelled
+	CancelledBy  string                 `protobuf:"bytes,15,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	CancelledAt  *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	CancelReason string                 `protobuf:"bytes,17,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	// cancelled within the grace period before departure
+	LateCancel bool `protobuf:"varint,18,opt,name=late_cancel,json=lateCancel,proto3" json:"late_cancel,omitempty"`
+	// set once the match is reported as a no-show: who did not turn up
+	NoShowUserId     string                 `protobuf:"bytes,19,opt,name=no_show_user_id,json=noShowUserId,proto3" json:"no_show_user_id,omitempty"`
+	NoShowReportedAt *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=no_show_reported_at,json=noShowReportedAt,proto3" json:"no_show_reported_at,omitempty"`
+	// when the driver checked the rider in with their PIN, and when the
+	// trip was completed
+	StartedAt   *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	// while promoted from the waitlist: the seats are held until then
+	ConfirmBy *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=confirm_by,json=confirmBy,proto3" json:"confirm_by,omitempty"`
+	// the offer's auto-accept rule when the rider asked to join, whether it
+	// accepted them and why
+	AcceptRule   string        `protobuf:"bytes,24,opt,name=accept_rule,json=acceptRule,proto3" json:"accept_rule,omitempty"`
+	AutoAccepted bool          `protobuf:"varint,25,opt,name=auto_accepted,json=autoAccepted,proto3" json:"auto_accepted,omitempty"`
+	AcceptNote   string        `protobuf:"bytes,26,opt,name=accept_note,json=acceptNote,proto3" json:"accept_note,omitempty"`
+	PickupWindow *PickupWindow `protobuf:"bytes,27,opt,name=pickup_window,json=pickupWindow,proto3" json:"pickup_window,omitempty"`
+	// the pickup time proposed with the request, agreed once accepted, and
+	// the departure of the ride it assumes
+	PickupAt *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=pickup_at,json=pickupAt,proto3" json:"pickup_at,omitempty"`
+	DepartAt *timestamppb.Timestamp `protobuf:"bytes,29,opt,name=depart_at,json=departAt,proto3" json:"depart_at,omitempty"`
+	// the two legs of a rider's round trip share a pair_id; leg is
+	// "outbound" or "return". With pair_mode "together", one leg falling
+	// through before it is ridden takes the other with it
+	PairId   string `protobuf:"bytes,30,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Leg      string `protobuf:"bytes,31,opt,name=leg,proto3" json:"leg,omitempty"`
+	PairMode string `protobuf:"bytes,32,opt,name=pair_mode,json=pairMode,proto3" json:"pair_mode,omitempty"`
+	// the fare per rider on the table, agreed once the match is accepted;
+	// fare_by is who proposed it, empty for the offer's listed fare, and
+	// fare_counters how often it was countered
+	Fare          float64 `protobuf:"fixed64,33,opt,name=fare,proto3" json:"fare,omitempty"`
+	FareBy        string  `protobuf:"bytes,34,opt,name=fare_by,json=fareBy,proto3" json:"fare_by,omitempty"`
+	FareCounters  int32   `protobuf:"varint,35,opt,name=fare_counters,json=fareCounters,proto3" json:"fare_counters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -106,16 +153,300 @@ func (x *Match) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type RequestToJoinRequest struct {
+func (x *Match) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *Match) GetPickupGeo() string {
+	if x != nil {
+		return x.PickupGeo
+	}
+	return ""
+}
+
+func (x *Match) GetDropoffGeo() string {
+	if x != nil {
+		return x.DropoffGeo
+	}
+	return ""
+}
+
+func (x *Match) GetDetourSeconds() int32 {
+	if x != nil {
+		return x.DetourSeconds
+	}
+	return 0
+}
+
+func (x *Match) GetDetourMeters() float64 {
+	if x != nil {
+		return x.DetourMeters
+	}
+	return 0
+}
+
+func (x *Match) GetPickupStop() int32 {
+	if x != nil {
+		return x.PickupStop
+	}
+	return 0
+}
+
+func (x *Match) GetDropoffStop() int32 {
+	if x != nil {
+		return x.DropoffStop
+	}
+	return 0
+}
+
+func (x *Match) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *Match) GetCancelledBy() string {
+	if x != nil {
+		return x.CancelledBy
+	}
+	return ""
+}
+
+func (x *Match) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Match) GetCancelReason() string {
+	if x != nil {
+		return x.CancelReason
+	}
+	return ""
+}
+
+func (x *Match) GetLateCancel() bool {
+	if x != nil {
+		return x.LateCancel
+	}
+	return false
+}
+
+func (x *Match) GetNoShowUserId() string {
+	if x != nil {
+		return x.NoShowUserId
+	}
+	return ""
+}
+
+func (x *Match) GetNoShowReportedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NoShowReportedAt
+	}
+	return nil
+}
+
+func (x *Match) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *Match) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Match) GetConfirmBy() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ConfirmBy
+	}
+	return nil
+}
+
+func (x *Match) GetAcceptRule() string {
+	if x != nil {
+		return x.AcceptRule
+	}
+	return ""
+}
+
+func (x *Match) GetAutoAccepted() bool {
+	if x != nil {
+		return x.AutoAccepted
+	}
+	return false
+}
+
+func (x *Match) GetAcceptNote() string {
+	if x != nil {
+		return x.AcceptNote
+	}
+	return ""
+}
+
+func (x *Match) GetPickupWindow() *PickupWindow {
+	if x != nil {
+		return x.PickupWindow
+	}
+	return nil
+}
+
+func (x *Match) GetPickupAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PickupAt
+	}
+	return nil
+}
+
+func (x *Match) GetDepartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartAt
+	}
+	return nil
+}
+
+func (x *Match) GetPairId() string {
+	if x != nil {
+		return x.PairId
+	}
+	return ""
+}
+
+func (x *Match) GetLeg() string {
+	if x != nil {
+		return x.Leg
+	}
+	return ""
+}
+
+func (x *Match) GetPairMode() string {
+	if x != nil {
+		return x.PairMode
+	}
+	return ""
+}
+
+func (x *Match) GetFare() float64 {
+	if x != nil {
+		return x.Fare
+	}
+	return 0
+}
+
+func (x *Match) GetFareBy() string {
+	if x != nil {
+		return x.FareBy
+	}
+	return ""
+}
+
+func (x *Match) GetFareCounters() int32 {
+	if x != nil {
+		return x.FareCounters
+	}
+	return 0
+}
+
+// when a rider can be picked up and has to be dropped off by; unset ends
+// are open
+type PickupWindow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RideId        string                 `protobuf:"bytes,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	Earliest      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=earliest,proto3" json:"earliest,omitempty"`
+	Latest        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=latest,proto3" json:"latest,omitempty"`
+	ArriveBy      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=arrive_by,json=arriveBy,proto3" json:"arrive_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupWindow) Reset() {
+	*x = PickupWindow{}
+	mi := &file_proto_v1_match_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupWindow) ProtoMessage() {}
+
+func (x *PickupWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupWindow.ProtoReflect.Descriptor instead.
+func (*PickupWindow) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PickupWindow) GetEarliest() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Earliest
+	}
+	return nil
+}
+
+func (x *PickupWindow) GetLatest() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Latest
+	}
+	return nil
+}
+
+func (x *PickupWindow) GetArriveBy() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArriveBy
+	}
+	return nil
+}
+
+type RequestToJoinRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RideId string                 `protobuf:"bytes,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	// where to be picked up, defaults to the offer's from_geo
+	PickupGeo string `protobuf:"bytes,2,opt,name=pickup_geo,json=pickupGeo,proto3" json:"pickup_geo,omitempty"`
+	// where to get off, defaults to the offer's to_geo
+	DropoffGeo string `protobuf:"bytes,3,opt,name=dropoff_geo,json=dropoffGeo,proto3" json:"dropoff_geo,omitempty"`
+	// board and leave at the offer's own stops (see ListOfferStops) instead
+	// of pickup_geo / dropoff_geo; a dropoff_stop of 0 means unset
+	PickupStop  int32 `protobuf:"varint,4,opt,name=pickup_stop,json=pickupStop,proto3" json:"pickup_stop,omitempty"`
+	DropoffStop int32 `protobuf:"varint,5,opt,name=dropoff_stop,json=dropoffStop,proto3" json:"dropoff_stop,omitempty"`
+	// defaults to 1
+	Seats int32 `protobuf:"varint,6,opt,name=seats,proto3" json:"seats,omitempty"`
+	// when no seats are free, join the offer's waitlist instead of failing
+	Waitlist     bool          `protobuf:"varint,7,opt,name=waitlist,proto3" json:"waitlist,omitempty"`
+	PickupWindow *PickupWindow `protobuf:"bytes,8,opt,name=pickup_window,json=pickupWindow,proto3" json:"pickup_window,omitempty"`
+	// the pickup time to propose, defaults to the one closest to the offer's
+	// plan that suits the offer's departure window and pickup_window
+	PickupAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=pickup_at,json=pickupAt,proto3" json:"pickup_at,omitempty"`
+	// the fare to propose, within the org's fare policy; unset takes the
+	// offer's listed fare
+	Fare          float64 `protobuf:"fixed64,10,opt,name=fare,proto3" json:"fare,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RequestToJoinRequest) Reset() {
 	*x = RequestToJoinRequest{}
-	mi := &file_proto_v1_match_proto_msgTypes[1]
+	mi := &file_proto_v1_match_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -127,7 +458,7 @@ func (x *RequestToJoinRequest) String() string {
 func (*RequestToJoinRequest) ProtoMessage() {}
 
 func (x *RequestToJoinRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[1]
+	mi := &file_proto_v1_match_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -140,7 +471,7 @@ func (x *RequestToJoinRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestToJoinRequest.ProtoReflect.Descriptor instead.
 func (*RequestToJoinRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{1}
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *RequestToJoinRequest) GetRideId() string {
@@ -150,6 +481,69 @@ func (x *RequestToJoinRequest) GetRideId() string {
 	return ""
 }
 
+func (x *RequestToJoinRequest) GetPickupGeo() string {
+	if x != nil {
+		return x.PickupGeo
+	}
+	return ""
+}
+
+func (x *RequestToJoinRequest) GetDropoffGeo() string {
+	if x != nil {
+		return x.DropoffGeo
+	}
+	return ""
+}
+
+func (x *RequestToJoinRequest) GetPickupStop() int32 {
+	if x != nil {
+		return x.PickupStop
+	}
+	return 0
+}
+
+func (x *RequestToJoinRequest) GetDropoffStop() int32 {
+	if x != nil {
+		return x.DropoffStop
+	}
+	return 0
+}
+
+func (x *RequestToJoinRequest) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *RequestToJoinRequest) GetWaitlist() bool {
+	if x != nil {
+		return x.Waitlist
+	}
+	return false
+}
+
+func (x *RequestToJoinRequest) GetPickupWindow() *PickupWindow {
+	if x != nil {
+		return x.PickupWindow
+	}
+	return nil
+}
+
+func (x *RequestToJoinRequest) GetPickupAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PickupAt
+	}
+	return nil
+}
+
+func (x *RequestToJoinRequest) GetFare() float64 {
+	if x != nil {
+		return x.Fare
+	}
+	return 0
+}
+
 type RequestToJoinResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
@@ -159,7 +553,7 @@ type RequestToJoinResponse struct {
 
 func (x *RequestToJoinResponse) Reset() {
 	*x = RequestToJoinResponse{}
-	mi := &file_proto_v1_match_proto_msgTypes[2]
+	mi := &file_proto_v1_match_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -171,7 +565,7 @@ func (x *RequestToJoinResponse) String() string {
 func (*RequestToJoinResponse) ProtoMessage() {}
 
 func (x *RequestToJoinResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[2]
+	mi := &file_proto_v1_match_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -184,7 +578,7 @@ func (x *RequestToJoinResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestToJoinResponse.ProtoReflect.Descriptor instead.
 func (*RequestToJoinResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{2}
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RequestToJoinResponse) GetMatch() *Match {
@@ -195,15 +589,17 @@ func (x *RequestToJoinResponse) GetMatch() *Match {
 }
 
 type AcceptRideRequestRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// inside the request's departure window, defaults to its time
+	PickupAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=pickup_at,json=pickupAt,proto3" json:"pickup_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AcceptRideRequestRequest) Reset() {
 	*x = AcceptRideRequestRequest{}
-	mi := &file_proto_v1_match_proto_msgTypes[3]
+	mi := &file_proto_v1_match_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -215,7 +611,7 @@ func (x *AcceptRideRequestRequest) String() string {
 func (*AcceptRideRequestRequest) ProtoMessage() {}
 
 func (x *AcceptRideRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[3]
+	mi := &file_proto_v1_match_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,7 +624,7 @@ func (x *AcceptRideRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptRideRequestRequest.ProtoReflect.Descriptor instead.
 func (*AcceptRideRequestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{3}
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AcceptRideRequestRequest) GetRequestId() string {
@@ -238,6 +634,13 @@ func (x *AcceptRideRequestRequest) GetRequestId() string {
 	return ""
 }
 
+func (x *AcceptRideRequestRequest) GetPickupAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PickupAt
+	}
+	return nil
+}
+
 type AcceptRideRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
@@ -247,7 +650,7 @@ type AcceptRideRequestResponse struct {
 
 func (x *AcceptRideRequestResponse) Reset() {
 	*x = AcceptRideRequestResponse{}
-	mi := &file_proto_v1_match_proto_msgTypes[4]
+	mi := &file_proto_v1_match_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -259,7 +662,7 @@ func (x *AcceptRideRequestResponse) String() string {
 func (*AcceptRideRequestResponse) ProtoMessage() {}
 
 func (x *AcceptRideRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[4]
+	mi := &file_proto_v1_match_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -272,7 +675,7 @@ func (x *AcceptRideRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptRideRequestResponse.ProtoReflect.Descriptor instead.
 func (*AcceptRideRequestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{4}
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AcceptRideRequestResponse) GetMatch() *Match {
@@ -283,15 +686,18 @@ func (x *AcceptRideRequestResponse) GetMatch() *Match {
 }
 
 type AcceptRequestRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MatchId string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// a counter to the pickup time the rider proposed, which has to suit
+	// the rider's pickup window too; unset agrees on the proposed one
+	PickupAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=pickup_at,json=pickupAt,proto3" json:"pickup_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AcceptRequestRequest) Reset() {
 	*x = AcceptRequestRequest{}
-	mi := &file_proto_v1_match_proto_msgTypes[5]
+	mi := &file_proto_v1_match_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -303,7 +709,7 @@ func (x *AcceptRequestRequest) String() string {
 func (*AcceptRequestRequest) ProtoMessage() {}
 
 func (x *AcceptRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[5]
+	mi := &file_proto_v1_match_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -316,7 +722,7 @@ func (x *AcceptRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptRequestRequest.ProtoReflect.Descriptor instead.
 func (*AcceptRequestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{5}
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *AcceptRequestRequest) GetMatchId() string {
@@ -326,6 +732,13 @@ func (x *AcceptRequestRequest) GetMatchId() string {
 	return ""
 }
 
+func (x *AcceptRequestRequest) GetPickupAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PickupAt
+	}
+	return nil
+}
+
 type AcceptRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
@@ -335,7 +748,7 @@ type AcceptRequestResponse struct {
 
 func (x *AcceptRequestResponse) Reset() {
 	*x = AcceptRequestResponse{}
-	mi := &file_proto_v1_match_proto_msgTypes[6]
+	mi := &file_proto_v1_match_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +760,7 @@ func (x *AcceptRequestResponse) String() string {
 func (*AcceptRequestResponse) ProtoMessage() {}
 
 func (x *AcceptRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[6]
+	mi := &file_proto_v1_match_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +773,7 @@ func (x *AcceptRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AcceptRequestResponse.ProtoReflect.Descriptor instead.
 func (*AcceptRequestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{6}
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AcceptRequestResponse) GetMatch() *Match {
@@ -371,27 +784,1418 @@ func (x *AcceptRequestResponse) GetMatch() *Match {
 }
 
 type RejectRequestRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MatchId string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// optional, kept in the match's history
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRequestRequest) Reset() {
+	*x = RejectRequestRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRequestRequest) ProtoMessage() {}
+
+func (x *RejectRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RejectRequestRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *RejectRequestRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RejectRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRequestResponse) Reset() {
+	*x = RejectRequestResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRequestResponse) ProtoMessage() {}
+
+func (x *RejectRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RejectRequestResponse) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+type CompleteMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteMatchRequest) Reset() {
+	*x = CompleteMatchRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMatchRequest) ProtoMessage() {}
+
+func (x *CompleteMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMatchRequest.ProtoReflect.Descriptor instead.
+func (*CompleteMatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CompleteMatchRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type CompleteMatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteMatchResponse) Reset() {
+	*x = CompleteMatchResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMatchResponse) ProtoMessage() {}
+
+func (x *CompleteMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMatchResponse.ProtoReflect.Descriptor instead.
+func (*CompleteMatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CompleteMatchResponse) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+type GetMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchRequest) Reset() {
+	*x = GetMatchRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchRequest) ProtoMessage() {}
+
+func (x *GetMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchRequest.ProtoReflect.Descriptor instead.
+func (*GetMatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetMatchRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type GetMatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchResponse) Reset() {
+	*x = GetMatchResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchResponse) ProtoMessage() {}
+
+func (x *GetMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchResponse.ProtoReflect.Descriptor instead.
+func (*GetMatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetMatchResponse) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+type ListMatchesByRideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RideId        string                 `protobuf:"bytes,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchesByRideRequest) Reset() {
+	*x = ListMatchesByRideRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchesByRideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchesByRideRequest) ProtoMessage() {}
+
+func (x *ListMatchesByRideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchesByRideRequest.ProtoReflect.Descriptor instead.
+func (*ListMatchesByRideRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListMatchesByRideRequest) GetRideId() string {
+	if x != nil {
+		return x.RideId
+	}
+	return ""
+}
+
+type ListMatchesByRideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchesByRideResponse) Reset() {
+	*x = ListMatchesByRideResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchesByRideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchesByRideResponse) ProtoMessage() {}
+
+func (x *ListMatchesByRideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchesByRideResponse.ProtoReflect.Descriptor instead.
+func (*ListMatchesByRideResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListMatchesByRideResponse) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type ListMatchesByRiderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RiderId       string                 `protobuf:"bytes,1,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchesByRiderRequest) Reset() {
+	*x = ListMatchesByRiderRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchesByRiderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchesByRiderRequest) ProtoMessage() {}
+
+func (x *ListMatchesByRiderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchesByRiderRequest.ProtoReflect.Descriptor instead.
+func (*ListMatchesByRiderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListMatchesByRiderRequest) GetRiderId() string {
+	if x != nil {
+		return x.RiderId
+	}
+	return ""
+}
+
+type ListMatchesByRiderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchesByRiderResponse) Reset() {
+	*x = ListMatchesByRiderResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchesByRiderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchesByRiderResponse) ProtoMessage() {}
+
+func (x *ListMatchesByRiderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchesByRiderResponse.ProtoReflect.Descriptor instead.
+func (*ListMatchesByRiderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListMatchesByRiderResponse) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type ListMyMatchesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// list the matches on the caller's offers instead of their own joins
+	AsDriver      bool  `protobuf:"varint,1,opt,name=as_driver,json=asDriver,proto3" json:"as_driver,omitempty"`
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyMatchesRequest) Reset() {
+	*x = ListMyMatchesRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyMatchesRequest) ProtoMessage() {}
+
+func (x *ListMyMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyMatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListMyMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListMyMatchesRequest) GetAsDriver() bool {
+	if x != nil {
+		return x.AsDriver
+	}
+	return false
+}
+
+func (x *ListMyMatchesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListMyMatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyMatchesResponse) Reset() {
+	*x = ListMyMatchesResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyMatchesResponse) ProtoMessage() {}
+
+func (x *ListMyMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyMatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListMyMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListMyMatchesResponse) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+// the rider takes back a request the driver has not answered yet
+type WithdrawRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawRequestRequest) Reset() {
+	*x = WithdrawRequestRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequestRequest) ProtoMessage() {}
+
+func (x *WithdrawRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawRequestRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WithdrawRequestRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *WithdrawRequestRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type WithdrawRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawRequestResponse) Reset() {
+	*x = WithdrawRequestResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawRequestResponse) ProtoMessage() {}
+
+func (x *WithdrawRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawRequestResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WithdrawRequestResponse) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+// either side drops an accepted match, freeing the rider's seats
+type CancelMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelMatchRequest) Reset() {
+	*x = CancelMatchRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMatchRequest) ProtoMessage() {}
+
+func (x *CancelMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMatchRequest.ProtoReflect.Descriptor instead.
+func (*CancelMatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CancelMatchRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *CancelMatchRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelMatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelMatchResponse) Reset() {
+	*x = CancelMatchResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMatchResponse) ProtoMessage() {}
+
+func (x *CancelMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMatchResponse.ProtoReflect.Descriptor instead.
+func (*CancelMatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CancelMatchResponse) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+// the driver calls off the whole ride, cancelling every open match on it
+type CancelRideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RideId        string                 `protobuf:"bytes,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRideRequest) Reset() {
+	*x = CancelRideRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRideRequest) ProtoMessage() {}
+
+func (x *CancelRideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRideRequest.ProtoReflect.Descriptor instead.
+func (*CancelRideRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CancelRideRequest) GetRideId() string {
+	if x != nil {
+		return x.RideId
+	}
+	return ""
+}
+
+func (x *CancelRideRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelRideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cancelled     []*Match               `protobuf:"bytes,1,rep,name=cancelled,proto3" json:"cancelled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRideResponse) Reset() {
+	*x = CancelRideResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRideResponse) ProtoMessage() {}
+
+func (x *CancelRideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRideResponse.ProtoReflect.Descriptor instead.
+func (*CancelRideResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CancelRideResponse) GetCancelled() []*Match {
+	if x != nil {
+		return x.Cancelled
+	}
+	return nil
+}
+
+// a side of an accepted match reports that the other did not turn up, once
+// the ride was due to leave
+type ReportNoShowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportNoShowRequest) Reset() {
+	*x = ReportNoShowRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportNoShowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportNoShowRequest) ProtoMessage() {}
+
+func (x *ReportNoShowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportNoShowRequest.ProtoReflect.Descriptor instead.
+func (*ReportNoShowRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReportNoShowRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type ReportNoShowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportNoShowResponse) Reset() {
+	*x = ReportNoShowResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportNoShowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportNoShowResponse) ProtoMessage() {}
+
+func (x *ReportNoShowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportNoShowResponse.ProtoReflect.Descriptor instead.
+func (*ReportNoShowResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReportNoShowResponse) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+// the rider asks for a PIN to show the driver at pickup
+type IssuePickupPinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssuePickupPinRequest) Reset() {
+	*x = IssuePickupPinRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssuePickupPinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuePickupPinRequest) ProtoMessage() {}
+
+func (x *IssuePickupPinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuePickupPinRequest.ProtoReflect.Descriptor instead.
+func (*IssuePickupPinRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *IssuePickupPinRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type IssuePickupPinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pin           string                 `protobuf:"bytes,1,opt,name=pin,proto3" json:"pin,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssuePickupPinResponse) Reset() {
+	*x = IssuePickupPinResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssuePickupPinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuePickupPinResponse) ProtoMessage() {}
+
+func (x *IssuePickupPinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuePickupPinResponse.ProtoReflect.Descriptor instead.
+func (*IssuePickupPinResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *IssuePickupPinResponse) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+func (x *IssuePickupPinResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// the driver enters the rider's PIN to check them in
+type CheckInRiderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Pin           string                 `protobuf:"bytes,2,opt,name=pin,proto3" json:"pin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInRiderRequest) Reset() {
+	*x = CheckInRiderRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInRiderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRiderRequest) ProtoMessage() {}
+
+func (x *CheckInRiderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRiderRequest.ProtoReflect.Descriptor instead.
+func (*CheckInRiderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CheckInRiderRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *CheckInRiderRequest) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+type CheckInRiderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInRiderResponse) Reset() {
+	*x = CheckInRiderResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInRiderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRiderResponse) ProtoMessage() {}
+
+func (x *CheckInRiderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRiderResponse.ProtoReflect.Descriptor instead.
+func (*CheckInRiderResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CheckInRiderResponse) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+// one status change of a match; from_status is empty for its creation
+type MatchEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MatchId       string                 `protobuf:"bytes,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,4,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,5,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchEvent) Reset() {
+	*x = MatchEvent{}
+	mi := &file_proto_v1_match_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchEvent) ProtoMessage() {}
+
+func (x *MatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchEvent.ProtoReflect.Descriptor instead.
+func (*MatchEvent) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MatchEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MatchEvent) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *MatchEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *MatchEvent) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *MatchEvent) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *MatchEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MatchEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetMatchHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchHistoryRequest) Reset() {
+	*x = GetMatchHistoryRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchHistoryRequest) ProtoMessage() {}
+
+func (x *GetMatchHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMatchHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetMatchHistoryRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type GetMatchHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*MatchEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchHistoryResponse) Reset() {
+	*x = GetMatchHistoryResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchHistoryResponse) ProtoMessage() {}
+
+func (x *GetMatchHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMatchHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetMatchHistoryResponse) GetEvents() []*MatchEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// the rider takes the seat held for them after promotion from the waitlist
+type ConfirmWaitlistSeatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmWaitlistSeatRequest) Reset() {
+	*x = ConfirmWaitlistSeatRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmWaitlistSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmWaitlistSeatRequest) ProtoMessage() {}
+
+func (x *ConfirmWaitlistSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmWaitlistSeatRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmWaitlistSeatRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ConfirmWaitlistSeatRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type ConfirmWaitlistSeatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmWaitlistSeatResponse) Reset() {
+	*x = ConfirmWaitlistSeatResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmWaitlistSeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmWaitlistSeatResponse) ProtoMessage() {}
+
+func (x *ConfirmWaitlistSeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmWaitlistSeatResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmWaitlistSeatResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ConfirmWaitlistSeatResponse) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+type GetWaitlistPositionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RejectRequestRequest) Reset() {
-	*x = RejectRequestRequest{}
-	mi := &file_proto_v1_match_proto_msgTypes[7]
+func (x *GetWaitlistPositionRequest) Reset() {
+	*x = GetWaitlistPositionRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RejectRequestRequest) String() string {
+func (x *GetWaitlistPositionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RejectRequestRequest) ProtoMessage() {}
+func (*GetWaitlistPositionRequest) ProtoMessage() {}
 
-func (x *RejectRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[7]
+func (x *GetWaitlistPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,40 +2206,44 @@ func (x *RejectRequestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RejectRequestRequest.ProtoReflect.Descriptor instead.
-func (*RejectRequestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetWaitlistPositionRequest.ProtoReflect.Descriptor instead.
+func (*GetWaitlistPositionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *RejectRequestRequest) GetMatchId() string {
+func (x *GetWaitlistPositionRequest) GetMatchId() string {
 	if x != nil {
 		return x.MatchId
 	}
 	return ""
 }
 
-type RejectRequestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+type GetWaitlistPositionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Match *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// 1 for the next in line, 0 once the match is no longer waiting
+	Position int32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	// how many riders are waiting in all
+	Length        int32 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RejectRequestResponse) Reset() {
-	*x = RejectRequestResponse{}
-	mi := &file_proto_v1_match_proto_msgTypes[8]
+func (x *GetWaitlistPositionResponse) Reset() {
+	*x = GetWaitlistPositionResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RejectRequestResponse) String() string {
+func (x *GetWaitlistPositionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RejectRequestResponse) ProtoMessage() {}
+func (*GetWaitlistPositionResponse) ProtoMessage() {}
 
-func (x *RejectRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[8]
+func (x *GetWaitlistPositionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,40 +2254,59 @@ func (x *RejectRequestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RejectRequestResponse.ProtoReflect.Descriptor instead.
-func (*RejectRequestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetWaitlistPositionResponse.ProtoReflect.Descriptor instead.
+func (*GetWaitlistPositionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *RejectRequestResponse) GetMatch() *Match {
+func (x *GetWaitlistPositionResponse) GetMatch() *Match {
 	if x != nil {
 		return x.Match
 	}
 	return nil
 }
 
-type CompleteMatchRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+func (x *GetWaitlistPositionResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *GetWaitlistPositionResponse) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type RequestRoundTripRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Outbound *RequestToJoinRequest  `protobuf:"bytes,1,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	// ride_id defaults to the return leg of the outbound ride's round trip.
+	// The return has to leave after the outbound arrives
+	ReturnLeg *RequestToJoinRequest `protobuf:"bytes,2,opt,name=return_leg,json=returnLeg,proto3" json:"return_leg,omitempty"`
+	// "together" (the default): both legs or neither; "independent"
+	Mode          string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteMatchRequest) Reset() {
-	*x = CompleteMatchRequest{}
-	mi := &file_proto_v1_match_proto_msgTypes[9]
+func (x *RequestRoundTripRequest) Reset() {
+	*x = RequestRoundTripRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteMatchRequest) String() string {
+func (x *RequestRoundTripRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteMatchRequest) ProtoMessage() {}
+func (*RequestRoundTripRequest) ProtoMessage() {}
 
-func (x *CompleteMatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[9]
+func (x *RequestRoundTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -490,40 +2317,55 @@ func (x *CompleteMatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteMatchRequest.ProtoReflect.Descriptor instead.
-func (*CompleteMatchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use RequestRoundTripRequest.ProtoReflect.Descriptor instead.
+func (*RequestRoundTripRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *CompleteMatchRequest) GetMatchId() string {
+func (x *RequestRoundTripRequest) GetOutbound() *RequestToJoinRequest {
 	if x != nil {
-		return x.MatchId
+		return x.Outbound
+	}
+	return nil
+}
+
+func (x *RequestRoundTripRequest) GetReturnLeg() *RequestToJoinRequest {
+	if x != nil {
+		return x.ReturnLeg
+	}
+	return nil
+}
+
+func (x *RequestRoundTripRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
 	}
 	return ""
 }
 
-type CompleteMatchResponse struct {
+type RequestRoundTripResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	Outbound      *Match                 `protobuf:"bytes,1,opt,name=outbound,proto3" json:"outbound,omitempty"`
+	ReturnLeg     *Match                 `protobuf:"bytes,2,opt,name=return_leg,json=returnLeg,proto3" json:"return_leg,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompleteMatchResponse) Reset() {
-	*x = CompleteMatchResponse{}
-	mi := &file_proto_v1_match_proto_msgTypes[10]
+func (x *RequestRoundTripResponse) Reset() {
+	*x = RequestRoundTripResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompleteMatchResponse) String() string {
+func (x *RequestRoundTripResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompleteMatchResponse) ProtoMessage() {}
+func (*RequestRoundTripResponse) ProtoMessage() {}
 
-func (x *CompleteMatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[10]
+func (x *RequestRoundTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,40 +2376,53 @@ func (x *CompleteMatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompleteMatchResponse.ProtoReflect.Descriptor instead.
-func (*CompleteMatchResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RequestRoundTripResponse.ProtoReflect.Descriptor instead.
+func (*RequestRoundTripResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CompleteMatchResponse) GetMatch() *Match {
+func (x *RequestRoundTripResponse) GetOutbound() *Match {
 	if x != nil {
-		return x.Match
+		return x.Outbound
 	}
 	return nil
 }
 
-type GetMatchRequest struct {
+func (x *RequestRoundTripResponse) GetReturnLeg() *Match {
+	if x != nil {
+		return x.ReturnLeg
+	}
+	return nil
+}
+
+// a fare the rider or the driver put on the table
+type FareProposal struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MatchId       string                 `protobuf:"bytes,2,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Fare          float64                `protobuf:"fixed64,4,opt,name=fare,proto3" json:"fare,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMatchRequest) Reset() {
-	*x = GetMatchRequest{}
-	mi := &file_proto_v1_match_proto_msgTypes[11]
+func (x *FareProposal) Reset() {
+	*x = FareProposal{}
+	mi := &file_proto_v1_match_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMatchRequest) String() string {
+func (x *FareProposal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMatchRequest) ProtoMessage() {}
+func (*FareProposal) ProtoMessage() {}
 
-func (x *GetMatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[11]
+func (x *FareProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,84 +2433,79 @@ func (x *GetMatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMatchRequest.ProtoReflect.Descriptor instead.
-func (*GetMatchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use FareProposal.ProtoReflect.Descriptor instead.
+func (*FareProposal) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetMatchRequest) GetMatchId() string {
+func (x *FareProposal) GetId() uint64 {
 	if x != nil {
-		return x.MatchId
+		return x.Id
 	}
-	return ""
-}
-
-type GetMatchResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *GetMatchResponse) Reset() {
-	*x = GetMatchResponse{}
-	mi := &file_proto_v1_match_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *FareProposal) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
 }
 
-func (x *GetMatchResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FareProposal) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
 }
 
-func (*GetMatchResponse) ProtoMessage() {}
-
-func (x *GetMatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[12]
+func (x *FareProposal) GetFare() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Fare
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetMatchResponse.ProtoReflect.Descriptor instead.
-func (*GetMatchResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{12}
+func (x *FareProposal) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
 }
 
-func (x *GetMatchResponse) GetMatch() *Match {
+func (x *FareProposal) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Match
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type ListMatchesByRideRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RideId        string                 `protobuf:"bytes,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+// either side proposes another fare before the match is accepted
+type CounterFareRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MatchId string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Fare    float64                `protobuf:"fixed64,2,opt,name=fare,proto3" json:"fare,omitempty"`
+	// optional, kept in the fare history
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMatchesByRideRequest) Reset() {
-	*x = ListMatchesByRideRequest{}
-	mi := &file_proto_v1_match_proto_msgTypes[13]
+func (x *CounterFareRequest) Reset() {
+	*x = CounterFareRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMatchesByRideRequest) String() string {
+func (x *CounterFareRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMatchesByRideRequest) ProtoMessage() {}
+func (*CounterFareRequest) ProtoMessage() {}
 
-func (x *ListMatchesByRideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[13]
+func (x *CounterFareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -666,40 +2516,54 @@ func (x *ListMatchesByRideRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMatchesByRideRequest.ProtoReflect.Descriptor instead.
-func (*ListMatchesByRideRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CounterFareRequest.ProtoReflect.Descriptor instead.
+func (*CounterFareRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ListMatchesByRideRequest) GetRideId() string {
+func (x *CounterFareRequest) GetMatchId() string {
 	if x != nil {
-		return x.RideId
+		return x.MatchId
 	}
 	return ""
 }
 
-type ListMatchesByRideResponse struct {
+func (x *CounterFareRequest) GetFare() float64 {
+	if x != nil {
+		return x.Fare
+	}
+	return 0
+}
+
+func (x *CounterFareRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CounterFareResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Matches       []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMatchesByRideResponse) Reset() {
-	*x = ListMatchesByRideResponse{}
-	mi := &file_proto_v1_match_proto_msgTypes[14]
+func (x *CounterFareResponse) Reset() {
+	*x = CounterFareResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMatchesByRideResponse) String() string {
+func (x *CounterFareResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMatchesByRideResponse) ProtoMessage() {}
+func (*CounterFareResponse) ProtoMessage() {}
 
-func (x *ListMatchesByRideResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[14]
+func (x *CounterFareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,40 +2574,41 @@ func (x *ListMatchesByRideResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMatchesByRideResponse.ProtoReflect.Descriptor instead.
-func (*ListMatchesByRideResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CounterFareResponse.ProtoReflect.Descriptor instead.
+func (*CounterFareResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ListMatchesByRideResponse) GetMatches() []*Match {
+func (x *CounterFareResponse) GetMatch() *Match {
 	if x != nil {
-		return x.Matches
+		return x.Match
 	}
 	return nil
 }
 
-type ListMatchesByRiderRequest struct {
+// the rider agrees to the driver's counter, which accepts the match
+type AcceptFareRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RiderId       string                 `protobuf:"bytes,1,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMatchesByRiderRequest) Reset() {
-	*x = ListMatchesByRiderRequest{}
-	mi := &file_proto_v1_match_proto_msgTypes[15]
+func (x *AcceptFareRequest) Reset() {
+	*x = AcceptFareRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMatchesByRiderRequest) String() string {
+func (x *AcceptFareRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMatchesByRiderRequest) ProtoMessage() {}
+func (*AcceptFareRequest) ProtoMessage() {}
 
-func (x *ListMatchesByRiderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[15]
+func (x *AcceptFareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,40 +2619,40 @@ func (x *ListMatchesByRiderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMatchesByRiderRequest.ProtoReflect.Descriptor instead.
-func (*ListMatchesByRiderRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AcceptFareRequest.ProtoReflect.Descriptor instead.
+func (*AcceptFareRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ListMatchesByRiderRequest) GetRiderId() string {
+func (x *AcceptFareRequest) GetMatchId() string {
 	if x != nil {
-		return x.RiderId
+		return x.MatchId
 	}
 	return ""
 }
 
-type ListMatchesByRiderResponse struct {
+type AcceptFareResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Matches       []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMatchesByRiderResponse) Reset() {
-	*x = ListMatchesByRiderResponse{}
-	mi := &file_proto_v1_match_proto_msgTypes[16]
+func (x *AcceptFareResponse) Reset() {
+	*x = AcceptFareResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMatchesByRiderResponse) String() string {
+func (x *AcceptFareResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMatchesByRiderResponse) ProtoMessage() {}
+func (*AcceptFareResponse) ProtoMessage() {}
 
-func (x *ListMatchesByRiderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[16]
+func (x *AcceptFareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,39 +2663,40 @@ func (x *ListMatchesByRiderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMatchesByRiderResponse.ProtoReflect.Descriptor instead.
-func (*ListMatchesByRiderResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use AcceptFareResponse.ProtoReflect.Descriptor instead.
+func (*AcceptFareResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ListMatchesByRiderResponse) GetMatches() []*Match {
+func (x *AcceptFareResponse) GetMatch() *Match {
 	if x != nil {
-		return x.Matches
+		return x.Match
 	}
 	return nil
 }
 
-type ListMyMatchesRequest struct {
+type GetFareHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMyMatchesRequest) Reset() {
-	*x = ListMyMatchesRequest{}
-	mi := &file_proto_v1_match_proto_msgTypes[17]
+func (x *GetFareHistoryRequest) Reset() {
+	*x = GetFareHistoryRequest{}
+	mi := &file_proto_v1_match_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMyMatchesRequest) String() string {
+func (x *GetFareHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMyMatchesRequest) ProtoMessage() {}
+func (*GetFareHistoryRequest) ProtoMessage() {}
 
-func (x *ListMyMatchesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[17]
+func (x *GetFareHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,33 +2707,40 @@ func (x *ListMyMatchesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMyMatchesRequest.ProtoReflect.Descriptor instead.
-func (*ListMyMatchesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetFareHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetFareHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{46}
 }
 
-type ListMyMatchesResponse struct {
+func (x *GetFareHistoryRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type GetFareHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Matches       []*Match               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	Proposals     []*FareProposal        `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMyMatchesResponse) Reset() {
-	*x = ListMyMatchesResponse{}
-	mi := &file_proto_v1_match_proto_msgTypes[18]
+func (x *GetFareHistoryResponse) Reset() {
+	*x = GetFareHistoryResponse{}
+	mi := &file_proto_v1_match_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMyMatchesResponse) String() string {
+func (x *GetFareHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMyMatchesResponse) ProtoMessage() {}
+func (*GetFareHistoryResponse) ProtoMessage() {}
 
-func (x *ListMyMatchesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_match_proto_msgTypes[18]
+func (x *GetFareHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_match_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,14 +2751,14 @@ func (x *ListMyMatchesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMyMatchesResponse.ProtoReflect.Descriptor instead.
-func (*ListMyMatchesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_match_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetFareHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetFareHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_match_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ListMyMatchesResponse) GetMatches() []*Match {
+func (x *GetFareHistoryResponse) GetProposals() []*FareProposal {
 	if x != nil {
-		return x.Matches
+		return x.Proposals
 	}
 	return nil
 }
@@ -894,7 +2767,8 @@ var File_proto_v1_match_proto protoreflect.FileDescriptor
 
 const file_proto_v1_match_proto_rawDesc = "" +
 	"\n" +
-	"\x14proto/v1/match.proto\x12\bproto.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbb\x01\n" +
+	"\x14proto/v1/match.proto\x12\bproto.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb7\n" +
+	"\n" +
 	"\x05Match\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
 	"\brider_id\x18\x02 \x01(\tR\ariderId\x12\x1b\n" +
@@ -902,22 +2776,80 @@ const file_proto_v1_match_proto_rawDesc = "" +
 	"\aride_id\x18\x04 \x01(\tR\x06rideId\x12\x16\n" +
 	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"/\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x15\n" +
+	"\x06org_id\x18\a \x01(\tR\x05orgId\x12\x1d\n" +
+	"\n" +
+	"pickup_geo\x18\b \x01(\tR\tpickupGeo\x12\x1f\n" +
+	"\vdropoff_geo\x18\t \x01(\tR\n" +
+	"dropoffGeo\x12%\n" +
+	"\x0edetour_seconds\x18\n" +
+	" \x01(\x05R\rdetourSeconds\x12#\n" +
+	"\rdetour_meters\x18\v \x01(\x01R\fdetourMeters\x12\x1f\n" +
+	"\vpickup_stop\x18\f \x01(\x05R\n" +
+	"pickupStop\x12!\n" +
+	"\fdropoff_stop\x18\r \x01(\x05R\vdropoffStop\x12\x14\n" +
+	"\x05seats\x18\x0e \x01(\x05R\x05seats\x12!\n" +
+	"\fcancelled_by\x18\x0f \x01(\tR\vcancelledBy\x12=\n" +
+	"\fcancelled_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x12#\n" +
+	"\rcancel_reason\x18\x11 \x01(\tR\fcancelReason\x12\x1f\n" +
+	"\vlate_cancel\x18\x12 \x01(\bR\n" +
+	"lateCancel\x12%\n" +
+	"\x0fno_show_user_id\x18\x13 \x01(\tR\fnoShowUserId\x12I\n" +
+	"\x13no_show_reported_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\x10noShowReportedAt\x129\n" +
+	"\n" +
+	"started_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x129\n" +
+	"\n" +
+	"confirm_by\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\tconfirmBy\x12\x1f\n" +
+	"\vaccept_rule\x18\x18 \x01(\tR\n" +
+	"acceptRule\x12#\n" +
+	"\rauto_accepted\x18\x19 \x01(\bR\fautoAccepted\x12\x1f\n" +
+	"\vaccept_note\x18\x1a \x01(\tR\n" +
+	"acceptNote\x12;\n" +
+	"\rpickup_window\x18\x1b \x01(\v2\x16.proto.v1.PickupWindowR\fpickupWindow\x127\n" +
+	"\tpickup_at\x18\x1c \x01(\v2\x1a.google.protobuf.TimestampR\bpickupAt\x127\n" +
+	"\tdepart_at\x18\x1d \x01(\v2\x1a.google.protobuf.TimestampR\bdepartAt\x12\x17\n" +
+	"\apair_id\x18\x1e \x01(\tR\x06pairId\x12\x10\n" +
+	"\x03leg\x18\x1f \x01(\tR\x03leg\x12\x1b\n" +
+	"\tpair_mode\x18  \x01(\tR\bpairMode\x12\x12\n" +
+	"\x04fare\x18! \x01(\x01R\x04fare\x12\x17\n" +
+	"\afare_by\x18\" \x01(\tR\x06fareBy\x12#\n" +
+	"\rfare_counters\x18# \x01(\x05R\ffareCounters\"\xb3\x01\n" +
+	"\fPickupWindow\x126\n" +
+	"\bearliest\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bearliest\x122\n" +
+	"\x06latest\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06latest\x127\n" +
+	"\tarrive_by\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\barriveBy\"\xef\x02\n" +
 	"\x14RequestToJoinRequest\x12\x17\n" +
-	"\aride_id\x18\x01 \x01(\tR\x06rideId\">\n" +
+	"\aride_id\x18\x01 \x01(\tR\x06rideId\x12\x1d\n" +
+	"\n" +
+	"pickup_geo\x18\x02 \x01(\tR\tpickupGeo\x12\x1f\n" +
+	"\vdropoff_geo\x18\x03 \x01(\tR\n" +
+	"dropoffGeo\x12\x1f\n" +
+	"\vpickup_stop\x18\x04 \x01(\x05R\n" +
+	"pickupStop\x12!\n" +
+	"\fdropoff_stop\x18\x05 \x01(\x05R\vdropoffStop\x12\x14\n" +
+	"\x05seats\x18\x06 \x01(\x05R\x05seats\x12\x1a\n" +
+	"\bwaitlist\x18\a \x01(\bR\bwaitlist\x12;\n" +
+	"\rpickup_window\x18\b \x01(\v2\x16.proto.v1.PickupWindowR\fpickupWindow\x127\n" +
+	"\tpickup_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bpickupAt\x12\x12\n" +
+	"\x04fare\x18\n" +
+	" \x01(\x01R\x04fare\">\n" +
 	"\x15RequestToJoinResponse\x12%\n" +
-	"\x05match\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\x05match\"9\n" +
+	"\x05match\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\x05match\"r\n" +
 	"\x18AcceptRideRequestRequest\x12\x1d\n" +
 	"\n" +
-	"request_id\x18\x01 \x01(\tR\trequestId\"B\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x127\n" +
+	"\tpickup_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bpickupAt\"B\n" +
 	"\x19AcceptRideRequestResponse\x12%\n" +
-	"\x05match\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\x05match\"1\n" +
+	"\x05match\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\x05match\"j\n" +
 	"\x14AcceptRequestRequest\x12\x19\n" +
-	"\bmatch_id\x18\x01 \x01(\tR\amatchId\">\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x127\n" +
+	"\tpickup_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bpickupAt\">\n" +
 	"\x15AcceptRequestResponse\x12%\n" +
-	"\x05match\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\x05match\"1\n" +
+	"\x05match\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\x05match\"I\n" +
 	"\x14RejectRequestRequest\x12\x19\n" +
-	"\bmatch_id\x18\x01 \x01(\tR\amatchId\">\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\">\n" +
 	"\x15RejectRequestResponse\x12%\n" +
 	"\x05match\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\x05match\"1\n" +
 	"\x14CompleteMatchRequest\x12\x19\n" +
@@ -935,10 +2867,98 @@ const file_proto_v1_match_proto_rawDesc = "" +
 	"\x19ListMatchesByRiderRequest\x12\x19\n" +
 	"\brider_id\x18\x01 \x01(\tR\ariderId\"G\n" +
 	"\x1aListMatchesByRiderResponse\x12)\n" +
-	"\amatches\x18\x01 \x03(\v2\x0f.proto.v1.MatchR\amatches\"\x16\n" +
-	"\x14ListMyMatchesRequest\"B\n" +
+	"\amatches\x18\x01 \x03(\v2\x0f.proto.v1.MatchR\amatches\"I\n" +
+	"\x14ListMyMatchesRequest\x12\x1b\n" +
+	"\tas_driver\x18\x01 \x01(\bR\basDriver\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"B\n" +
 	"\x15ListMyMatchesResponse\x12)\n" +
-	"\amatches\x18\x01 \x03(\v2\x0f.proto.v1.MatchR\amatches2\x88\x06\n" +
+	"\amatches\x18\x01 \x03(\v2\x0f.proto.v1.MatchR\amatches\"K\n" +
+	"\x16WithdrawRequestRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"@\n" +
+	"\x17WithdrawRequestResponse\x12%\n" +
+	"\x05match\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\x05match\"G\n" +
+	"\x12CancelMatchRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"<\n" +
+	"\x13CancelMatchResponse\x12%\n" +
+	"\x05match\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\x05match\"D\n" +
+	"\x11CancelRideRequest\x12\x17\n" +
+	"\aride_id\x18\x01 \x01(\tR\x06rideId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"C\n" +
+	"\x12CancelRideResponse\x12-\n" +
+	"\tcancelled\x18\x01 \x03(\v2\x0f.proto.v1.MatchR\tcancelled\"0\n" +
+	"\x13ReportNoShowRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"=\n" +
+	"\x14ReportNoShowResponse\x12%\n" +
+	"\x05match\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\x05match\"2\n" +
+	"\x15IssuePickupPinRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"e\n" +
+	"\x16IssuePickupPinResponse\x12\x10\n" +
+	"\x03pin\x18\x01 \x01(\tR\x03pin\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"B\n" +
+	"\x13CheckInRiderRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x10\n" +
+	"\x03pin\x18\x02 \x01(\tR\x03pin\"=\n" +
+	"\x14CheckInRiderResponse\x12%\n" +
+	"\x05match\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\x05match\"\xe3\x01\n" +
+	"\n" +
+	"MatchEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bmatch_id\x18\x02 \x01(\tR\amatchId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x1f\n" +
+	"\vfrom_status\x18\x04 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x05 \x01(\tR\btoStatus\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"3\n" +
+	"\x16GetMatchHistoryRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"G\n" +
+	"\x17GetMatchHistoryResponse\x12,\n" +
+	"\x06events\x18\x01 \x03(\v2\x14.proto.v1.MatchEventR\x06events\"7\n" +
+	"\x1aConfirmWaitlistSeatRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"D\n" +
+	"\x1bConfirmWaitlistSeatResponse\x12%\n" +
+	"\x05match\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\x05match\"7\n" +
+	"\x1aGetWaitlistPositionRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"x\n" +
+	"\x1bGetWaitlistPositionResponse\x12%\n" +
+	"\x05match\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\x05match\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x05R\x06length\"\xa8\x01\n" +
+	"\x17RequestRoundTripRequest\x12:\n" +
+	"\boutbound\x18\x01 \x01(\v2\x1e.proto.v1.RequestToJoinRequestR\boutbound\x12=\n" +
+	"\n" +
+	"return_leg\x18\x02 \x01(\v2\x1e.proto.v1.RequestToJoinRequestR\treturnLeg\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\"w\n" +
+	"\x18RequestRoundTripResponse\x12+\n" +
+	"\boutbound\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\boutbound\x12.\n" +
+	"\n" +
+	"return_leg\x18\x02 \x01(\v2\x0f.proto.v1.MatchR\treturnLeg\"\xb7\x01\n" +
+	"\fFareProposal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bmatch_id\x18\x02 \x01(\tR\amatchId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x12\x12\n" +
+	"\x04fare\x18\x04 \x01(\x01R\x04fare\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"W\n" +
+	"\x12CounterFareRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x12\n" +
+	"\x04fare\x18\x02 \x01(\x01R\x04fare\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"<\n" +
+	"\x13CounterFareResponse\x12%\n" +
+	"\x05match\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\x05match\".\n" +
+	"\x11AcceptFareRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\";\n" +
+	"\x12AcceptFareResponse\x12%\n" +
+	"\x05match\x18\x01 \x01(\v2\x0f.proto.v1.MatchR\x05match\"2\n" +
+	"\x15GetFareHistoryRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"N\n" +
+	"\x16GetFareHistoryResponse\x124\n" +
+	"\tproposals\x18\x01 \x03(\v2\x16.proto.v1.FareProposalR\tproposals2\xcd\x0e\n" +
 	"\fMatchService\x12P\n" +
 	"\rRequestToJoin\x12\x1e.proto.v1.RequestToJoinRequest\x1a\x1f.proto.v1.RequestToJoinResponse\x12\\\n" +
 	"\x11AcceptRideRequest\x12\".proto.v1.AcceptRideRequestRequest\x1a#.proto.v1.AcceptRideRequestResponse\x12P\n" +
@@ -948,7 +2968,22 @@ const file_proto_v1_match_proto_rawDesc = "" +
 	"\bGetMatch\x12\x19.proto.v1.GetMatchRequest\x1a\x1a.proto.v1.GetMatchResponse\x12\\\n" +
 	"\x11ListMatchesByRide\x12\".proto.v1.ListMatchesByRideRequest\x1a#.proto.v1.ListMatchesByRideResponse\x12_\n" +
 	"\x12ListMatchesByRider\x12#.proto.v1.ListMatchesByRiderRequest\x1a$.proto.v1.ListMatchesByRiderResponse\x12P\n" +
-	"\rListMyMatches\x12\x1e.proto.v1.ListMyMatchesRequest\x1a\x1f.proto.v1.ListMyMatchesResponseB\x12Z\x10./proto/v1/matchb\x06proto3"
+	"\rListMyMatches\x12\x1e.proto.v1.ListMyMatchesRequest\x1a\x1f.proto.v1.ListMyMatchesResponse\x12V\n" +
+	"\x0fWithdrawRequest\x12 .proto.v1.WithdrawRequestRequest\x1a!.proto.v1.WithdrawRequestResponse\x12J\n" +
+	"\vCancelMatch\x12\x1c.proto.v1.CancelMatchRequest\x1a\x1d.proto.v1.CancelMatchResponse\x12G\n" +
+	"\n" +
+	"CancelRide\x12\x1b.proto.v1.CancelRideRequest\x1a\x1c.proto.v1.CancelRideResponse\x12M\n" +
+	"\fReportNoShow\x12\x1d.proto.v1.ReportNoShowRequest\x1a\x1e.proto.v1.ReportNoShowResponse\x12S\n" +
+	"\x0eIssuePickupPin\x12\x1f.proto.v1.IssuePickupPinRequest\x1a .proto.v1.IssuePickupPinResponse\x12M\n" +
+	"\fCheckInRider\x12\x1d.proto.v1.CheckInRiderRequest\x1a\x1e.proto.v1.CheckInRiderResponse\x12V\n" +
+	"\x0fGetMatchHistory\x12 .proto.v1.GetMatchHistoryRequest\x1a!.proto.v1.GetMatchHistoryResponse\x12b\n" +
+	"\x13ConfirmWaitlistSeat\x12$.proto.v1.ConfirmWaitlistSeatRequest\x1a%.proto.v1.ConfirmWaitlistSeatResponse\x12b\n" +
+	"\x13GetWaitlistPosition\x12$.proto.v1.GetWaitlistPositionRequest\x1a%.proto.v1.GetWaitlistPositionResponse\x12Y\n" +
+	"\x10RequestRoundTrip\x12!.proto.v1.RequestRoundTripRequest\x1a\".proto.v1.RequestRoundTripResponse\x12J\n" +
+	"\vCounterFare\x12\x1c.proto.v1.CounterFareRequest\x1a\x1d.proto.v1.CounterFareResponse\x12G\n" +
+	"\n" +
+	"AcceptFare\x12\x1b.proto.v1.AcceptFareRequest\x1a\x1c.proto.v1.AcceptFareResponse\x12S\n" +
+	"\x0eGetFareHistory\x12\x1f.proto.v1.GetFareHistoryRequest\x1a .proto.v1.GetFareHistoryResponseB\x12Z\x10./proto/v1/matchb\x06proto3"
 
 var (
 	file_proto_v1_match_proto_rawDescOnce sync.Once
@@ -962,63 +2997,151 @@ func file_proto_v1_match_proto_rawDescGZIP() []byte {
 	return file_proto_v1_match_proto_rawDescData
 }
 
-var file_proto_v1_match_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_proto_v1_match_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_proto_v1_match_proto_goTypes = []any{
-	(*Match)(nil),                      // 0: proto.v1.Match
-	(*RequestToJoinRequest)(nil),       // 1: proto.v1.RequestToJoinRequest
-	(*RequestToJoinResponse)(nil),      // 2: proto.v1.RequestToJoinResponse
-	(*AcceptRideRequestRequest)(nil),   // 3: proto.v1.AcceptRideRequestRequest
-	(*AcceptRideRequestResponse)(nil),  // 4: proto.v1.AcceptRideRequestResponse
-	(*AcceptRequestRequest)(nil),       // 5: proto.v1.AcceptRequestRequest
-	(*AcceptRequestResponse)(nil),      // 6: proto.v1.AcceptRequestResponse
-	(*RejectRequestRequest)(nil),       // 7: proto.v1.RejectRequestRequest
-	(*RejectRequestResponse)(nil),      // 8: proto.v1.RejectRequestResponse
-	(*CompleteMatchRequest)(nil),       // 9: proto.v1.CompleteMatchRequest
-	(*CompleteMatchResponse)(nil),      // 10: proto.v1.CompleteMatchResponse
-	(*GetMatchRequest)(nil),            // 11: proto.v1.GetMatchRequest
-	(*GetMatchResponse)(nil),           // 12: proto.v1.GetMatchResponse
-	(*ListMatchesByRideRequest)(nil),   // 13: proto.v1.ListMatchesByRideRequest
-	(*ListMatchesByRideResponse)(nil),  // 14: proto.v1.ListMatchesByRideResponse
-	(*ListMatchesByRiderRequest)(nil),  // 15: proto.v1.ListMatchesByRiderRequest
-	(*ListMatchesByRiderResponse)(nil), // 16: proto.v1.ListMatchesByRiderResponse
-	(*ListMyMatchesRequest)(nil),       // 17: proto.v1.ListMyMatchesRequest
-	(*ListMyMatchesResponse)(nil),      // 18: proto.v1.ListMyMatchesResponse
-	(*timestamppb.Timestamp)(nil),      // 19: google.protobuf.Timestamp
+	(*Match)(nil),                       // 0: proto.v1.Match
+	(*PickupWindow)(nil),                // 1: proto.v1.PickupWindow
+	(*RequestToJoinRequest)(nil),        // 2: proto.v1.RequestToJoinRequest
+	(*RequestToJoinResponse)(nil),       // 3: proto.v1.RequestToJoinResponse
+	(*AcceptRideRequestRequest)(nil),    // 4: proto.v1.AcceptRideRequestRequest
+	(*AcceptRideRequestResponse)(nil),   // 5: proto.v1.AcceptRideRequestResponse
+	(*AcceptRequestRequest)(nil),        // 6: proto.v1.AcceptRequestRequest
+	(*AcceptRequestResponse)(nil),       // 7: proto.v1.AcceptRequestResponse
+	(*RejectRequestRequest)(nil),        // 8: proto.v1.RejectRequestRequest
+	(*RejectRequestResponse)(nil),       // 9: proto.v1.RejectRequestResponse
+	(*CompleteMatchRequest)(nil),        // 10: proto.v1.CompleteMatchRequest
+	(*CompleteMatchResponse)(nil),       // 11: proto.v1.CompleteMatchResponse
+	(*GetMatchRequest)(nil),             // 12: proto.v1.GetMatchRequest
+	(*GetMatchResponse)(nil),            // 13: proto.v1.GetMatchResponse
+	(*ListMatchesByRideRequest)(nil),    // 14: proto.v1.ListMatchesByRideRequest
+	(*ListMatchesByRideResponse)(nil),   // 15: proto.v1.ListMatchesByRideResponse
+	(*ListMatchesByRiderRequest)(nil),   // 16: proto.v1.ListMatchesByRiderRequest
+	(*ListMatchesByRiderResponse)(nil),  // 17: proto.v1.ListMatchesByRiderResponse
+	(*ListMyMatchesRequest)(nil),        // 18: proto.v1.ListMyMatchesRequest
+	(*ListMyMatchesResponse)(nil),       // 19: proto.v1.ListMyMatchesResponse
+	(*WithdrawRequestRequest)(nil),      // 20: proto.v1.WithdrawRequestRequest
+	(*WithdrawRequestResponse)(nil),     // 21: proto.v1.WithdrawRequestResponse
+	(*CancelMatchRequest)(nil),          // 22: proto.v1.CancelMatchRequest
+	(*CancelMatchResponse)(nil),         // 23: proto.v1.CancelMatchResponse
+	(*CancelRideRequest)(nil),           // 24: proto.v1.CancelRideRequest
+	(*CancelRideResponse)(nil),          // 25: proto.v1.CancelRideResponse
+	(*ReportNoShowRequest)(nil),         // 26: proto.v1.ReportNoShowRequest
+	(*ReportNoShowResponse)(nil),        // 27: proto.v1.ReportNoShowResponse
+	(*IssuePickupPinRequest)(nil),       // 28: proto.v1.IssuePickupPinRequest
+	(*IssuePickupPinResponse)(nil),      // 29: proto.v1.IssuePickupPinResponse
+	(*CheckInRiderRequest)(nil),         // 30: proto.v1.CheckInRiderRequest
+	(*CheckInRiderResponse)(nil),        // 31: proto.v1.CheckInRiderResponse
+	(*MatchEvent)(nil),                  // 32: proto.v1.MatchEvent
+	(*GetMatchHistoryRequest)(nil),      // 33: proto.v1.GetMatchHistoryRequest
+	(*GetMatchHistoryResponse)(nil),     // 34: proto.v1.GetMatchHistoryResponse
+	(*ConfirmWaitlistSeatRequest)(nil),  // 35: proto.v1.ConfirmWaitlistSeatRequest
+	(*ConfirmWaitlistSeatResponse)(nil), // 36: proto.v1.ConfirmWaitlistSeatResponse
+	(*GetWaitlistPositionRequest)(nil),  // 37: proto.v1.GetWaitlistPositionRequest
+	(*GetWaitlistPositionResponse)(nil), // 38: proto.v1.GetWaitlistPositionResponse
+	(*RequestRoundTripRequest)(nil),     // 39: proto.v1.RequestRoundTripRequest
+	(*RequestRoundTripResponse)(nil),    // 40: proto.v1.RequestRoundTripResponse
+	(*FareProposal)(nil),                // 41: proto.v1.FareProposal
+	(*CounterFareRequest)(nil),          // 42: proto.v1.CounterFareRequest
+	(*CounterFareResponse)(nil),         // 43: proto.v1.CounterFareResponse
+	(*AcceptFareRequest)(nil),           // 44: proto.v1.AcceptFareRequest
+	(*AcceptFareResponse)(nil),          // 45: proto.v1.AcceptFareResponse
+	(*GetFareHistoryRequest)(nil),       // 46: proto.v1.GetFareHistoryRequest
+	(*GetFareHistoryResponse)(nil),      // 47: proto.v1.GetFareHistoryResponse
+	(*timestamppb.Timestamp)(nil),       // 48: google.protobuf.Timestamp
 }
 var file_proto_v1_match_proto_depIdxs = []int32{
-	19, // 0: proto.v1.Match.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 1: proto.v1.RequestToJoinResponse.match:type_name -> proto.v1.Match
-	0,  // 2: proto.v1.AcceptRideRequestResponse.match:type_name -> proto.v1.Match
-	0,  // 3: proto.v1.AcceptRequestResponse.match:type_name -> proto.v1.Match
-	0,  // 4: proto.v1.RejectRequestResponse.match:type_name -> proto.v1.Match
-	0,  // 5: proto.v1.CompleteMatchResponse.match:type_name -> proto.v1.Match
-	0,  // 6: proto.v1.GetMatchResponse.match:type_name -> proto.v1.Match
-	0,  // 7: proto.v1.ListMatchesByRideResponse.matches:type_name -> proto.v1.Match
-	0,  // 8: proto.v1.ListMatchesByRiderResponse.matches:type_name -> proto.v1.Match
-	0,  // 9: proto.v1.ListMyMatchesResponse.matches:type_name -> proto.v1.Match
-	1,  // 10: proto.v1.MatchService.RequestToJoin:input_type -> proto.v1.RequestToJoinRequest
-	3,  // 11: proto.v1.MatchService.AcceptRideRequest:input_type -> proto.v1.AcceptRideRequestRequest
-	5,  // 12: proto.v1.MatchService.AcceptRequest:input_type -> proto.v1.AcceptRequestRequest
-	7,  // 13: proto.v1.MatchService.RejectRequest:input_type -> proto.v1.RejectRequestRequest
-	9,  // 14: proto.v1.MatchService.CompleteMatch:input_type -> proto.v1.CompleteMatchRequest
-	11, // 15: proto.v1.MatchService.GetMatch:input_type -> proto.v1.GetMatchRequest
-	13, // 16: proto.v1.MatchService.ListMatchesByRide:input_type -> proto.v1.ListMatchesByRideRequest
-	15, // 17: proto.v1.MatchService.ListMatchesByRider:input_type -> proto.v1.ListMatchesByRiderRequest
-	17, // 18: proto.v1.MatchService.ListMyMatches:input_type -> proto.v1.ListMyMatchesRequest
-	2,  // 19: proto.v1.MatchService.RequestToJoin:output_type -> proto.v1.RequestToJoinResponse
-	4,  // 20: proto.v1.MatchService.AcceptRideRequest:output_type -> proto.v1.AcceptRideRequestResponse
-	6,  // 21: proto.v1.MatchService.AcceptRequest:output_type -> proto.v1.AcceptRequestResponse
-	8,  // 22: proto.v1.MatchService.RejectRequest:output_type -> proto.v1.RejectRequestResponse
-	10, // 23: proto.v1.MatchService.CompleteMatch:output_type -> proto.v1.CompleteMatchResponse
-	12, // 24: proto.v1.MatchService.GetMatch:output_type -> proto.v1.GetMatchResponse
-	14, // 25: proto.v1.MatchService.ListMatchesByRide:output_type -> proto.v1.ListMatchesByRideResponse
-	16, // 26: proto.v1.MatchService.ListMatchesByRider:output_type -> proto.v1.ListMatchesByRiderResponse
-	18, // 27: proto.v1.MatchService.ListMyMatches:output_type -> proto.v1.ListMyMatchesResponse
-	19, // [19:28] is the sub-list for method output_type
-	10, // [10:19] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	48, // 0: proto.v1.Match.created_at:type_name -> google.protobuf.Timestamp
+	48, // 1: proto.v1.Match.cancelled_at:type_name -> google.protobuf.Timestamp
+	48, // 2: proto.v1.Match.no_show_reported_at:type_name -> google.protobuf.Timestamp
+	48, // 3: proto.v1.Match.started_at:type_name -> google.protobuf.Timestamp
+	48, // 4: proto.v1.Match.completed_at:type_name -> google.protobuf.Timestamp
+	48, // 5: proto.v1.Match.confirm_by:type_name -> google.protobuf.Timestamp
+	1,  // 6: proto.v1.Match.pickup_window:type_name -> proto.v1.PickupWindow
+	48, // 7: proto.v1.Match.pickup_at:type_name -> google.protobuf.Timestamp
+	48, // 8: proto.v1.Match.depart_at:type_name -> google.protobuf.Timestamp
+	48, // 9: proto.v1.PickupWindow.earliest:type_name -> google.protobuf.Timestamp
+	48, // 10: proto.v1.PickupWindow.latest:type_name -> google.protobuf.Timestamp
+	48, // 11: proto.v1.PickupWindow.arrive_by:type_name -> google.protobuf.Timestamp
+	1,  // 12: proto.v1.RequestToJoinRequest.pickup_window:type_name -> proto.v1.PickupWindow
+	48, // 13: proto.v1.RequestToJoinRequest.pickup_at:type_name -> google.protobuf.Timestamp
+	0,  // 14: proto.v1.RequestToJoinResponse.match:type_name -> proto.v1.Match
+	48, // 15: proto.v1.AcceptRideRequestRequest.pickup_at:type_name -> google.protobuf.Timestamp
+	0,  // 16: proto.v1.AcceptRideRequestResponse.match:type_name -> proto.v1.Match
+	48, // 17: proto.v1.AcceptRequestRequest.pickup_at:type_name -> google.protobuf.Timestamp
+	0,  // 18: proto.v1.AcceptRequestResponse.match:type_name -> proto.v1.Match
+	0,  // 19: proto.v1.RejectRequestResponse.match:type_name -> proto.v1.Match
+	0,  // 20: proto.v1.CompleteMatchResponse.match:type_name -> proto.v1.Match
+	0,  // 21: proto.v1.GetMatchResponse.match:type_name -> proto.v1.Match
+	0,  // 22: proto.v1.ListMatchesByRideResponse.matches:type_name -> proto.v1.Match
+	0,  // 23: proto.v1.ListMatchesByRiderResponse.matches:type_name -> proto.v1.Match
+	0,  // 24: proto.v1.ListMyMatchesResponse.matches:type_name -> proto.v1.Match
+	0,  // 25: proto.v1.WithdrawRequestResponse.match:type_name -> proto.v1.Match
+	0,  // 26: proto.v1.CancelMatchResponse.match:type_name -> proto.v1.Match
+	0,  // 27: proto.v1.CancelRideResponse.cancelled:type_name -> proto.v1.Match
+	0,  // 28: proto.v1.ReportNoShowResponse.match:type_name -> proto.v1.Match
+	48, // 29: proto.v1.IssuePickupPinResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 30: proto.v1.CheckInRiderResponse.match:type_name -> proto.v1.Match
+	48, // 31: proto.v1.MatchEvent.created_at:type_name -> google.protobuf.Timestamp
+	32, // 32: proto.v1.GetMatchHistoryResponse.events:type_name -> proto.v1.MatchEvent
+	0,  // 33: proto.v1.ConfirmWaitlistSeatResponse.match:type_name -> proto.v1.Match
+	0,  // 34: proto.v1.GetWaitlistPositionResponse.match:type_name -> proto.v1.Match
+	2,  // 35: proto.v1.RequestRoundTripRequest.outbound:type_name -> proto.v1.RequestToJoinRequest
+	2,  // 36: proto.v1.RequestRoundTripRequest.return_leg:type_name -> proto.v1.RequestToJoinRequest
+	0,  // 37: proto.v1.RequestRoundTripResponse.outbound:type_name -> proto.v1.Match
+	0,  // 38: proto.v1.RequestRoundTripResponse.return_leg:type_name -> proto.v1.Match
+	48, // 39: proto.v1.FareProposal.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 40: proto.v1.CounterFareResponse.match:type_name -> proto.v1.Match
+	0,  // 41: proto.v1.AcceptFareResponse.match:type_name -> proto.v1.Match
+	41, // 42: proto.v1.GetFareHistoryResponse.proposals:type_name -> proto.v1.FareProposal
+	2,  // 43: proto.v1.MatchService.RequestToJoin:input_type -> proto.v1.RequestToJoinRequest
+	4,  // 44: proto.v1.MatchService.AcceptRideRequest:input_type -> proto.v1.AcceptRideRequestRequest
+	6,  // 45: proto.v1.MatchService.AcceptRequest:input_type -> proto.v1.AcceptRequestRequest
+	8,  // 46: proto.v1.MatchService.RejectRequest:input_type -> proto.v1.RejectRequestRequest
+	10, // 47: proto.v1.MatchService.CompleteMatch:input_type -> proto.v1.CompleteMatchRequest
+	12, // 48: proto.v1.MatchService.GetMatch:input_type -> proto.v1.GetMatchRequest
+	14, // 49: proto.v1.MatchService.ListMatchesByRide:input_type -> proto.v1.ListMatchesByRideRequest
+	16, // 50: proto.v1.MatchService.ListMatchesByRider:input_type -> proto.v1.ListMatchesByRiderRequest
+	18, // 51: proto.v1.MatchService.ListMyMatches:input_type -> proto.v1.ListMyMatchesRequest
+	20, // 52: proto.v1.MatchService.WithdrawRequest:input_type -> proto.v1.WithdrawRequestRequest
+	22, // 53: proto.v1.MatchService.CancelMatch:input_type -> proto.v1.CancelMatchRequest
+	24, // 54: proto.v1.MatchService.CancelRide:input_type -> proto.v1.CancelRideRequest
+	26, // 55: proto.v1.MatchService.ReportNoShow:input_type -> proto.v1.ReportNoShowRequest
+	28, // 56: proto.v1.MatchService.IssuePickupPin:input_type -> proto.v1.IssuePickupPinRequest
+	30, // 57: proto.v1.MatchService.CheckInRider:input_type -> proto.v1.CheckInRiderRequest
+	33, // 58: proto.v1.MatchService.GetMatchHistory:input_type -> proto.v1.GetMatchHistoryRequest
+	35, // 59: proto.v1.MatchService.ConfirmWaitlistSeat:input_type -> proto.v1.ConfirmWaitlistSeatRequest
+	37, // 60: proto.v1.MatchService.GetWaitlistPosition:input_type -> proto.v1.GetWaitlistPositionRequest
+	39, // 61: proto.v1.MatchService.RequestRoundTrip:input_type -> proto.v1.RequestRoundTripRequest
+	42, // 62: proto.v1.MatchService.CounterFare:input_type -> proto.v1.CounterFareRequest
+	44, // 63: proto.v1.MatchService.AcceptFare:input_type -> proto.v1.AcceptFareRequest
+	46, // 64: proto.v1.MatchService.GetFareHistory:input_type -> proto.v1.GetFareHistoryRequest
+	3,  // 65: proto.v1.MatchService.RequestToJoin:output_type -> proto.v1.RequestToJoinResponse
+	5,  // 66: proto.v1.MatchService.AcceptRideRequest:output_type -> proto.v1.AcceptRideRequestResponse
+	7,  // 67: proto.v1.MatchService.AcceptRequest:output_type -> proto.v1.AcceptRequestResponse
+	9,  // 68: proto.v1.MatchService.RejectRequest:output_type -> proto.v1.RejectRequestResponse
+	11, // 69: proto.v1.MatchService.CompleteMatch:output_type -> proto.v1.CompleteMatchResponse
+	13, // 70: proto.v1.MatchService.GetMatch:output_type -> proto.v1.GetMatchResponse
+	15, // 71: proto.v1.MatchService.ListMatchesByRide:output_type -> proto.v1.ListMatchesByRideResponse
+	17, // 72: proto.v1.MatchService.ListMatchesByRider:output_type -> proto.v1.ListMatchesByRiderResponse
+	19, // 73: proto.v1.MatchService.ListMyMatches:output_type -> proto.v1.ListMyMatchesResponse
+	21, // 74: proto.v1.MatchService.WithdrawRequest:output_type -> proto.v1.WithdrawRequestResponse
+	23, // 75: proto.v1.MatchService.CancelMatch:output_type -> proto.v1.CancelMatchResponse
+	25, // 76: proto.v1.MatchService.CancelRide:output_type -> proto.v1.CancelRideResponse
+	27, // 77: proto.v1.MatchService.ReportNoShow:output_type -> proto.v1.ReportNoShowResponse
+	29, // 78: proto.v1.MatchService.IssuePickupPin:output_type -> proto.v1.IssuePickupPinResponse
+	31, // 79: proto.v1.MatchService.CheckInRider:output_type -> proto.v1.CheckInRiderResponse
+	34, // 80: proto.v1.MatchService.GetMatchHistory:output_type -> proto.v1.GetMatchHistoryResponse
+	36, // 81: proto.v1.MatchService.ConfirmWaitlistSeat:output_type -> proto.v1.ConfirmWaitlistSeatResponse
+	38, // 82: proto.v1.MatchService.GetWaitlistPosition:output_type -> proto.v1.GetWaitlistPositionResponse
+	40, // 83: proto.v1.MatchService.RequestRoundTrip:output_type -> proto.v1.RequestRoundTripResponse
+	43, // 84: proto.v1.MatchService.CounterFare:output_type -> proto.v1.CounterFareResponse
+	45, // 85: proto.v1.MatchService.AcceptFare:output_type -> proto.v1.AcceptFareResponse
+	47, // 86: proto.v1.MatchService.GetFareHistory:output_type -> proto.v1.GetFareHistoryResponse
+	65, // [65:87] is the sub-list for method output_type
+	43, // [43:65] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_match_proto_init() }
@@ -1032,7 +3155,7 @@ func file_proto_v1_match_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_match_proto_rawDesc), len(file_proto_v1_match_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,15 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MatchService_RequestToJoin_FullMethodName      = "/proto.v1.MatchService/RequestToJoin"
-	MatchService_AcceptRideRequest_FullMethodName  = "/proto.v1.MatchService/AcceptRideRequest"
-	MatchService_AcceptRequest_FullMethodName      = "/proto.v1.MatchService/AcceptRequest"
-	MatchService_RejectRequest_FullMethodName      = "/proto.v1.MatchService/RejectRequest"
-	MatchService_CompleteMatch_FullMethodName      = "/proto.v1.MatchService/CompleteMatch"
-	MatchService_GetMatch_FullMethodName           = "/proto.v1.MatchService/GetMatch"
-	MatchService_ListMatchesByRide_FullMethodName  = "/proto.v1.MatchService/ListMatchesByRide"
-	MatchService_ListMatchesByRider_FullMethodName = "/proto.v1.MatchService/ListMatchesByRider"
-	MatchService_ListMyMatches_FullMethodName      = "/proto.v1.MatchService/ListMyMatches"
+	MatchService_RequestToJoin_FullMethodName       = "/proto.v1.MatchService/RequestToJoin"
+	MatchService_AcceptRideRequest_FullMethodName   = "/proto.v1.MatchService/AcceptRideRequest"
+	MatchService_AcceptRequest_FullMethodName       = "/proto.v1.MatchService/AcceptRequest"
+	MatchService_RejectRequest_FullMethodName       = "/proto.v1.MatchService/RejectRequest"
+	MatchService_CompleteMatch_FullMethodName       = "/proto.v1.MatchService/CompleteMatch"
+	MatchService_GetMatch_FullMethodName            = "/proto.v1.MatchService/GetMatch"
+	MatchService_ListMatchesByRide_FullMethodName   = "/proto.v1.MatchService/ListMatchesByRide"
+	MatchService_ListMatchesByRider_FullMethodName  = "/proto.v1.MatchService/ListMatchesByRider"
+	MatchService_ListMyMatches_FullMethodName       = "/proto.v1.MatchService/ListMyMatches"
+	MatchService_WithdrawRequest_FullMethodName     = "/proto.v1.MatchService/WithdrawRequest"
+	MatchService_CancelMatch_FullMethodName         = "/proto.v1.MatchService/CancelMatch"
+	MatchService_CancelRide_FullMethodName          = "/proto.v1.MatchService/CancelRide"
+	MatchService_ReportNoShow_FullMethodName        = "/proto.v1.MatchService/ReportNoShow"
+	MatchService_IssuePickupPin_FullMethodName      = "/proto.v1.MatchService/IssuePickupPin"
+	MatchService_CheckInRider_FullMethodName        = "/proto.v1.MatchService/CheckInRider"
+	MatchService_GetMatchHistory_FullMethodName     = "/proto.v1.MatchService/GetMatchHistory"
+	MatchService_ConfirmWaitlistSeat_FullMethodName = "/proto.v1.MatchService/ConfirmWaitlistSeat"
+	MatchService_GetWaitlistPosition_FullMethodName = "/proto.v1.MatchService/GetWaitlistPosition"
+	MatchService_RequestRoundTrip_FullMethodName    = "/proto.v1.MatchService/RequestRoundTrip"
+	MatchService_CounterFare_FullMethodName         = "/proto.v1.MatchService/CounterFare"
+	MatchService_AcceptFare_FullMethodName          = "/proto.v1.MatchService/AcceptFare"
+	MatchService_GetFareHistory_FullMethodName      = "/proto.v1.MatchService/GetFareHistory"
 )
 
 // MatchServiceClient is the client API for MatchService service.
@@ -43,6 +56,19 @@ type MatchServiceClient interface {
 	ListMatchesByRide(ctx context.Context, in *ListMatchesByRideRequest, opts ...grpc.CallOption) (*ListMatchesByRideResponse, error)
 	ListMatchesByRider(ctx context.Context, in *ListMatchesByRiderRequest, opts ...grpc.CallOption) (*ListMatchesByRiderResponse, error)
 	ListMyMatches(ctx context.Context, in *ListMyMatchesRequest, opts ...grpc.CallOption) (*ListMyMatchesResponse, error)
+	WithdrawRequest(ctx context.Context, in *WithdrawRequestRequest, opts ...grpc.CallOption) (*WithdrawRequestResponse, error)
+	CancelMatch(ctx context.Context, in *CancelMatchRequest, opts ...grpc.CallOption) (*CancelMatchResponse, error)
+	CancelRide(ctx context.Context, in *CancelRideRequest, opts ...grpc.CallOption) (*CancelRideResponse, error)
+	ReportNoShow(ctx context.Context, in *ReportNoShowRequest, opts ...grpc.CallOption) (*ReportNoShowResponse, error)
+	IssuePickupPin(ctx context.Context, in *IssuePickupPinRequest, opts ...grpc.CallOption) (*IssuePickupPinResponse, error)
+	CheckInRider(ctx context.Context, in *CheckInRiderRequest, opts ...grpc.CallOption) (*CheckInRiderResponse, error)
+	GetMatchHistory(ctx context.Context, in *GetMatchHistoryRequest, opts ...grpc.CallOption) (*GetMatchHistoryResponse, error)
+	ConfirmWaitlistSeat(ctx context.Context, in *ConfirmWaitlistSeatRequest, opts ...grpc.CallOption) (*ConfirmWaitlistSeatResponse, error)
+	GetWaitlistPosition(ctx context.Context, in *GetWaitlistPositionRequest, opts ...grpc.CallOption) (*GetWaitlistPositionResponse, error)
+	RequestRoundTrip(ctx context.Context, in *RequestRoundTripRequest, opts ...grpc.CallOption) (*RequestRoundTripResponse, error)
+	CounterFare(ctx context.Context, in *CounterFareRequest, opts ...grpc.CallOption) (*CounterFareResponse, error)
+	AcceptFare(ctx context.Context, in *AcceptFareRequest, opts ...grpc.CallOption) (*AcceptFareResponse, error)
+	GetFareHistory(ctx context.Context, in *GetFareHistoryRequest, opts ...grpc.CallOption) (*GetFareHistoryResponse, error)
 }
 
 type matchServiceClient struct {
@@ -143,6 +169,136 @@ func (c *matchServiceClient) ListMyMatches(ctx context.Context, in *ListMyMatche
 	return out, nil
 }
 
+func (c *matchServiceClient) WithdrawRequest(ctx context.Context, in *WithdrawRequestRequest, opts ...grpc.CallOption) (*WithdrawRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawRequestResponse)
+	err := c.cc.Invoke(ctx, MatchService_WithdrawRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) CancelMatch(ctx context.Context, in *CancelMatchRequest, opts ...grpc.CallOption) (*CancelMatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelMatchResponse)
+	err := c.cc.Invoke(ctx, MatchService_CancelMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) CancelRide(ctx context.Context, in *CancelRideRequest, opts ...grpc.CallOption) (*CancelRideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelRideResponse)
+	err := c.cc.Invoke(ctx, MatchService_CancelRide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) ReportNoShow(ctx context.Context, in *ReportNoShowRequest, opts ...grpc.CallOption) (*ReportNoShowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportNoShowResponse)
+	err := c.cc.Invoke(ctx, MatchService_ReportNoShow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) IssuePickupPin(ctx context.Context, in *IssuePickupPinRequest, opts ...grpc.CallOption) (*IssuePickupPinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssuePickupPinResponse)
+	err := c.cc.Invoke(ctx, MatchService_IssuePickupPin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) CheckInRider(ctx context.Context, in *CheckInRiderRequest, opts ...grpc.CallOption) (*CheckInRiderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInRiderResponse)
+	err := c.cc.Invoke(ctx, MatchService_CheckInRider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) GetMatchHistory(ctx context.Context, in *GetMatchHistoryRequest, opts ...grpc.CallOption) (*GetMatchHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMatchHistoryResponse)
+	err := c.cc.Invoke(ctx, MatchService_GetMatchHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) ConfirmWaitlistSeat(ctx context.Context, in *ConfirmWaitlistSeatRequest, opts ...grpc.CallOption) (*ConfirmWaitlistSeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmWaitlistSeatResponse)
+	err := c.cc.Invoke(ctx, MatchService_ConfirmWaitlistSeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) GetWaitlistPosition(ctx context.Context, in *GetWaitlistPositionRequest, opts ...grpc.CallOption) (*GetWaitlistPositionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWaitlistPositionResponse)
+	err := c.cc.Invoke(ctx, MatchService_GetWaitlistPosition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) RequestRoundTrip(ctx context.Context, in *RequestRoundTripRequest, opts ...grpc.CallOption) (*RequestRoundTripResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestRoundTripResponse)
+	err := c.cc.Invoke(ctx, MatchService_RequestRoundTrip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) CounterFare(ctx context.Context, in *CounterFareRequest, opts ...grpc.CallOption) (*CounterFareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CounterFareResponse)
+	err := c.cc.Invoke(ctx, MatchService_CounterFare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) AcceptFare(ctx context.Context, in *AcceptFareRequest, opts ...grpc.CallOption) (*AcceptFareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptFareResponse)
+	err := c.cc.Invoke(ctx, MatchService_AcceptFare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchServiceClient) GetFareHistory(ctx context.Context, in *GetFareHistoryRequest, opts ...grpc.CallOption) (*GetFareHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFareHistoryResponse)
+	err := c.cc.Invoke(ctx, MatchService_GetFareHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MatchServiceServer is the server API for MatchService service.
 // All implementations must embed UnimplementedMatchServiceServer
 // for forward compatibility.
@@ -156,6 +312,19 @@ type MatchServiceServer interface {
 	ListMatchesByRide(context.Context, *ListMatchesByRideRequest) (*ListMatchesByRideResponse, error)
 	ListMatchesByRider(context.Context, *ListMatchesByRiderRequest) (*ListMatchesByRiderResponse, error)
 	ListMyMatches(context.Context, *ListMyMatchesRequest) (*ListMyMatchesResponse, error)
+	WithdrawRequest(context.Context, *WithdrawRequestRequest) (*WithdrawRequestResponse, error)
+	CancelMatch(context.Context, *CancelMatchRequest) (*CancelMatchResponse, error)
+	CancelRide(context.Context, *CancelRideRequest) (*CancelRideResponse, error)
+	ReportNoShow(context.Context, *ReportNoShowRequest) (*ReportNoShowResponse, error)
+	IssuePickupPin(context.Context, *IssuePickupPinRequest) (*IssuePickupPinResponse, error)
+	CheckInRider(context.Context, *CheckInRiderRequest) (*CheckInRiderResponse, error)
+	GetMatchHistory(context.Context, *GetMatchHistoryRequest) (*GetMatchHistoryResponse, error)
+	ConfirmWaitlistSeat(context.Context, *ConfirmWaitlistSeatRequest) (*ConfirmWaitlistSeatResponse, error)
+	GetWaitlistPosition(context.Context, *GetWaitlistPositionRequest) (*GetWaitlistPositionResponse, error)
+	RequestRoundTrip(context.Context, *RequestRoundTripRequest) (*RequestRoundTripResponse, error)
+	CounterFare(context.Context, *CounterFareRequest) (*CounterFareResponse, error)
+	AcceptFare(context.Context, *AcceptFareRequest) (*AcceptFareResponse, error)
+	GetFareHistory(context.Context, *GetFareHistoryRequest) (*GetFareHistoryResponse, error)
 	mustEmbedUnimplementedMatchServiceServer()
 }
 
@@ -193,6 +362,45 @@ func (UnimplementedMatchServiceServer) ListMatchesByRider(context.Context, *List
 func (UnimplementedMatchServiceServer) ListMyMatches(context.Context, *ListMyMatchesRequest) (*ListMyMatchesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMyMatches not implemented")
 }
+func (UnimplementedMatchServiceServer) WithdrawRequest(context.Context, *WithdrawRequestRequest) (*WithdrawRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRequest not implemented")
+}
+func (UnimplementedMatchServiceServer) CancelMatch(context.Context, *CancelMatchRequest) (*CancelMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMatch not implemented")
+}
+func (UnimplementedMatchServiceServer) CancelRide(context.Context, *CancelRideRequest) (*CancelRideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRide not implemented")
+}
+func (UnimplementedMatchServiceServer) ReportNoShow(context.Context, *ReportNoShowRequest) (*ReportNoShowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportNoShow not implemented")
+}
+func (UnimplementedMatchServiceServer) IssuePickupPin(context.Context, *IssuePickupPinRequest) (*IssuePickupPinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuePickupPin not implemented")
+}
+func (UnimplementedMatchServiceServer) CheckInRider(context.Context, *CheckInRiderRequest) (*CheckInRiderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInRider not implemented")
+}
+func (UnimplementedMatchServiceServer) GetMatchHistory(context.Context, *GetMatchHistoryRequest) (*GetMatchHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatchHistory not implemented")
+}
+func (UnimplementedMatchServiceServer) ConfirmWaitlistSeat(context.Context, *ConfirmWaitlistSeatRequest) (*ConfirmWaitlistSeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmWaitlistSeat not implemented")
+}
+func (UnimplementedMatchServiceServer) GetWaitlistPosition(context.Context, *GetWaitlistPositionRequest) (*GetWaitlistPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaitlistPosition not implemented")
+}
+func (UnimplementedMatchServiceServer) RequestRoundTrip(context.Context, *RequestRoundTripRequest) (*RequestRoundTripResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRoundTrip not implemented")
+}
+func (UnimplementedMatchServiceServer) CounterFare(context.Context, *CounterFareRequest) (*CounterFareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CounterFare not implemented")
+}
+func (UnimplementedMatchServiceServer) AcceptFare(context.Context, *AcceptFareRequest) (*AcceptFareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFare not implemented")
+}
+func (UnimplementedMatchServiceServer) GetFareHistory(context.Context, *GetFareHistoryRequest) (*GetFareHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFareHistory not implemented")
+}
 func (UnimplementedMatchServiceServer) mustEmbedUnimplementedMatchServiceServer() {}
 func (UnimplementedMatchServiceServer) testEmbeddedByValue()                      {}
 
@@ -376,6 +584,240 @@ func _MatchService_ListMyMatches_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchService_WithdrawRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).WithdrawRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_WithdrawRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).WithdrawRequest(ctx, req.(*WithdrawRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_CancelMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).CancelMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_CancelMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).CancelMatch(ctx, req.(*CancelMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_CancelRide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).CancelRide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_CancelRide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).CancelRide(ctx, req.(*CancelRideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_ReportNoShow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportNoShowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).ReportNoShow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_ReportNoShow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).ReportNoShow(ctx, req.(*ReportNoShowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_IssuePickupPin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssuePickupPinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).IssuePickupPin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_IssuePickupPin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).IssuePickupPin(ctx, req.(*IssuePickupPinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_CheckInRider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRiderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).CheckInRider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_CheckInRider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).CheckInRider(ctx, req.(*CheckInRiderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_GetMatchHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).GetMatchHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_GetMatchHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).GetMatchHistory(ctx, req.(*GetMatchHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_ConfirmWaitlistSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmWaitlistSeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).ConfirmWaitlistSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_ConfirmWaitlistSeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).ConfirmWaitlistSeat(ctx, req.(*ConfirmWaitlistSeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_GetWaitlistPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitlistPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).GetWaitlistPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_GetWaitlistPosition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).GetWaitlistPosition(ctx, req.(*GetWaitlistPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_RequestRoundTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestRoundTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).RequestRoundTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_RequestRoundTrip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).RequestRoundTrip(ctx, req.(*RequestRoundTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_CounterFare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CounterFareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).CounterFare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_CounterFare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).CounterFare(ctx, req.(*CounterFareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_AcceptFare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptFareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).AcceptFare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_AcceptFare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).AcceptFare(ctx, req.(*AcceptFareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchService_GetFareHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFareHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchServiceServer).GetFareHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchService_GetFareHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchServiceServer).GetFareHistory(ctx, req.(*GetFareHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MatchService_ServiceDesc is the grpc.ServiceDesc for MatchService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -419,6 +861,58 @@ var MatchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMyMatches",
 			Handler:    _MatchService_ListMyMatches_Handler,
 		},
+		{
+			MethodName: "WithdrawRequest",
+			Handler:    _MatchService_WithdrawRequest_Handler,
+		},
+		{
+			MethodName: "CancelMatch",
+			Handler:    _MatchService_CancelMatch_Handler,
+		},
+		{
+			MethodName: "CancelRide",
+			Handler:    _MatchService_CancelRide_Handler,
+		},
+		{
+			MethodName: "ReportNoShow",
+			Handler:    _MatchService_ReportNoShow_Handler,
+		},
+		{
+			MethodName: "IssuePickupPin",
+			Handler:    _MatchService_IssuePickupPin_Handler,
+		},
+		{
+			MethodName: "CheckInRider",
+			Handler:    _MatchService_CheckInRider_Handler,
+		},
+		{
+			MethodName: "GetMatchHistory",
+			Handler:    _MatchService_GetMatchHistory_Handler,
+		},
+		{
+			MethodName: "ConfirmWaitlistSeat",
+			Handler:    _MatchService_ConfirmWaitlistSeat_Handler,
+		},
+		{
+			MethodName: "GetWaitlistPosition",
+			Handler:    _MatchService_GetWaitlistPosition_Handler,
+		},
+		{
+			MethodName: "RequestRoundTrip",
+			Handler:    _MatchService_RequestRoundTrip_Handler,
+		},
+		{
+			MethodName: "CounterFare",
+			Handler:    _MatchService_CounterFare_Handler,
+		},
+		{
+			MethodName: "AcceptFare",
+			Handler:    _MatchService_AcceptFare_Handler,
+		},
+		{
+			MethodName: "GetFareHistory",
+			Handler:    _MatchService_GetFareHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/v1/match.proto",
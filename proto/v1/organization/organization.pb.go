@@ -0,0 +1,1915 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: proto/v1/organization.proto
+
+package organization
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Organization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Domains       []string               `protobuf:"bytes,3,rep,name=domains,proto3" json:"domains,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	mi := &file_proto_v1_organization_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Organization) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Organization) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Organization) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *Organization) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SharingAgreement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId         string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	PartnerOrgId  string                 `protobuf:"bytes,3,opt,name=partner_org_id,json=partnerOrgId,proto3" json:"partner_org_id,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharingAgreement) Reset() {
+	*x = SharingAgreement{}
+	mi := &file_proto_v1_organization_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharingAgreement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharingAgreement) ProtoMessage() {}
+
+func (x *SharingAgreement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharingAgreement.ProtoReflect.Descriptor instead.
+func (*SharingAgreement) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SharingAgreement) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SharingAgreement) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *SharingAgreement) GetPartnerOrgId() string {
+	if x != nil {
+		return x.PartnerOrgId
+	}
+	return ""
+}
+
+func (x *SharingAgreement) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *SharingAgreement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Invite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	OrgId         string                 `protobuf:"bytes,4,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	MaxUses       int32                  `protobuf:"varint,6,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	Uses          int32                  `protobuf:"varint,7,opt,name=uses,proto3" json:"uses,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_proto_v1_organization_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Invite) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invite) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Invite) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invite) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *Invite) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Invite) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Invite) GetUses() int32 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+func (x *Invite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invite) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *Invite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// proposed fares have to lie between min_ratio and max_ratio times the
+// offer's listed fare (the fare model's estimate when it lists none), and
+// can be countered at most max_counters times per match
+type FarePolicy struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	OrgId       string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	MinRatio    float64                `protobuf:"fixed64,2,opt,name=min_ratio,json=minRatio,proto3" json:"min_ratio,omitempty"`
+	MaxRatio    float64                `protobuf:"fixed64,3,opt,name=max_ratio,json=maxRatio,proto3" json:"max_ratio,omitempty"`
+	MaxCounters int32                  `protobuf:"varint,4,opt,name=max_counters,json=maxCounters,proto3" json:"max_counters,omitempty"`
+	// empty while the org uses the configured defaults
+	UpdatedBy     string                 `protobuf:"bytes,5,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FarePolicy) Reset() {
+	*x = FarePolicy{}
+	mi := &file_proto_v1_organization_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FarePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FarePolicy) ProtoMessage() {}
+
+func (x *FarePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FarePolicy.ProtoReflect.Descriptor instead.
+func (*FarePolicy) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FarePolicy) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *FarePolicy) GetMinRatio() float64 {
+	if x != nil {
+		return x.MinRatio
+	}
+	return 0
+}
+
+func (x *FarePolicy) GetMaxRatio() float64 {
+	if x != nil {
+		return x.MaxRatio
+	}
+	return 0
+}
+
+func (x *FarePolicy) GetMaxCounters() int32 {
+	if x != nil {
+		return x.MaxCounters
+	}
+	return 0
+}
+
+func (x *FarePolicy) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *FarePolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetMyOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyOrganizationRequest) Reset() {
+	*x = GetMyOrganizationRequest{}
+	mi := &file_proto_v1_organization_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyOrganizationRequest) ProtoMessage() {}
+
+func (x *GetMyOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*GetMyOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{4}
+}
+
+type GetMyOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyOrganizationResponse) Reset() {
+	*x = GetMyOrganizationResponse{}
+	mi := &file_proto_v1_organization_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyOrganizationResponse) ProtoMessage() {}
+
+func (x *GetMyOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*GetMyOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetMyOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+type CreateOrganizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Domains       []string               `protobuf:"bytes,2,rep,name=domains,proto3" json:"domains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationRequest) Reset() {
+	*x = CreateOrganizationRequest{}
+	mi := &file_proto_v1_organization_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationRequest) ProtoMessage() {}
+
+func (x *CreateOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateOrganizationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateOrganizationRequest) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+type CreateOrganizationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organization  *Organization          `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOrganizationResponse) Reset() {
+	*x = CreateOrganizationResponse{}
+	mi := &file_proto_v1_organization_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOrganizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationResponse) ProtoMessage() {}
+
+func (x *CreateOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateOrganizationResponse) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+type ListOrganizationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsRequest) Reset() {
+	*x = ListOrganizationsRequest{}
+	mi := &file_proto_v1_organization_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsRequest) ProtoMessage() {}
+
+func (x *ListOrganizationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListOrganizationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListOrganizationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Organizations []*Organization        `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrganizationsResponse) Reset() {
+	*x = ListOrganizationsResponse{}
+	mi := &file_proto_v1_organization_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrganizationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsResponse) ProtoMessage() {}
+
+func (x *ListOrganizationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListOrganizationsResponse) GetOrganizations() []*Organization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+type AddDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDomainRequest) Reset() {
+	*x = AddDomainRequest{}
+	mi := &file_proto_v1_organization_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDomainRequest) ProtoMessage() {}
+
+func (x *AddDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDomainRequest.ProtoReflect.Descriptor instead.
+func (*AddDomainRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddDomainRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *AddDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type AddDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddDomainResponse) Reset() {
+	*x = AddDomainResponse{}
+	mi := &file_proto_v1_organization_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDomainResponse) ProtoMessage() {}
+
+func (x *AddDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDomainResponse.ProtoReflect.Descriptor instead.
+func (*AddDomainResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AddDomainResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RemoveDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDomainRequest) Reset() {
+	*x = RemoveDomainRequest{}
+	mi := &file_proto_v1_organization_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDomainRequest) ProtoMessage() {}
+
+func (x *RemoveDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDomainRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDomainRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RemoveDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type RemoveDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDomainResponse) Reset() {
+	*x = RemoveDomainResponse{}
+	mi := &file_proto_v1_organization_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDomainResponse) ProtoMessage() {}
+
+func (x *RemoveDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDomainResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDomainResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RemoveDomainResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AssignUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrgId         string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignUserRequest) Reset() {
+	*x = AssignUserRequest{}
+	mi := &file_proto_v1_organization_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignUserRequest) ProtoMessage() {}
+
+func (x *AssignUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignUserRequest.ProtoReflect.Descriptor instead.
+func (*AssignUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AssignUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AssignUserRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type AssignUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignUserResponse) Reset() {
+	*x = AssignUserResponse{}
+	mi := &file_proto_v1_organization_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignUserResponse) ProtoMessage() {}
+
+func (x *AssignUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignUserResponse.ProtoReflect.Descriptor instead.
+func (*AssignUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AssignUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreateSharingAgreementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	PartnerOrgId  string                 `protobuf:"bytes,2,opt,name=partner_org_id,json=partnerOrgId,proto3" json:"partner_org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSharingAgreementRequest) Reset() {
+	*x = CreateSharingAgreementRequest{}
+	mi := &file_proto_v1_organization_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSharingAgreementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSharingAgreementRequest) ProtoMessage() {}
+
+func (x *CreateSharingAgreementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSharingAgreementRequest.ProtoReflect.Descriptor instead.
+func (*CreateSharingAgreementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateSharingAgreementRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreateSharingAgreementRequest) GetPartnerOrgId() string {
+	if x != nil {
+		return x.PartnerOrgId
+	}
+	return ""
+}
+
+type CreateSharingAgreementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agreement     *SharingAgreement      `protobuf:"bytes,1,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSharingAgreementResponse) Reset() {
+	*x = CreateSharingAgreementResponse{}
+	mi := &file_proto_v1_organization_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSharingAgreementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSharingAgreementResponse) ProtoMessage() {}
+
+func (x *CreateSharingAgreementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSharingAgreementResponse.ProtoReflect.Descriptor instead.
+func (*CreateSharingAgreementResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateSharingAgreementResponse) GetAgreement() *SharingAgreement {
+	if x != nil {
+		return x.Agreement
+	}
+	return nil
+}
+
+type DeleteSharingAgreementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	PartnerOrgId  string                 `protobuf:"bytes,2,opt,name=partner_org_id,json=partnerOrgId,proto3" json:"partner_org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSharingAgreementRequest) Reset() {
+	*x = DeleteSharingAgreementRequest{}
+	mi := &file_proto_v1_organization_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSharingAgreementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSharingAgreementRequest) ProtoMessage() {}
+
+func (x *DeleteSharingAgreementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSharingAgreementRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSharingAgreementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteSharingAgreementRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *DeleteSharingAgreementRequest) GetPartnerOrgId() string {
+	if x != nil {
+		return x.PartnerOrgId
+	}
+	return ""
+}
+
+type DeleteSharingAgreementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSharingAgreementResponse) Reset() {
+	*x = DeleteSharingAgreementResponse{}
+	mi := &file_proto_v1_organization_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSharingAgreementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSharingAgreementResponse) ProtoMessage() {}
+
+func (x *DeleteSharingAgreementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSharingAgreementResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSharingAgreementResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteSharingAgreementResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListSharingAgreementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharingAgreementsRequest) Reset() {
+	*x = ListSharingAgreementsRequest{}
+	mi := &file_proto_v1_organization_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharingAgreementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharingAgreementsRequest) ProtoMessage() {}
+
+func (x *ListSharingAgreementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharingAgreementsRequest.ProtoReflect.Descriptor instead.
+func (*ListSharingAgreementsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{20}
+}
+
+type ListSharingAgreementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Agreements    []*SharingAgreement    `protobuf:"bytes,1,rep,name=agreements,proto3" json:"agreements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSharingAgreementsResponse) Reset() {
+	*x = ListSharingAgreementsResponse{}
+	mi := &file_proto_v1_organization_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSharingAgreementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharingAgreementsResponse) ProtoMessage() {}
+
+func (x *ListSharingAgreementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharingAgreementsResponse.ProtoReflect.Descriptor instead.
+func (*ListSharingAgreementsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListSharingAgreementsResponse) GetAgreements() []*SharingAgreement {
+	if x != nil {
+		return x.Agreements
+	}
+	return nil
+}
+
+type CreateInviteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// optional, when set the invite is applied automatically at that user's login
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	// ignored for email invites, which are single use; 0 means unlimited
+	MaxUses       int32                  `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteRequest) Reset() {
+	*x = CreateInviteRequest{}
+	mi := &file_proto_v1_organization_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteRequest) ProtoMessage() {}
+
+func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateInviteRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateInviteRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteResponse) Reset() {
+	*x = CreateInviteResponse{}
+	mi := &file_proto_v1_organization_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteResponse) ProtoMessage() {}
+
+func (x *CreateInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateInviteResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type RevokeInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteId      string                 `protobuf:"bytes,1,opt,name=invite_id,json=inviteId,proto3" json:"invite_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteRequest) Reset() {
+	*x = RevokeInviteRequest{}
+	mi := &file_proto_v1_organization_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteRequest) ProtoMessage() {}
+
+func (x *RevokeInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RevokeInviteRequest) GetInviteId() string {
+	if x != nil {
+		return x.InviteId
+	}
+	return ""
+}
+
+type RevokeInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeInviteResponse) Reset() {
+	*x = RevokeInviteResponse{}
+	mi := &file_proto_v1_organization_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInviteResponse) ProtoMessage() {}
+
+func (x *RevokeInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInviteResponse.ProtoReflect.Descriptor instead.
+func (*RevokeInviteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RevokeInviteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesRequest) Reset() {
+	*x = ListInvitesRequest{}
+	mi := &file_proto_v1_organization_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesRequest) ProtoMessage() {}
+
+func (x *ListInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListInvitesRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *ListInvitesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitesResponse) Reset() {
+	*x = ListInvitesResponse{}
+	mi := &file_proto_v1_organization_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitesResponse) ProtoMessage() {}
+
+func (x *ListInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListInvitesResponse) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type GetFarePolicyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// defaults to the caller's org
+	OrgId         string `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFarePolicyRequest) Reset() {
+	*x = GetFarePolicyRequest{}
+	mi := &file_proto_v1_organization_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFarePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFarePolicyRequest) ProtoMessage() {}
+
+func (x *GetFarePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFarePolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetFarePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetFarePolicyRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+type GetFarePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *FarePolicy            `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFarePolicyResponse) Reset() {
+	*x = GetFarePolicyResponse{}
+	mi := &file_proto_v1_organization_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFarePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFarePolicyResponse) ProtoMessage() {}
+
+func (x *GetFarePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFarePolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetFarePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetFarePolicyResponse) GetPolicy() *FarePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetFarePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *FarePolicy            `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFarePolicyRequest) Reset() {
+	*x = SetFarePolicyRequest{}
+	mi := &file_proto_v1_organization_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFarePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFarePolicyRequest) ProtoMessage() {}
+
+func (x *SetFarePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFarePolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetFarePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetFarePolicyRequest) GetPolicy() *FarePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type SetFarePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *FarePolicy            `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFarePolicyResponse) Reset() {
+	*x = SetFarePolicyResponse{}
+	mi := &file_proto_v1_organization_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFarePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFarePolicyResponse) ProtoMessage() {}
+
+func (x *SetFarePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_organization_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFarePolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetFarePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_organization_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetFarePolicyResponse) GetPolicy() *FarePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+var File_proto_v1_organization_proto protoreflect.FileDescriptor
+
+const file_proto_v1_organization_proto_rawDesc = "" +
+	"\n" +
+	"\x1bproto/v1/organization.proto\x12\bproto.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x87\x01\n" +
+	"\fOrganization\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\adomains\x18\x03 \x03(\tR\adomains\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb9\x01\n" +
+	"\x10SharingAgreement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\x12$\n" +
+	"\x0epartner_org_id\x18\x03 \x01(\tR\fpartnerOrgId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x04 \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd8\x02\n" +
+	"\x06Invite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x15\n" +
+	"\x06org_id\x18\x04 \x01(\tR\x05orgId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x05 \x01(\tR\tcreatedBy\x12\x19\n" +
+	"\bmax_uses\x18\x06 \x01(\x05R\amaxUses\x12\x12\n" +
+	"\x04uses\x18\a \x01(\x05R\x04uses\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xda\x01\n" +
+	"\n" +
+	"FarePolicy\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1b\n" +
+	"\tmin_ratio\x18\x02 \x01(\x01R\bminRatio\x12\x1b\n" +
+	"\tmax_ratio\x18\x03 \x01(\x01R\bmaxRatio\x12!\n" +
+	"\fmax_counters\x18\x04 \x01(\x05R\vmaxCounters\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x05 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x1a\n" +
+	"\x18GetMyOrganizationRequest\"W\n" +
+	"\x19GetMyOrganizationResponse\x12:\n" +
+	"\forganization\x18\x01 \x01(\v2\x16.proto.v1.OrganizationR\forganization\"I\n" +
+	"\x19CreateOrganizationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\adomains\x18\x02 \x03(\tR\adomains\"X\n" +
+	"\x1aCreateOrganizationResponse\x12:\n" +
+	"\forganization\x18\x01 \x01(\v2\x16.proto.v1.OrganizationR\forganization\"0\n" +
+	"\x18ListOrganizationsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"Y\n" +
+	"\x19ListOrganizationsResponse\x12<\n" +
+	"\rorganizations\x18\x01 \x03(\v2\x16.proto.v1.OrganizationR\rorganizations\"A\n" +
+	"\x10AddDomainRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\"-\n" +
+	"\x11AddDomainResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"-\n" +
+	"\x13RemoveDomainRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\"0\n" +
+	"\x14RemoveDomainResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"C\n" +
+	"\x11AssignUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\".\n" +
+	"\x12AssignUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\\\n" +
+	"\x1dCreateSharingAgreementRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12$\n" +
+	"\x0epartner_org_id\x18\x02 \x01(\tR\fpartnerOrgId\"Z\n" +
+	"\x1eCreateSharingAgreementResponse\x128\n" +
+	"\tagreement\x18\x01 \x01(\v2\x1a.proto.v1.SharingAgreementR\tagreement\"\\\n" +
+	"\x1dDeleteSharingAgreementRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12$\n" +
+	"\x0epartner_org_id\x18\x02 \x01(\tR\fpartnerOrgId\":\n" +
+	"\x1eDeleteSharingAgreementResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1e\n" +
+	"\x1cListSharingAgreementsRequest\"[\n" +
+	"\x1dListSharingAgreementsResponse\x12:\n" +
+	"\n" +
+	"agreements\x18\x01 \x03(\v2\x1a.proto.v1.SharingAgreementR\n" +
+	"agreements\"\x98\x01\n" +
+	"\x13CreateInviteRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x19\n" +
+	"\bmax_uses\x18\x03 \x01(\x05R\amaxUses\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"@\n" +
+	"\x14CreateInviteResponse\x12(\n" +
+	"\x06invite\x18\x01 \x01(\v2\x10.proto.v1.InviteR\x06invite\"2\n" +
+	"\x13RevokeInviteRequest\x12\x1b\n" +
+	"\tinvite_id\x18\x01 \x01(\tR\binviteId\"0\n" +
+	"\x14RevokeInviteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"A\n" +
+	"\x12ListInvitesRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"A\n" +
+	"\x13ListInvitesResponse\x12*\n" +
+	"\ainvites\x18\x01 \x03(\v2\x10.proto.v1.InviteR\ainvites\"-\n" +
+	"\x14GetFarePolicyRequest\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\"E\n" +
+	"\x15GetFarePolicyResponse\x12,\n" +
+	"\x06policy\x18\x01 \x01(\v2\x14.proto.v1.FarePolicyR\x06policy\"D\n" +
+	"\x14SetFarePolicyRequest\x12,\n" +
+	"\x06policy\x18\x01 \x01(\v2\x14.proto.v1.FarePolicyR\x06policy\"E\n" +
+	"\x15SetFarePolicyResponse\x12,\n" +
+	"\x06policy\x18\x01 \x01(\v2\x14.proto.v1.FarePolicyR\x06policy2\xfe\t\n" +
+	"\x13OrganizationService\x12^\n" +
+	"\x11GetMyOrganization\x12\".proto.v1.GetMyOrganizationRequest\x1a#.proto.v1.GetMyOrganizationResponse\"\x00\x12a\n" +
+	"\x12CreateOrganization\x12#.proto.v1.CreateOrganizationRequest\x1a$.proto.v1.CreateOrganizationResponse\"\x00\x12^\n" +
+	"\x11ListOrganizations\x12\".proto.v1.ListOrganizationsRequest\x1a#.proto.v1.ListOrganizationsResponse\"\x00\x12F\n" +
+	"\tAddDomain\x12\x1a.proto.v1.AddDomainRequest\x1a\x1b.proto.v1.AddDomainResponse\"\x00\x12O\n" +
+	"\fRemoveDomain\x12\x1d.proto.v1.RemoveDomainRequest\x1a\x1e.proto.v1.RemoveDomainResponse\"\x00\x12I\n" +
+	"\n" +
+	"AssignUser\x12\x1b.proto.v1.AssignUserRequest\x1a\x1c.proto.v1.AssignUserResponse\"\x00\x12m\n" +
+	"\x16CreateSharingAgreement\x12'.proto.v1.CreateSharingAgreementRequest\x1a(.proto.v1.CreateSharingAgreementResponse\"\x00\x12m\n" +
+	"\x16DeleteSharingAgreement\x12'.proto.v1.DeleteSharingAgreementRequest\x1a(.proto.v1.DeleteSharingAgreementResponse\"\x00\x12j\n" +
+	"\x15ListSharingAgreements\x12&.proto.v1.ListSharingAgreementsRequest\x1a'.proto.v1.ListSharingAgreementsResponse\"\x00\x12O\n" +
+	"\fCreateInvite\x12\x1d.proto.v1.CreateInviteRequest\x1a\x1e.proto.v1.CreateInviteResponse\"\x00\x12O\n" +
+	"\fRevokeInvite\x12\x1d.proto.v1.RevokeInviteRequest\x1a\x1e.proto.v1.RevokeInviteResponse\"\x00\x12L\n" +
+	"\vListInvites\x12\x1c.proto.v1.ListInvitesRequest\x1a\x1d.proto.v1.ListInvitesResponse\"\x00\x12R\n" +
+	"\rGetFarePolicy\x12\x1e.proto.v1.GetFarePolicyRequest\x1a\x1f.proto.v1.GetFarePolicyResponse\"\x00\x12R\n" +
+	"\rSetFarePolicy\x12\x1e.proto.v1.SetFarePolicyRequest\x1a\x1f.proto.v1.SetFarePolicyResponse\"\x00B\x19Z\x17./proto/v1/organizationb\x06proto3"
+
+var (
+	file_proto_v1_organization_proto_rawDescOnce sync.Once
+	file_proto_v1_organization_proto_rawDescData []byte
+)
+
+func file_proto_v1_organization_proto_rawDescGZIP() []byte {
+	file_proto_v1_organization_proto_rawDescOnce.Do(func() {
+		file_proto_v1_organization_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v1_organization_proto_rawDesc), len(file_proto_v1_organization_proto_rawDesc)))
+	})
+	return file_proto_v1_organization_proto_rawDescData
+}
+
+var file_proto_v1_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_proto_v1_organization_proto_goTypes = []any{
+	(*Organization)(nil),                   // 0: proto.v1.Organization
+	(*SharingAgreement)(nil),               // 1: proto.v1.SharingAgreement
+	(*Invite)(nil),                         // 2: proto.v1.Invite
+	(*FarePolicy)(nil),                     // 3: proto.v1.FarePolicy
+	(*GetMyOrganizationRequest)(nil),       // 4: proto.v1.GetMyOrganizationRequest
+	(*GetMyOrganizationResponse)(nil),      // 5: proto.v1.GetMyOrganizationResponse
+	(*CreateOrganizationRequest)(nil),      // 6: proto.v1.CreateOrganizationRequest
+	(*CreateOrganizationResponse)(nil),     // 7: proto.v1.CreateOrganizationResponse
+	(*ListOrganizationsRequest)(nil),       // 8: proto.v1.ListOrganizationsRequest
+	(*ListOrganizationsResponse)(nil),      // 9: proto.v1.ListOrganizationsResponse
+	(*AddDomainRequest)(nil),               // 10: proto.v1.AddDomainRequest
+	(*AddDomainResponse)(nil),              // 11: proto.v1.AddDomainResponse
+	(*RemoveDomainRequest)(nil),            // 12: proto.v1.RemoveDomainRequest
+	(*RemoveDomainResponse)(nil),           // 13: proto.v1.RemoveDomainResponse
+	(*AssignUserRequest)(nil),              // 14: proto.v1.AssignUserRequest
+	(*AssignUserResponse)(nil),             // 15: proto.v1.AssignUserResponse
+	(*CreateSharingAgreementRequest)(nil),  // 16: proto.v1.CreateSharingAgreementRequest
+	(*CreateSharingAgreementResponse)(nil), // 17: proto.v1.CreateSharingAgreementResponse
+	(*DeleteSharingAgreementRequest)(nil),  // 18: proto.v1.DeleteSharingAgreementRequest
+	(*DeleteSharingAgreementResponse)(nil), // 19: proto.v1.DeleteSharingAgreementResponse
+	(*ListSharingAgreementsRequest)(nil),   // 20: proto.v1.ListSharingAgreementsRequest
+	(*ListSharingAgreementsResponse)(nil),  // 21: proto.v1.ListSharingAgreementsResponse
+	(*CreateInviteRequest)(nil),            // 22: proto.v1.CreateInviteRequest
+	(*CreateInviteResponse)(nil),           // 23: proto.v1.CreateInviteResponse
+	(*RevokeInviteRequest)(nil),            // 24: proto.v1.RevokeInviteRequest
+	(*RevokeInviteResponse)(nil),           // 25: proto.v1.RevokeInviteResponse
+	(*ListInvitesRequest)(nil),             // 26: proto.v1.ListInvitesRequest
+	(*ListInvitesResponse)(nil),            // 27: proto.v1.ListInvitesResponse
+	(*GetFarePolicyRequest)(nil),           // 28: proto.v1.GetFarePolicyRequest
+	(*GetFarePolicyResponse)(nil),          // 29: proto.v1.GetFarePolicyResponse
+	(*SetFarePolicyRequest)(nil),           // 30: proto.v1.SetFarePolicyRequest
+	(*SetFarePolicyResponse)(nil),          // 31: proto.v1.SetFarePolicyResponse
+	(*timestamppb.Timestamp)(nil),          // 32: google.protobuf.Timestamp
+}
+var file_proto_v1_organization_proto_depIdxs = []int32{
+	32, // 0: proto.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
+	32, // 1: proto.v1.SharingAgreement.created_at:type_name -> google.protobuf.Timestamp
+	32, // 2: proto.v1.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	32, // 3: proto.v1.Invite.revoked_at:type_name -> google.protobuf.Timestamp
+	32, // 4: proto.v1.Invite.created_at:type_name -> google.protobuf.Timestamp
+	32, // 5: proto.v1.FarePolicy.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: proto.v1.GetMyOrganizationResponse.organization:type_name -> proto.v1.Organization
+	0,  // 7: proto.v1.CreateOrganizationResponse.organization:type_name -> proto.v1.Organization
+	0,  // 8: proto.v1.ListOrganizationsResponse.organizations:type_name -> proto.v1.Organization
+	1,  // 9: proto.v1.CreateSharingAgreementResponse.agreement:type_name -> proto.v1.SharingAgreement
+	1,  // 10: proto.v1.ListSharingAgreementsResponse.agreements:type_name -> proto.v1.SharingAgreement
+	32, // 11: proto.v1.CreateInviteRequest.expires_at:type_name -> google.protobuf.Timestamp
+	2,  // 12: proto.v1.CreateInviteResponse.invite:type_name -> proto.v1.Invite
+	2,  // 13: proto.v1.ListInvitesResponse.invites:type_name -> proto.v1.Invite
+	3,  // 14: proto.v1.GetFarePolicyResponse.policy:type_name -> proto.v1.FarePolicy
+	3,  // 15: proto.v1.SetFarePolicyRequest.policy:type_name -> proto.v1.FarePolicy
+	3,  // 16: proto.v1.SetFarePolicyResponse.policy:type_name -> proto.v1.FarePolicy
+	4,  // 17: proto.v1.OrganizationService.GetMyOrganization:input_type -> proto.v1.GetMyOrganizationRequest
+	6,  // 18: proto.v1.OrganizationService.CreateOrganization:input_type -> proto.v1.CreateOrganizationRequest
+	8,  // 19: proto.v1.OrganizationService.ListOrganizations:input_type -> proto.v1.ListOrganizationsRequest
+	10, // 20: proto.v1.OrganizationService.AddDomain:input_type -> proto.v1.AddDomainRequest
+	12, // 21: proto.v1.OrganizationService.RemoveDomain:input_type -> proto.v1.RemoveDomainRequest
+	14, // 22: proto.v1.OrganizationService.AssignUser:input_type -> proto.v1.AssignUserRequest
+	16, // 23: proto.v1.OrganizationService.CreateSharingAgreement:input_type -> proto.v1.CreateSharingAgreementRequest
+	18, // 24: proto.v1.OrganizationService.DeleteSharingAgreement:input_type -> proto.v1.DeleteSharingAgreementRequest
+	20, // 25: proto.v1.OrganizationService.ListSharingAgreements:input_type -> proto.v1.ListSharingAgreementsRequest
+	22, // 26: proto.v1.OrganizationService.CreateInvite:input_type -> proto.v1.CreateInviteRequest
+	24, // 27: proto.v1.OrganizationService.RevokeInvite:input_type -> proto.v1.RevokeInviteRequest
+	26, // 28: proto.v1.OrganizationService.ListInvites:input_type -> proto.v1.ListInvitesRequest
+	28, // 29: proto.v1.OrganizationService.GetFarePolicy:input_type -> proto.v1.GetFarePolicyRequest
+	30, // 30: proto.v1.OrganizationService.SetFarePolicy:input_type -> proto.v1.SetFarePolicyRequest
+	5,  // 31: proto.v1.OrganizationService.GetMyOrganization:output_type -> proto.v1.GetMyOrganizationResponse
+	7,  // 32: proto.v1.OrganizationService.CreateOrganization:output_type -> proto.v1.CreateOrganizationResponse
+	9,  // 33: proto.v1.OrganizationService.ListOrganizations:output_type -> proto.v1.ListOrganizationsResponse
+	11, // 34: proto.v1.OrganizationService.AddDomain:output_type -> proto.v1.AddDomainResponse
+	13, // 35: proto.v1.OrganizationService.RemoveDomain:output_type -> proto.v1.RemoveDomainResponse
+	15, // 36: proto.v1.OrganizationService.AssignUser:output_type -> proto.v1.AssignUserResponse
+	17, // 37: proto.v1.OrganizationService.CreateSharingAgreement:output_type -> proto.v1.CreateSharingAgreementResponse
+	19, // 38: proto.v1.OrganizationService.DeleteSharingAgreement:output_type -> proto.v1.DeleteSharingAgreementResponse
+	21, // 39: proto.v1.OrganizationService.ListSharingAgreements:output_type -> proto.v1.ListSharingAgreementsResponse
+	23, // 40: proto.v1.OrganizationService.CreateInvite:output_type -> proto.v1.CreateInviteResponse
+	25, // 41: proto.v1.OrganizationService.RevokeInvite:output_type -> proto.v1.RevokeInviteResponse
+	27, // 42: proto.v1.OrganizationService.ListInvites:output_type -> proto.v1.ListInvitesResponse
+	29, // 43: proto.v1.OrganizationService.GetFarePolicy:output_type -> proto.v1.GetFarePolicyResponse
+	31, // 44: proto.v1.OrganizationService.SetFarePolicy:output_type -> proto.v1.SetFarePolicyResponse
+	31, // [31:45] is the sub-list for method output_type
+	17, // [17:31] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_organization_proto_init() }
+func file_proto_v1_organization_proto_init() {
+	if File_proto_v1_organization_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_organization_proto_rawDesc), len(file_proto_v1_organization_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   32,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_organization_proto_goTypes,
+		DependencyIndexes: file_proto_v1_organization_proto_depIdxs,
+		MessageInfos:      file_proto_v1_organization_proto_msgTypes,
+	}.Build()
+	File_proto_v1_organization_proto = out.File
+	file_proto_v1_organization_proto_goTypes = nil
+	file_proto_v1_organization_proto_depIdxs = nil
+}
@@ -23,15 +23,30 @@ const (
 )
 
 type RideOffer struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DriverId      string                 `protobuf:"bytes,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
-	FromGeo       string                 `protobuf:"bytes,3,opt,name=from_geo,json=fromGeo,proto3" json:"from_geo,omitempty"`
-	ToGeo         string                 `protobuf:"bytes,4,opt,name=to_geo,json=toGeo,proto3" json:"to_geo,omitempty"`
-	Fare          float64                `protobuf:"fixed64,5,opt,name=fare,proto3" json:"fare,omitempty"`
-	Time          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
-	Seats         int32                  `protobuf:"varint,7,opt,name=seats,proto3" json:"seats,omitempty"`
-	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DriverId    string                 `protobuf:"bytes,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	FromGeo     string                 `protobuf:"bytes,3,opt,name=from_geo,json=fromGeo,proto3" json:"from_geo,omitempty"`
+	ToGeo       string                 `protobuf:"bytes,4,opt,name=to_geo,json=toGeo,proto3" json:"to_geo,omitempty"`
+	Fare        float64                `protobuf:"fixed64,5,opt,name=fare,proto3" json:"fare,omitempty"`
+	Time        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	Seats       int32                  `protobuf:"varint,7,opt,name=seats,proto3" json:"seats,omitempty"`
+	Status      string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	OrgId       string                 `protobuf:"bytes,9,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	FromPointId string                 `protobuf:"bytes,10,opt,name=from_point_id,json=fromPointId,proto3" json:"from_point_id,omitempty"`
+	ToPointId   string                 `protobuf:"bytes,11,opt,name=to_point_id,json=toPointId,proto3" json:"to_point_id,omitempty"`
+	// riders adding more than this to the route are turned away, 0 is no limit
+	MaxDetourMinutes int32 `protobuf:"varint,12,opt,name=max_detour_minutes,json=maxDetourMinutes,proto3" json:"max_detour_minutes,omitempty"`
+	// set once the driver cancels the ride
+	CancelledBy     string                 `protobuf:"bytes,13,opt,name=cancelled_by,json=cancelledBy,proto3" json:"cancelled_by,omitempty"`
+	CancelledAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	CancelReason    string                 `protobuf:"bytes,15,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	AutoAccept      *AutoAccept            `protobuf:"bytes,16,opt,name=auto_accept,json=autoAccept,proto3" json:"auto_accept,omitempty"`
+	DepartureWindow *TimeWindow            `protobuf:"bytes,17,opt,name=departure_window,json=departureWindow,proto3" json:"departure_window,omitempty"`
+	// the outbound and return legs of a round trip share a pair_id; leg is
+	// "outbound" or "return"
+	PairId        string `protobuf:"bytes,18,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Leg           string `protobuf:"bytes,19,opt,name=leg,proto3" json:"leg,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -122,124 +137,107 @@ func (x *RideOffer) GetStatus() string {
 	return ""
 }
 
-type RideRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FromGeo       string                 `protobuf:"bytes,3,opt,name=from_geo,json=fromGeo,proto3" json:"from_geo,omitempty"`
-	ToGeo         string                 `protobuf:"bytes,4,opt,name=to_geo,json=toGeo,proto3" json:"to_geo,omitempty"`
-	Time          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
-	Seats         int32                  `protobuf:"varint,6,opt,name=seats,proto3" json:"seats,omitempty"`
-	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RideRequest) Reset() {
-	*x = RideRequest{}
-	mi := &file_proto_v1_ride_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RideOffer) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
 }
 
-func (x *RideRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RideOffer) GetFromPointId() string {
+	if x != nil {
+		return x.FromPointId
+	}
+	return ""
 }
 
-func (*RideRequest) ProtoMessage() {}
-
-func (x *RideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[1]
+func (x *RideOffer) GetToPointId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ToPointId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RideRequest.ProtoReflect.Descriptor instead.
-func (*RideRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{1}
+func (x *RideOffer) GetMaxDetourMinutes() int32 {
+	if x != nil {
+		return x.MaxDetourMinutes
+	}
+	return 0
 }
 
-func (x *RideRequest) GetId() string {
+func (x *RideOffer) GetCancelledBy() string {
 	if x != nil {
-		return x.Id
+		return x.CancelledBy
 	}
 	return ""
 }
 
-func (x *RideRequest) GetUserId() string {
+func (x *RideOffer) GetCancelledAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UserId
+		return x.CancelledAt
 	}
-	return ""
+	return nil
 }
 
-func (x *RideRequest) GetFromGeo() string {
+func (x *RideOffer) GetCancelReason() string {
 	if x != nil {
-		return x.FromGeo
+		return x.CancelReason
 	}
 	return ""
 }
 
-func (x *RideRequest) GetToGeo() string {
+func (x *RideOffer) GetAutoAccept() *AutoAccept {
 	if x != nil {
-		return x.ToGeo
+		return x.AutoAccept
 	}
-	return ""
+	return nil
 }
 
-func (x *RideRequest) GetTime() *timestamppb.Timestamp {
+func (x *RideOffer) GetDepartureWindow() *TimeWindow {
 	if x != nil {
-		return x.Time
+		return x.DepartureWindow
 	}
 	return nil
 }
 
-func (x *RideRequest) GetSeats() int32 {
+func (x *RideOffer) GetPairId() string {
 	if x != nil {
-		return x.Seats
+		return x.PairId
 	}
-	return 0
+	return ""
 }
 
-func (x *RideRequest) GetStatus() string {
+func (x *RideOffer) GetLeg() string {
 	if x != nil {
-		return x.Status
+		return x.Leg
 	}
 	return ""
 }
 
-type CreateOfferRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromGeo       string                 `protobuf:"bytes,1,opt,name=from_geo,json=fromGeo,proto3" json:"from_geo,omitempty"`
-	ToGeo         string                 `protobuf:"bytes,2,opt,name=to_geo,json=toGeo,proto3" json:"to_geo,omitempty"`
-	Fare          float64                `protobuf:"fixed64,3,opt,name=fare,proto3" json:"fare,omitempty"`
-	Time          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
-	Seats         int32                  `protobuf:"varint,5,opt,name=seats,proto3" json:"seats,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// the way back of a round trip, from the trip's to_geo to its from_geo
+type ReturnTrip struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Time            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	DepartureWindow *TimeWindow            `protobuf:"bytes,2,opt,name=departure_window,json=departureWindow,proto3" json:"departure_window,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateOfferRequest) Reset() {
-	*x = CreateOfferRequest{}
-	mi := &file_proto_v1_ride_proto_msgTypes[2]
+func (x *ReturnTrip) Reset() {
+	*x = ReturnTrip{}
+	mi := &file_proto_v1_ride_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOfferRequest) String() string {
+func (x *ReturnTrip) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOfferRequest) ProtoMessage() {}
+func (*ReturnTrip) ProtoMessage() {}
 
-func (x *CreateOfferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[2]
+func (x *ReturnTrip) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,67 +248,116 @@ func (x *CreateOfferRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOfferRequest.ProtoReflect.Descriptor instead.
-func (*CreateOfferRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ReturnTrip.ProtoReflect.Descriptor instead.
+func (*ReturnTrip) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateOfferRequest) GetFromGeo() string {
+func (x *ReturnTrip) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FromGeo
+		return x.Time
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateOfferRequest) GetToGeo() string {
+func (x *ReturnTrip) GetDepartureWindow() *TimeWindow {
 	if x != nil {
-		return x.ToGeo
+		return x.DepartureWindow
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateOfferRequest) GetFare() float64 {
+// how far departure may move off a trip's planned time, and when it has to
+// arrive; unset ends are open. In searches, the times a trip has to be
+// able to leave between and arrive by
+type TimeWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Earliest      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=earliest,proto3" json:"earliest,omitempty"`
+	Latest        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=latest,proto3" json:"latest,omitempty"`
+	ArriveBy      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=arrive_by,json=arriveBy,proto3" json:"arrive_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
+	mi := &file_proto_v1_ride_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeWindow) ProtoMessage() {}
+
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[2]
 	if x != nil {
-		return x.Fare
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateOfferRequest) GetTime() *timestamppb.Timestamp {
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TimeWindow) GetEarliest() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Time
+		return x.Earliest
 	}
 	return nil
 }
 
-func (x *CreateOfferRequest) GetSeats() int32 {
+func (x *TimeWindow) GetLatest() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Seats
+		return x.Latest
 	}
-	return 0
+	return nil
 }
 
-type CreateOfferResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offer         *RideOffer             `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TimeWindow) GetArriveBy() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArriveBy
+	}
+	return nil
 }
 
-func (x *CreateOfferResponse) Reset() {
-	*x = CreateOfferResponse{}
+// which join requests an offer accepts without asking the driver
+type AutoAccept struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty for none, "everyone", "favorites" (the driver's) or "trusted":
+	// riders of the driver's org with at least min_rating and min_reliability
+	Rule string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// average review score, 1 to 5; 0 ignores ratings
+	MinRating float64 `protobuf:"fixed64,2,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`
+	// 0 to 100; 0 ignores reliability
+	MinReliability float64 `protobuf:"fixed64,3,opt,name=min_reliability,json=minReliability,proto3" json:"min_reliability,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AutoAccept) Reset() {
+	*x = AutoAccept{}
 	mi := &file_proto_v1_ride_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOfferResponse) String() string {
+func (x *AutoAccept) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOfferResponse) ProtoMessage() {}
+func (*AutoAccept) ProtoMessage() {}
 
-func (x *CreateOfferResponse) ProtoReflect() protoreflect.Message {
+func (x *AutoAccept) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1_ride_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -322,39 +369,60 @@ func (x *CreateOfferResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOfferResponse.ProtoReflect.Descriptor instead.
-func (*CreateOfferResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AutoAccept.ProtoReflect.Descriptor instead.
+func (*AutoAccept) Descriptor() ([]byte, []int) {
 	return file_proto_v1_ride_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateOfferResponse) GetOffer() *RideOffer {
+func (x *AutoAccept) GetRule() string {
 	if x != nil {
-		return x.Offer
+		return x.Rule
 	}
-	return nil
+	return ""
 }
 
-type GetOfferRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *AutoAccept) GetMinRating() float64 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+func (x *AutoAccept) GetMinReliability() float64 {
+	if x != nil {
+		return x.MinReliability
+	}
+	return 0
+}
+
+// a stop along an offer's route, seq 0 is the origin and the last one the
+// destination
+type Stop struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Seq         int32                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Geohash     string                 `protobuf:"bytes,2,opt,name=geohash,proto3" json:"geohash,omitempty"`
+	PointId     string                 `protobuf:"bytes,3,opt,name=point_id,json=pointId,proto3" json:"point_id,omitempty"`
+	PlannedTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=planned_time,json=plannedTime,proto3" json:"planned_time,omitempty"`
+	// free seats on the leg leaving this stop
+	SeatsFree     int32 `protobuf:"varint,5,opt,name=seats_free,json=seatsFree,proto3" json:"seats_free,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOfferRequest) Reset() {
-	*x = GetOfferRequest{}
+func (x *Stop) Reset() {
+	*x = Stop{}
 	mi := &file_proto_v1_ride_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOfferRequest) String() string {
+func (x *Stop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOfferRequest) ProtoMessage() {}
+func (*Stop) ProtoMessage() {}
 
-func (x *GetOfferRequest) ProtoReflect() protoreflect.Message {
+func (x *Stop) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1_ride_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -366,39 +434,83 @@ func (x *GetOfferRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOfferRequest.ProtoReflect.Descriptor instead.
-func (*GetOfferRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Stop.ProtoReflect.Descriptor instead.
+func (*Stop) Descriptor() ([]byte, []int) {
 	return file_proto_v1_ride_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetOfferRequest) GetId() string {
+func (x *Stop) GetSeq() int32 {
 	if x != nil {
-		return x.Id
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *Stop) GetGeohash() string {
+	if x != nil {
+		return x.Geohash
 	}
 	return ""
 }
 
-type GetOfferResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offer         *RideOffer             `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+func (x *Stop) GetPointId() string {
+	if x != nil {
+		return x.PointId
+	}
+	return ""
+}
+
+func (x *Stop) GetPlannedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlannedTime
+	}
+	return nil
+}
+
+func (x *Stop) GetSeatsFree() int32 {
+	if x != nil {
+		return x.SeatsFree
+	}
+	return 0
+}
+
+type RideRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FromGeo         string                 `protobuf:"bytes,3,opt,name=from_geo,json=fromGeo,proto3" json:"from_geo,omitempty"`
+	ToGeo           string                 `protobuf:"bytes,4,opt,name=to_geo,json=toGeo,proto3" json:"to_geo,omitempty"`
+	Time            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	Seats           int32                  `protobuf:"varint,6,opt,name=seats,proto3" json:"seats,omitempty"`
+	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	OrgId           string                 `protobuf:"bytes,8,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	FromPointId     string                 `protobuf:"bytes,9,opt,name=from_point_id,json=fromPointId,proto3" json:"from_point_id,omitempty"`
+	ToPointId       string                 `protobuf:"bytes,10,opt,name=to_point_id,json=toPointId,proto3" json:"to_point_id,omitempty"`
+	DepartureWindow *TimeWindow            `protobuf:"bytes,11,opt,name=departure_window,json=departureWindow,proto3" json:"departure_window,omitempty"`
+	PairId          string                 `protobuf:"bytes,12,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Leg             string                 `protobuf:"bytes,13,opt,name=leg,proto3" json:"leg,omitempty"`
+	// "together": a driver takes both legs or neither; "independent"
+	PairMode string `protobuf:"bytes,14,opt,name=pair_mode,json=pairMode,proto3" json:"pair_mode,omitempty"`
+	// the fare the rider proposes, 0 leaves it to the fare model's estimate
+	Fare          float64 `protobuf:"fixed64,15,opt,name=fare,proto3" json:"fare,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOfferResponse) Reset() {
-	*x = GetOfferResponse{}
+func (x *RideRequest) Reset() {
+	*x = RideRequest{}
 	mi := &file_proto_v1_ride_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOfferResponse) String() string {
+func (x *RideRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOfferResponse) ProtoMessage() {}
+func (*RideRequest) ProtoMessage() {}
 
-func (x *GetOfferResponse) ProtoReflect() protoreflect.Message {
+func (x *RideRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1_ride_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -410,108 +522,723 @@ func (x *GetOfferResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOfferResponse.ProtoReflect.Descriptor instead.
-func (*GetOfferResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RideRequest.ProtoReflect.Descriptor instead.
+func (*RideRequest) Descriptor() ([]byte, []int) {
 	return file_proto_v1_ride_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetOfferResponse) GetOffer() *RideOffer {
+func (x *RideRequest) GetId() string {
 	if x != nil {
-		return x.Offer
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RideRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RideRequest) GetFromGeo() string {
+	if x != nil {
+		return x.FromGeo
+	}
+	return ""
+}
+
+func (x *RideRequest) GetToGeo() string {
+	if x != nil {
+		return x.ToGeo
+	}
+	return ""
+}
+
+func (x *RideRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
 	}
 	return nil
 }
 
-type UpdateOfferRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Fare          float64                `protobuf:"fixed64,2,opt,name=fare,proto3" json:"fare,omitempty"`
-	Seats         int32                  `protobuf:"varint,3,opt,name=seats,proto3" json:"seats,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RideRequest) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
 }
 
-func (x *UpdateOfferRequest) Reset() {
-	*x = UpdateOfferRequest{}
-	mi := &file_proto_v1_ride_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RideRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *UpdateOfferRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RideRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *RideRequest) GetFromPointId() string {
+	if x != nil {
+		return x.FromPointId
+	}
+	return ""
+}
+
+func (x *RideRequest) GetToPointId() string {
+	if x != nil {
+		return x.ToPointId
+	}
+	return ""
+}
+
+func (x *RideRequest) GetDepartureWindow() *TimeWindow {
+	if x != nil {
+		return x.DepartureWindow
+	}
+	return nil
+}
+
+func (x *RideRequest) GetPairId() string {
+	if x != nil {
+		return x.PairId
+	}
+	return ""
+}
+
+func (x *RideRequest) GetLeg() string {
+	if x != nil {
+		return x.Leg
+	}
+	return ""
+}
+
+func (x *RideRequest) GetPairMode() string {
+	if x != nil {
+		return x.PairMode
+	}
+	return ""
+}
+
+func (x *RideRequest) GetFare() float64 {
+	if x != nil {
+		return x.Fare
+	}
+	return 0
+}
+
+type CreateOfferRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	FromGeo string                 `protobuf:"bytes,1,opt,name=from_geo,json=fromGeo,proto3" json:"from_geo,omitempty"`
+	ToGeo   string                 `protobuf:"bytes,2,opt,name=to_geo,json=toGeo,proto3" json:"to_geo,omitempty"`
+	Fare    float64                `protobuf:"fixed64,3,opt,name=fare,proto3" json:"fare,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	Seats   int32                  `protobuf:"varint,5,opt,name=seats,proto3" json:"seats,omitempty"`
+	// meeting points stand in for from_geo / to_geo
+	FromPointId      string `protobuf:"bytes,6,opt,name=from_point_id,json=fromPointId,proto3" json:"from_point_id,omitempty"`
+	ToPointId        string `protobuf:"bytes,7,opt,name=to_point_id,json=toPointId,proto3" json:"to_point_id,omitempty"`
+	MaxDetourMinutes int32  `protobuf:"varint,8,opt,name=max_detour_minutes,json=maxDetourMinutes,proto3" json:"max_detour_minutes,omitempty"`
+	// stops between from and to in driving order, each a geohash or point_id
+	// with an optional planned_time
+	Waypoints  []*Stop     `protobuf:"bytes,9,rep,name=waypoints,proto3" json:"waypoints,omitempty"`
+	AutoAccept *AutoAccept `protobuf:"bytes,10,opt,name=auto_accept,json=autoAccept,proto3" json:"auto_accept,omitempty"`
+	// earliest and latest must be around time; a window lets riders agree
+	// on a pickup time that suits them
+	DepartureWindow *TimeWindow `protobuf:"bytes,11,opt,name=departure_window,json=departureWindow,proto3" json:"departure_window,omitempty"`
+	// also create the way back, linked to this offer as a round trip. It
+	// has to leave after this one arrives
+	ReturnTrip    *ReturnTrip `protobuf:"bytes,12,opt,name=return_trip,json=returnTrip,proto3" json:"return_trip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOfferRequest) Reset() {
+	*x = CreateOfferRequest{}
+	mi := &file_proto_v1_ride_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOfferRequest) ProtoMessage() {}
+
+func (x *CreateOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOfferRequest.ProtoReflect.Descriptor instead.
+func (*CreateOfferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateOfferRequest) GetFromGeo() string {
+	if x != nil {
+		return x.FromGeo
+	}
+	return ""
+}
+
+func (x *CreateOfferRequest) GetToGeo() string {
+	if x != nil {
+		return x.ToGeo
+	}
+	return ""
+}
+
+func (x *CreateOfferRequest) GetFare() float64 {
+	if x != nil {
+		return x.Fare
+	}
+	return 0
+}
+
+func (x *CreateOfferRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *CreateOfferRequest) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *CreateOfferRequest) GetFromPointId() string {
+	if x != nil {
+		return x.FromPointId
+	}
+	return ""
+}
+
+func (x *CreateOfferRequest) GetToPointId() string {
+	if x != nil {
+		return x.ToPointId
+	}
+	return ""
+}
+
+func (x *CreateOfferRequest) GetMaxDetourMinutes() int32 {
+	if x != nil {
+		return x.MaxDetourMinutes
+	}
+	return 0
+}
+
+func (x *CreateOfferRequest) GetWaypoints() []*Stop {
+	if x != nil {
+		return x.Waypoints
+	}
+	return nil
+}
+
+func (x *CreateOfferRequest) GetAutoAccept() *AutoAccept {
+	if x != nil {
+		return x.AutoAccept
+	}
+	return nil
+}
+
+func (x *CreateOfferRequest) GetDepartureWindow() *TimeWindow {
+	if x != nil {
+		return x.DepartureWindow
+	}
+	return nil
+}
+
+func (x *CreateOfferRequest) GetReturnTrip() *ReturnTrip {
+	if x != nil {
+		return x.ReturnTrip
+	}
+	return nil
+}
+
+type CreateOfferResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Offer *RideOffer             `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	// set with return_trip
+	ReturnOffer   *RideOffer `protobuf:"bytes,2,opt,name=return_offer,json=returnOffer,proto3" json:"return_offer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOfferResponse) Reset() {
+	*x = CreateOfferResponse{}
+	mi := &file_proto_v1_ride_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOfferResponse) ProtoMessage() {}
+
+func (x *CreateOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOfferResponse.ProtoReflect.Descriptor instead.
+func (*CreateOfferResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateOfferResponse) GetOffer() *RideOffer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+func (x *CreateOfferResponse) GetReturnOffer() *RideOffer {
+	if x != nil {
+		return x.ReturnOffer
+	}
+	return nil
+}
+
+type GetOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOfferRequest) Reset() {
+	*x = GetOfferRequest{}
+	mi := &file_proto_v1_ride_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfferRequest) ProtoMessage() {}
+
+func (x *GetOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfferRequest.ProtoReflect.Descriptor instead.
+func (*GetOfferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetOfferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offer         *RideOffer             `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOfferResponse) Reset() {
+	*x = GetOfferResponse{}
+	mi := &file_proto_v1_ride_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfferResponse) ProtoMessage() {}
+
+func (x *GetOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfferResponse.ProtoReflect.Descriptor instead.
+func (*GetOfferResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetOfferResponse) GetOffer() *RideOffer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+type UpdateOfferRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fare  float64                `protobuf:"fixed64,2,opt,name=fare,proto3" json:"fare,omitempty"`
+	Seats int32                  `protobuf:"varint,3,opt,name=seats,proto3" json:"seats,omitempty"`
+	// only "completed", once the ride has left and no rider is still accepted
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOfferRequest) Reset() {
+	*x = UpdateOfferRequest{}
+	mi := &file_proto_v1_ride_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOfferRequest) ProtoMessage() {}
+
+func (x *UpdateOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOfferRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOfferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateOfferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateOfferRequest) GetFare() float64 {
+	if x != nil {
+		return x.Fare
+	}
+	return 0
+}
+
+func (x *UpdateOfferRequest) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *UpdateOfferRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UpdateOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offer         *RideOffer             `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOfferResponse) Reset() {
+	*x = UpdateOfferResponse{}
+	mi := &file_proto_v1_ride_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOfferResponse) ProtoMessage() {}
+
+func (x *UpdateOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOfferResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOfferResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateOfferResponse) GetOffer() *RideOffer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+type DeleteOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOfferRequest) Reset() {
+	*x = DeleteOfferRequest{}
+	mi := &file_proto_v1_ride_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOfferRequest) ProtoMessage() {}
+
+func (x *DeleteOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOfferRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOfferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteOfferRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteOfferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOfferResponse) Reset() {
+	*x = DeleteOfferResponse{}
+	mi := &file_proto_v1_ride_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOfferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOfferResponse) ProtoMessage() {}
+
+func (x *DeleteOfferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOfferResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOfferResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteOfferResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListNearbyOffersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GeohashPrefix string                 `protobuf:"bytes,1,opt,name=geohash_prefix,json=geohashPrefix,proto3" json:"geohash_prefix,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// search around a meeting point instead of a prefix
+	MeetingPointId string `protobuf:"bytes,3,opt,name=meeting_point_id,json=meetingPointId,proto3" json:"meeting_point_id,omitempty"`
+	// with radius_meters set, search this circle instead, closest first
+	Latitude     float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude    float64 `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusMeters float64 `protobuf:"fixed64,6,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"`
+	// leave out users whose reliability score (0-100) is lower, 0 keeps everyone
+	MinReliability float64 `protobuf:"fixed64,7,opt,name=min_reliability,json=minReliability,proto3" json:"min_reliability,omitempty"`
+	// leave out trips that can't leave within it or arrive by its arrive_by
+	DepartureWindow *TimeWindow `protobuf:"bytes,8,opt,name=departure_window,json=departureWindow,proto3" json:"departure_window,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListNearbyOffersRequest) Reset() {
+	*x = ListNearbyOffersRequest{}
+	mi := &file_proto_v1_ride_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNearbyOffersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNearbyOffersRequest) ProtoMessage() {}
+
+func (x *ListNearbyOffersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNearbyOffersRequest.ProtoReflect.Descriptor instead.
+func (*ListNearbyOffersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListNearbyOffersRequest) GetGeohashPrefix() string {
+	if x != nil {
+		return x.GeohashPrefix
+	}
+	return ""
+}
+
+func (x *ListNearbyOffersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (*UpdateOfferRequest) ProtoMessage() {}
-
-func (x *UpdateOfferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[6]
+func (x *ListNearbyOffersRequest) GetMeetingPointId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MeetingPointId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateOfferRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOfferRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{6}
+func (x *ListNearbyOffersRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
 }
 
-func (x *UpdateOfferRequest) GetId() string {
+func (x *ListNearbyOffersRequest) GetLongitude() float64 {
 	if x != nil {
-		return x.Id
+		return x.Longitude
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateOfferRequest) GetFare() float64 {
+func (x *ListNearbyOffersRequest) GetRadiusMeters() float64 {
 	if x != nil {
-		return x.Fare
+		return x.RadiusMeters
 	}
 	return 0
 }
 
-func (x *UpdateOfferRequest) GetSeats() int32 {
+func (x *ListNearbyOffersRequest) GetMinReliability() float64 {
 	if x != nil {
-		return x.Seats
+		return x.MinReliability
 	}
 	return 0
 }
 
-func (x *UpdateOfferRequest) GetStatus() string {
+func (x *ListNearbyOffersRequest) GetDepartureWindow() *TimeWindow {
 	if x != nil {
-		return x.Status
+		return x.DepartureWindow
 	}
-	return ""
+	return nil
 }
 
-type UpdateOfferResponse struct {
+type ListNearbyOffersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offer         *RideOffer             `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	Offers        []*RideOffer           `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOfferResponse) Reset() {
-	*x = UpdateOfferResponse{}
-	mi := &file_proto_v1_ride_proto_msgTypes[7]
+func (x *ListNearbyOffersResponse) Reset() {
+	*x = ListNearbyOffersResponse{}
+	mi := &file_proto_v1_ride_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOfferResponse) String() string {
+func (x *ListNearbyOffersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOfferResponse) ProtoMessage() {}
+func (*ListNearbyOffersResponse) ProtoMessage() {}
 
-func (x *UpdateOfferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[7]
+func (x *ListNearbyOffersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -522,40 +1249,40 @@ func (x *UpdateOfferResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOfferResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOfferResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListNearbyOffersResponse.ProtoReflect.Descriptor instead.
+func (*ListNearbyOffersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateOfferResponse) GetOffer() *RideOffer {
+func (x *ListNearbyOffersResponse) GetOffers() []*RideOffer {
 	if x != nil {
-		return x.Offer
+		return x.Offers
 	}
 	return nil
 }
 
-type DeleteOfferRequest struct {
+type ListMyOffersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteOfferRequest) Reset() {
-	*x = DeleteOfferRequest{}
-	mi := &file_proto_v1_ride_proto_msgTypes[8]
+func (x *ListMyOffersRequest) Reset() {
+	*x = ListMyOffersRequest{}
+	mi := &file_proto_v1_ride_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOfferRequest) String() string {
+func (x *ListMyOffersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOfferRequest) ProtoMessage() {}
+func (*ListMyOffersRequest) ProtoMessage() {}
 
-func (x *DeleteOfferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[8]
+func (x *ListMyOffersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -566,40 +1293,40 @@ func (x *DeleteOfferRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOfferRequest.ProtoReflect.Descriptor instead.
-func (*DeleteOfferRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListMyOffersRequest.ProtoReflect.Descriptor instead.
+func (*ListMyOffersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DeleteOfferRequest) GetId() string {
+func (x *ListMyOffersRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Id
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type DeleteOfferResponse struct {
+type ListMyOffersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Offers        []*RideOffer           `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteOfferResponse) Reset() {
-	*x = DeleteOfferResponse{}
-	mi := &file_proto_v1_ride_proto_msgTypes[9]
+func (x *ListMyOffersResponse) Reset() {
+	*x = ListMyOffersResponse{}
+	mi := &file_proto_v1_ride_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOfferResponse) String() string {
+func (x *ListMyOffersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOfferResponse) ProtoMessage() {}
+func (*ListMyOffersResponse) ProtoMessage() {}
 
-func (x *DeleteOfferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[9]
+func (x *ListMyOffersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,41 +1337,40 @@ func (x *DeleteOfferResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOfferResponse.ProtoReflect.Descriptor instead.
-func (*DeleteOfferResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListMyOffersResponse.ProtoReflect.Descriptor instead.
+func (*ListMyOffersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DeleteOfferResponse) GetSuccess() bool {
+func (x *ListMyOffersResponse) GetOffers() []*RideOffer {
 	if x != nil {
-		return x.Success
+		return x.Offers
 	}
-	return false
+	return nil
 }
 
-type ListNearbyOffersRequest struct {
+type ListOfferStopsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GeohashPrefix string                 `protobuf:"bytes,1,opt,name=geohash_prefix,json=geohashPrefix,proto3" json:"geohash_prefix,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OfferId       string                 `protobuf:"bytes,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNearbyOffersRequest) Reset() {
-	*x = ListNearbyOffersRequest{}
-	mi := &file_proto_v1_ride_proto_msgTypes[10]
+func (x *ListOfferStopsRequest) Reset() {
+	*x = ListOfferStopsRequest{}
+	mi := &file_proto_v1_ride_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNearbyOffersRequest) String() string {
+func (x *ListOfferStopsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNearbyOffersRequest) ProtoMessage() {}
+func (*ListOfferStopsRequest) ProtoMessage() {}
 
-func (x *ListNearbyOffersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[10]
+func (x *ListOfferStopsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,47 +1381,40 @@ func (x *ListNearbyOffersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNearbyOffersRequest.ProtoReflect.Descriptor instead.
-func (*ListNearbyOffersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListOfferStopsRequest.ProtoReflect.Descriptor instead.
+func (*ListOfferStopsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListNearbyOffersRequest) GetGeohashPrefix() string {
+func (x *ListOfferStopsRequest) GetOfferId() string {
 	if x != nil {
-		return x.GeohashPrefix
+		return x.OfferId
 	}
 	return ""
 }
 
-func (x *ListNearbyOffersRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-type ListNearbyOffersResponse struct {
+type ListOfferStopsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offers        []*RideOffer           `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	Stops         []*Stop                `protobuf:"bytes,1,rep,name=stops,proto3" json:"stops,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNearbyOffersResponse) Reset() {
-	*x = ListNearbyOffersResponse{}
-	mi := &file_proto_v1_ride_proto_msgTypes[11]
+func (x *ListOfferStopsResponse) Reset() {
+	*x = ListOfferStopsResponse{}
+	mi := &file_proto_v1_ride_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNearbyOffersResponse) String() string {
+func (x *ListOfferStopsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNearbyOffersResponse) ProtoMessage() {}
+func (*ListOfferStopsResponse) ProtoMessage() {}
 
-func (x *ListNearbyOffersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[11]
+func (x *ListOfferStopsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -706,40 +1425,42 @@ func (x *ListNearbyOffersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNearbyOffersResponse.ProtoReflect.Descriptor instead.
-func (*ListNearbyOffersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListOfferStopsResponse.ProtoReflect.Descriptor instead.
+func (*ListOfferStopsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListNearbyOffersResponse) GetOffers() []*RideOffer {
+func (x *ListOfferStopsResponse) GetStops() []*Stop {
 	if x != nil {
-		return x.Offers
+		return x.Stops
 	}
 	return nil
 }
 
-type ListMyOffersRequest struct {
+// replaces the offer's auto-accept rule, an empty rule turns it off
+type SetAutoAcceptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	OfferId       string                 `protobuf:"bytes,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	AutoAccept    *AutoAccept            `protobuf:"bytes,2,opt,name=auto_accept,json=autoAccept,proto3" json:"auto_accept,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMyOffersRequest) Reset() {
-	*x = ListMyOffersRequest{}
-	mi := &file_proto_v1_ride_proto_msgTypes[12]
+func (x *SetAutoAcceptRequest) Reset() {
+	*x = SetAutoAcceptRequest{}
+	mi := &file_proto_v1_ride_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMyOffersRequest) String() string {
+func (x *SetAutoAcceptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMyOffersRequest) ProtoMessage() {}
+func (*SetAutoAcceptRequest) ProtoMessage() {}
 
-func (x *ListMyOffersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[12]
+func (x *SetAutoAcceptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -750,40 +1471,47 @@ func (x *ListMyOffersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMyOffersRequest.ProtoReflect.Descriptor instead.
-func (*ListMyOffersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SetAutoAcceptRequest.ProtoReflect.Descriptor instead.
+func (*SetAutoAcceptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListMyOffersRequest) GetLimit() int32 {
+func (x *SetAutoAcceptRequest) GetOfferId() string {
 	if x != nil {
-		return x.Limit
+		return x.OfferId
 	}
-	return 0
+	return ""
 }
 
-type ListMyOffersResponse struct {
+func (x *SetAutoAcceptRequest) GetAutoAccept() *AutoAccept {
+	if x != nil {
+		return x.AutoAccept
+	}
+	return nil
+}
+
+type SetAutoAcceptResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offers        []*RideOffer           `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
+	Offer         *RideOffer             `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMyOffersResponse) Reset() {
-	*x = ListMyOffersResponse{}
-	mi := &file_proto_v1_ride_proto_msgTypes[13]
+func (x *SetAutoAcceptResponse) Reset() {
+	*x = SetAutoAcceptResponse{}
+	mi := &file_proto_v1_ride_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMyOffersResponse) String() string {
+func (x *SetAutoAcceptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMyOffersResponse) ProtoMessage() {}
+func (*SetAutoAcceptResponse) ProtoMessage() {}
 
-func (x *ListMyOffersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[13]
+func (x *SetAutoAcceptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -794,32 +1522,44 @@ func (x *ListMyOffersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMyOffersResponse.ProtoReflect.Descriptor instead.
-func (*ListMyOffersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SetAutoAcceptResponse.ProtoReflect.Descriptor instead.
+func (*SetAutoAcceptResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListMyOffersResponse) GetOffers() []*RideOffer {
+func (x *SetAutoAcceptResponse) GetOffer() *RideOffer {
 	if x != nil {
-		return x.Offers
+		return x.Offer
 	}
 	return nil
 }
 
 type CreateRequestRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromGeo       string                 `protobuf:"bytes,1,opt,name=from_geo,json=fromGeo,proto3" json:"from_geo,omitempty"`
-	ToGeo         string                 `protobuf:"bytes,2,opt,name=to_geo,json=toGeo,proto3" json:"to_geo,omitempty"`
-	Time          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
-	Seats         int32                  `protobuf:"varint,4,opt,name=seats,proto3" json:"seats,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	FromGeo string                 `protobuf:"bytes,1,opt,name=from_geo,json=fromGeo,proto3" json:"from_geo,omitempty"`
+	ToGeo   string                 `protobuf:"bytes,2,opt,name=to_geo,json=toGeo,proto3" json:"to_geo,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Seats   int32                  `protobuf:"varint,4,opt,name=seats,proto3" json:"seats,omitempty"`
+	Status  string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// meeting points stand in for from_geo / to_geo
+	FromPointId     string      `protobuf:"bytes,6,opt,name=from_point_id,json=fromPointId,proto3" json:"from_point_id,omitempty"`
+	ToPointId       string      `protobuf:"bytes,7,opt,name=to_point_id,json=toPointId,proto3" json:"to_point_id,omitempty"`
+	DepartureWindow *TimeWindow `protobuf:"bytes,8,opt,name=departure_window,json=departureWindow,proto3" json:"departure_window,omitempty"`
+	// also ask for the way back, linked to this request as a round trip
+	ReturnTrip *ReturnTrip `protobuf:"bytes,9,opt,name=return_trip,json=returnTrip,proto3" json:"return_trip,omitempty"`
+	// with return_trip: "together" (the default) has a driver take both
+	// legs or neither, "independent" lets each leg go its own way
+	PairMode string `protobuf:"bytes,10,opt,name=pair_mode,json=pairMode,proto3" json:"pair_mode,omitempty"`
+	// the fare to propose to whoever takes the request, within the org's
+	// fare policy around the fare model's estimate
+	Fare          float64 `protobuf:"fixed64,11,opt,name=fare,proto3" json:"fare,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateRequestRequest) Reset() {
 	*x = CreateRequestRequest{}
-	mi := &file_proto_v1_ride_proto_msgTypes[14]
+	mi := &file_proto_v1_ride_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -831,7 +1571,7 @@ func (x *CreateRequestRequest) String() string {
 func (*CreateRequestRequest) ProtoMessage() {}
 
 func (x *CreateRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[14]
+	mi := &file_proto_v1_ride_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,7 +1584,7 @@ func (x *CreateRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRequestRequest.ProtoReflect.Descriptor instead.
 func (*CreateRequestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{14}
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CreateRequestRequest) GetFromGeo() string {
@@ -882,16 +1622,60 @@ func (x *CreateRequestRequest) GetStatus() string {
 	return ""
 }
 
+func (x *CreateRequestRequest) GetFromPointId() string {
+	if x != nil {
+		return x.FromPointId
+	}
+	return ""
+}
+
+func (x *CreateRequestRequest) GetToPointId() string {
+	if x != nil {
+		return x.ToPointId
+	}
+	return ""
+}
+
+func (x *CreateRequestRequest) GetDepartureWindow() *TimeWindow {
+	if x != nil {
+		return x.DepartureWindow
+	}
+	return nil
+}
+
+func (x *CreateRequestRequest) GetReturnTrip() *ReturnTrip {
+	if x != nil {
+		return x.ReturnTrip
+	}
+	return nil
+}
+
+func (x *CreateRequestRequest) GetPairMode() string {
+	if x != nil {
+		return x.PairMode
+	}
+	return ""
+}
+
+func (x *CreateRequestRequest) GetFare() float64 {
+	if x != nil {
+		return x.Fare
+	}
+	return 0
+}
+
 type CreateRequestResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Request       *RideRequest           `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Request *RideRequest           `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	// set with return_trip
+	ReturnRequest *RideRequest `protobuf:"bytes,2,opt,name=return_request,json=returnRequest,proto3" json:"return_request,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateRequestResponse) Reset() {
 	*x = CreateRequestResponse{}
-	mi := &file_proto_v1_ride_proto_msgTypes[15]
+	mi := &file_proto_v1_ride_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -903,7 +1687,7 @@ func (x *CreateRequestResponse) String() string {
 func (*CreateRequestResponse) ProtoMessage() {}
 
 func (x *CreateRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[15]
+	mi := &file_proto_v1_ride_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -916,12 +1700,19 @@ func (x *CreateRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRequestResponse.ProtoReflect.Descriptor instead.
 func (*CreateRequestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{15}
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateRequestResponse) GetRequest() *RideRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
 }
 
-func (x *CreateRequestResponse) GetRequest() *RideRequest {
+func (x *CreateRequestResponse) GetReturnRequest() *RideRequest {
 	if x != nil {
-		return x.Request
+		return x.ReturnRequest
 	}
 	return nil
 }
@@ -935,7 +1726,7 @@ type GetRequestRequest struct {
 
 func (x *GetRequestRequest) Reset() {
 	*x = GetRequestRequest{}
-	mi := &file_proto_v1_ride_proto_msgTypes[16]
+	mi := &file_proto_v1_ride_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -947,7 +1738,7 @@ func (x *GetRequestRequest) String() string {
 func (*GetRequestRequest) ProtoMessage() {}
 
 func (x *GetRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[16]
+	mi := &file_proto_v1_ride_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,7 +1751,7 @@ func (x *GetRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRequestRequest.ProtoReflect.Descriptor instead.
 func (*GetRequestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{16}
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetRequestRequest) GetId() string {
@@ -979,7 +1770,7 @@ type GetRequestResponse struct {
 
 func (x *GetRequestResponse) Reset() {
 	*x = GetRequestResponse{}
-	mi := &file_proto_v1_ride_proto_msgTypes[17]
+	mi := &file_proto_v1_ride_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -991,7 +1782,7 @@ func (x *GetRequestResponse) String() string {
 func (*GetRequestResponse) ProtoMessage() {}
 
 func (x *GetRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[17]
+	mi := &file_proto_v1_ride_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1004,7 +1795,7 @@ func (x *GetRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRequestResponse.ProtoReflect.Descriptor instead.
 func (*GetRequestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{17}
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetRequestResponse) GetRequest() *RideRequest {
@@ -1015,16 +1806,17 @@ func (x *GetRequestResponse) GetRequest() *RideRequest {
 }
 
 type UpdateRequestStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// "cancelled" for an active request, "completed" for a matched one that has left
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateRequestStatusRequest) Reset() {
 	*x = UpdateRequestStatusRequest{}
-	mi := &file_proto_v1_ride_proto_msgTypes[18]
+	mi := &file_proto_v1_ride_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1036,7 +1828,7 @@ func (x *UpdateRequestStatusRequest) String() string {
 func (*UpdateRequestStatusRequest) ProtoMessage() {}
 
 func (x *UpdateRequestStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[18]
+	mi := &file_proto_v1_ride_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,7 +1841,7 @@ func (x *UpdateRequestStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRequestStatusRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRequestStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{18}
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *UpdateRequestStatusRequest) GetId() string {
@@ -1075,7 +1867,7 @@ type UpdateRequestStatusResponse struct {
 
 func (x *UpdateRequestStatusResponse) Reset() {
 	*x = UpdateRequestStatusResponse{}
-	mi := &file_proto_v1_ride_proto_msgTypes[19]
+	mi := &file_proto_v1_ride_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1087,7 +1879,7 @@ func (x *UpdateRequestStatusResponse) String() string {
 func (*UpdateRequestStatusResponse) ProtoMessage() {}
 
 func (x *UpdateRequestStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[19]
+	mi := &file_proto_v1_ride_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1100,7 +1892,7 @@ func (x *UpdateRequestStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRequestStatusResponse.ProtoReflect.Descriptor instead.
 func (*UpdateRequestStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{19}
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *UpdateRequestStatusResponse) GetRequest() *RideRequest {
@@ -1119,7 +1911,7 @@ type DeleteRequestRequest struct {
 
 func (x *DeleteRequestRequest) Reset() {
 	*x = DeleteRequestRequest{}
-	mi := &file_proto_v1_ride_proto_msgTypes[20]
+	mi := &file_proto_v1_ride_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1131,7 +1923,7 @@ func (x *DeleteRequestRequest) String() string {
 func (*DeleteRequestRequest) ProtoMessage() {}
 
 func (x *DeleteRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[20]
+	mi := &file_proto_v1_ride_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1144,7 +1936,7 @@ func (x *DeleteRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequestRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRequestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{20}
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *DeleteRequestRequest) GetId() string {
@@ -1163,7 +1955,7 @@ type DeleteRequestResponse struct {
 
 func (x *DeleteRequestResponse) Reset() {
 	*x = DeleteRequestResponse{}
-	mi := &file_proto_v1_ride_proto_msgTypes[21]
+	mi := &file_proto_v1_ride_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1175,7 +1967,7 @@ func (x *DeleteRequestResponse) String() string {
 func (*DeleteRequestResponse) ProtoMessage() {}
 
 func (x *DeleteRequestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[21]
+	mi := &file_proto_v1_ride_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1188,7 +1980,7 @@ func (x *DeleteRequestResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRequestResponse.ProtoReflect.Descriptor instead.
 func (*DeleteRequestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{21}
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DeleteRequestResponse) GetSuccess() bool {
@@ -1202,13 +1994,23 @@ type ListNearbyRequestsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GeohashPrefix string                 `protobuf:"bytes,1,opt,name=geohash_prefix,json=geohashPrefix,proto3" json:"geohash_prefix,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// search around a meeting point instead of a prefix
+	MeetingPointId string `protobuf:"bytes,3,opt,name=meeting_point_id,json=meetingPointId,proto3" json:"meeting_point_id,omitempty"`
+	// with radius_meters set, search this circle instead, closest first
+	Latitude     float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude    float64 `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusMeters float64 `protobuf:"fixed64,6,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"`
+	// leave out users whose reliability score (0-100) is lower, 0 keeps everyone
+	MinReliability float64 `protobuf:"fixed64,7,opt,name=min_reliability,json=minReliability,proto3" json:"min_reliability,omitempty"`
+	// leave out trips that can't leave within it or arrive by its arrive_by
+	DepartureWindow *TimeWindow `protobuf:"bytes,8,opt,name=departure_window,json=departureWindow,proto3" json:"departure_window,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListNearbyRequestsRequest) Reset() {
 	*x = ListNearbyRequestsRequest{}
-	mi := &file_proto_v1_ride_proto_msgTypes[22]
+	mi := &file_proto_v1_ride_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1220,7 +2022,7 @@ func (x *ListNearbyRequestsRequest) String() string {
 func (*ListNearbyRequestsRequest) ProtoMessage() {}
 
 func (x *ListNearbyRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[22]
+	mi := &file_proto_v1_ride_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,7 +2035,7 @@ func (x *ListNearbyRequestsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNearbyRequestsRequest.ProtoReflect.Descriptor instead.
 func (*ListNearbyRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{22}
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ListNearbyRequestsRequest) GetGeohashPrefix() string {
@@ -1250,6 +2052,48 @@ func (x *ListNearbyRequestsRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListNearbyRequestsRequest) GetMeetingPointId() string {
+	if x != nil {
+		return x.MeetingPointId
+	}
+	return ""
+}
+
+func (x *ListNearbyRequestsRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *ListNearbyRequestsRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *ListNearbyRequestsRequest) GetRadiusMeters() float64 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+func (x *ListNearbyRequestsRequest) GetMinReliability() float64 {
+	if x != nil {
+		return x.MinReliability
+	}
+	return 0
+}
+
+func (x *ListNearbyRequestsRequest) GetDepartureWindow() *TimeWindow {
+	if x != nil {
+		return x.DepartureWindow
+	}
+	return nil
+}
+
 type ListNearbyRequestsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Requests      []*RideRequest         `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
@@ -1259,7 +2103,7 @@ type ListNearbyRequestsResponse struct {
 
 func (x *ListNearbyRequestsResponse) Reset() {
 	*x = ListNearbyRequestsResponse{}
-	mi := &file_proto_v1_ride_proto_msgTypes[23]
+	mi := &file_proto_v1_ride_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1271,7 +2115,7 @@ func (x *ListNearbyRequestsResponse) String() string {
 func (*ListNearbyRequestsResponse) ProtoMessage() {}
 
 func (x *ListNearbyRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[23]
+	mi := &file_proto_v1_ride_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,7 +2128,7 @@ func (x *ListNearbyRequestsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNearbyRequestsResponse.ProtoReflect.Descriptor instead.
 func (*ListNearbyRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{23}
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *ListNearbyRequestsResponse) GetRequests() []*RideRequest {
@@ -1303,7 +2147,7 @@ type ListMyRequestsRequest struct {
 
 func (x *ListMyRequestsRequest) Reset() {
 	*x = ListMyRequestsRequest{}
-	mi := &file_proto_v1_ride_proto_msgTypes[24]
+	mi := &file_proto_v1_ride_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1315,7 +2159,7 @@ func (x *ListMyRequestsRequest) String() string {
 func (*ListMyRequestsRequest) ProtoMessage() {}
 
 func (x *ListMyRequestsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[24]
+	mi := &file_proto_v1_ride_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1328,7 +2172,7 @@ func (x *ListMyRequestsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMyRequestsRequest.ProtoReflect.Descriptor instead.
 func (*ListMyRequestsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{24}
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ListMyRequestsRequest) GetLimit() int32 {
@@ -1347,7 +2191,7 @@ type ListMyRequestsResponse struct {
 
 func (x *ListMyRequestsResponse) Reset() {
 	*x = ListMyRequestsResponse{}
-	mi := &file_proto_v1_ride_proto_msgTypes[25]
+	mi := &file_proto_v1_ride_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1359,7 +2203,7 @@ func (x *ListMyRequestsResponse) String() string {
 func (*ListMyRequestsResponse) ProtoMessage() {}
 
 func (x *ListMyRequestsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_ride_proto_msgTypes[25]
+	mi := &file_proto_v1_ride_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1372,7 +2216,7 @@ func (x *ListMyRequestsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMyRequestsResponse.ProtoReflect.Descriptor instead.
 func (*ListMyRequestsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_ride_proto_rawDescGZIP(), []int{25}
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ListMyRequestsResponse) GetRequests() []*RideRequest {
@@ -1382,11 +2226,273 @@ func (x *ListMyRequestsResponse) GetRequests() []*RideRequest {
 	return nil
 }
 
+// one status change of an offer or request; from_status is empty for its
+// creation and to_status is "deleted" for its removal
+type StatusEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,3,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,4,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusEvent) Reset() {
+	*x = StatusEvent{}
+	mi := &file_proto_v1_ride_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusEvent) ProtoMessage() {}
+
+func (x *StatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusEvent.ProtoReflect.Descriptor instead.
+func (*StatusEvent) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *StatusEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StatusEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *StatusEvent) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *StatusEvent) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *StatusEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StatusEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetOfferHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OfferId       string                 `protobuf:"bytes,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOfferHistoryRequest) Reset() {
+	*x = GetOfferHistoryRequest{}
+	mi := &file_proto_v1_ride_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOfferHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfferHistoryRequest) ProtoMessage() {}
+
+func (x *GetOfferHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfferHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetOfferHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetOfferHistoryRequest) GetOfferId() string {
+	if x != nil {
+		return x.OfferId
+	}
+	return ""
+}
+
+type GetOfferHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*StatusEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOfferHistoryResponse) Reset() {
+	*x = GetOfferHistoryResponse{}
+	mi := &file_proto_v1_ride_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOfferHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOfferHistoryResponse) ProtoMessage() {}
+
+func (x *GetOfferHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOfferHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetOfferHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetOfferHistoryResponse) GetEvents() []*StatusEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GetRequestHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequestHistoryRequest) Reset() {
+	*x = GetRequestHistoryRequest{}
+	mi := &file_proto_v1_ride_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequestHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequestHistoryRequest) ProtoMessage() {}
+
+func (x *GetRequestHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequestHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetRequestHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetRequestHistoryRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetRequestHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*StatusEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequestHistoryResponse) Reset() {
+	*x = GetRequestHistoryResponse{}
+	mi := &file_proto_v1_ride_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequestHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequestHistoryResponse) ProtoMessage() {}
+
+func (x *GetRequestHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_ride_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequestHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetRequestHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_ride_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetRequestHistoryResponse) GetEvents() []*StatusEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
 var File_proto_v1_ride_proto protoreflect.FileDescriptor
 
 const file_proto_v1_ride_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/v1/ride.proto\x12\bproto.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdc\x01\n" +
+	"\x13proto/v1/ride.proto\x12\bproto.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8f\x05\n" +
 	"\tRideOffer\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tdriver_id\x18\x02 \x01(\tR\bdriverId\x12\x19\n" +
@@ -1395,7 +2501,42 @@ const file_proto_v1_ride_proto_rawDesc = "" +
 	"\x04fare\x18\x05 \x01(\x01R\x04fare\x12.\n" +
 	"\x04time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
 	"\x05seats\x18\a \x01(\x05R\x05seats\x12\x16\n" +
-	"\x06status\x18\b \x01(\tR\x06status\"\xc6\x01\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x15\n" +
+	"\x06org_id\x18\t \x01(\tR\x05orgId\x12\"\n" +
+	"\rfrom_point_id\x18\n" +
+	" \x01(\tR\vfromPointId\x12\x1e\n" +
+	"\vto_point_id\x18\v \x01(\tR\ttoPointId\x12,\n" +
+	"\x12max_detour_minutes\x18\f \x01(\x05R\x10maxDetourMinutes\x12!\n" +
+	"\fcancelled_by\x18\r \x01(\tR\vcancelledBy\x12=\n" +
+	"\fcancelled_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x12#\n" +
+	"\rcancel_reason\x18\x0f \x01(\tR\fcancelReason\x125\n" +
+	"\vauto_accept\x18\x10 \x01(\v2\x14.proto.v1.AutoAcceptR\n" +
+	"autoAccept\x12?\n" +
+	"\x10departure_window\x18\x11 \x01(\v2\x14.proto.v1.TimeWindowR\x0fdepartureWindow\x12\x17\n" +
+	"\apair_id\x18\x12 \x01(\tR\x06pairId\x12\x10\n" +
+	"\x03leg\x18\x13 \x01(\tR\x03leg\"}\n" +
+	"\n" +
+	"ReturnTrip\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12?\n" +
+	"\x10departure_window\x18\x02 \x01(\v2\x14.proto.v1.TimeWindowR\x0fdepartureWindow\"\xb1\x01\n" +
+	"\n" +
+	"TimeWindow\x126\n" +
+	"\bearliest\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bearliest\x122\n" +
+	"\x06latest\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x06latest\x127\n" +
+	"\tarrive_by\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\barriveBy\"h\n" +
+	"\n" +
+	"AutoAccept\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x1d\n" +
+	"\n" +
+	"min_rating\x18\x02 \x01(\x01R\tminRating\x12'\n" +
+	"\x0fmin_reliability\x18\x03 \x01(\x01R\x0eminReliability\"\xab\x01\n" +
+	"\x04Stop\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x05R\x03seq\x12\x18\n" +
+	"\ageohash\x18\x02 \x01(\tR\ageohash\x12\x19\n" +
+	"\bpoint_id\x18\x03 \x01(\tR\apointId\x12=\n" +
+	"\fplanned_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vplannedTime\x12\x1d\n" +
+	"\n" +
+	"seats_free\x18\x05 \x01(\x05R\tseatsFree\"\xbe\x03\n" +
 	"\vRideRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x19\n" +
@@ -1403,15 +2544,35 @@ const file_proto_v1_ride_proto_rawDesc = "" +
 	"\x06to_geo\x18\x04 \x01(\tR\x05toGeo\x12.\n" +
 	"\x04time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
 	"\x05seats\x18\x06 \x01(\x05R\x05seats\x12\x16\n" +
-	"\x06status\x18\a \x01(\tR\x06status\"\xa0\x01\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x15\n" +
+	"\x06org_id\x18\b \x01(\tR\x05orgId\x12\"\n" +
+	"\rfrom_point_id\x18\t \x01(\tR\vfromPointId\x12\x1e\n" +
+	"\vto_point_id\x18\n" +
+	" \x01(\tR\ttoPointId\x12?\n" +
+	"\x10departure_window\x18\v \x01(\v2\x14.proto.v1.TimeWindowR\x0fdepartureWindow\x12\x17\n" +
+	"\apair_id\x18\f \x01(\tR\x06pairId\x12\x10\n" +
+	"\x03leg\x18\r \x01(\tR\x03leg\x12\x1b\n" +
+	"\tpair_mode\x18\x0e \x01(\tR\bpairMode\x12\x12\n" +
+	"\x04fare\x18\x0f \x01(\x01R\x04fare\"\xef\x03\n" +
 	"\x12CreateOfferRequest\x12\x19\n" +
 	"\bfrom_geo\x18\x01 \x01(\tR\afromGeo\x12\x15\n" +
 	"\x06to_geo\x18\x02 \x01(\tR\x05toGeo\x12\x12\n" +
 	"\x04fare\x18\x03 \x01(\x01R\x04fare\x12.\n" +
 	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
-	"\x05seats\x18\x05 \x01(\x05R\x05seats\"@\n" +
+	"\x05seats\x18\x05 \x01(\x05R\x05seats\x12\"\n" +
+	"\rfrom_point_id\x18\x06 \x01(\tR\vfromPointId\x12\x1e\n" +
+	"\vto_point_id\x18\a \x01(\tR\ttoPointId\x12,\n" +
+	"\x12max_detour_minutes\x18\b \x01(\x05R\x10maxDetourMinutes\x12,\n" +
+	"\twaypoints\x18\t \x03(\v2\x0e.proto.v1.StopR\twaypoints\x125\n" +
+	"\vauto_accept\x18\n" +
+	" \x01(\v2\x14.proto.v1.AutoAcceptR\n" +
+	"autoAccept\x12?\n" +
+	"\x10departure_window\x18\v \x01(\v2\x14.proto.v1.TimeWindowR\x0fdepartureWindow\x125\n" +
+	"\vreturn_trip\x18\f \x01(\v2\x14.proto.v1.ReturnTripR\n" +
+	"returnTrip\"x\n" +
 	"\x13CreateOfferResponse\x12)\n" +
-	"\x05offer\x18\x01 \x01(\v2\x13.proto.v1.RideOfferR\x05offer\"!\n" +
+	"\x05offer\x18\x01 \x01(\v2\x13.proto.v1.RideOfferR\x05offer\x126\n" +
+	"\freturn_offer\x18\x02 \x01(\v2\x13.proto.v1.RideOfferR\vreturnOffer\"!\n" +
 	"\x0fGetOfferRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"=\n" +
 	"\x10GetOfferResponse\x12)\n" +
@@ -1426,24 +2587,49 @@ const file_proto_v1_ride_proto_rawDesc = "" +
 	"\x12DeleteOfferRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"/\n" +
 	"\x13DeleteOfferResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"V\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc9\x02\n" +
 	"\x17ListNearbyOffersRequest\x12%\n" +
 	"\x0egeohash_prefix\x18\x01 \x01(\tR\rgeohashPrefix\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"G\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12(\n" +
+	"\x10meeting_point_id\x18\x03 \x01(\tR\x0emeetingPointId\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\x12#\n" +
+	"\rradius_meters\x18\x06 \x01(\x01R\fradiusMeters\x12'\n" +
+	"\x0fmin_reliability\x18\a \x01(\x01R\x0eminReliability\x12?\n" +
+	"\x10departure_window\x18\b \x01(\v2\x14.proto.v1.TimeWindowR\x0fdepartureWindow\"G\n" +
 	"\x18ListNearbyOffersResponse\x12+\n" +
 	"\x06offers\x18\x01 \x03(\v2\x13.proto.v1.RideOfferR\x06offers\"+\n" +
 	"\x13ListMyOffersRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\"C\n" +
 	"\x14ListMyOffersResponse\x12+\n" +
-	"\x06offers\x18\x01 \x03(\v2\x13.proto.v1.RideOfferR\x06offers\"\xa6\x01\n" +
+	"\x06offers\x18\x01 \x03(\v2\x13.proto.v1.RideOfferR\x06offers\"2\n" +
+	"\x15ListOfferStopsRequest\x12\x19\n" +
+	"\boffer_id\x18\x01 \x01(\tR\aofferId\">\n" +
+	"\x16ListOfferStopsResponse\x12$\n" +
+	"\x05stops\x18\x01 \x03(\v2\x0e.proto.v1.StopR\x05stops\"h\n" +
+	"\x14SetAutoAcceptRequest\x12\x19\n" +
+	"\boffer_id\x18\x01 \x01(\tR\aofferId\x125\n" +
+	"\vauto_accept\x18\x02 \x01(\v2\x14.proto.v1.AutoAcceptR\n" +
+	"autoAccept\"B\n" +
+	"\x15SetAutoAcceptResponse\x12)\n" +
+	"\x05offer\x18\x01 \x01(\v2\x13.proto.v1.RideOfferR\x05offer\"\x93\x03\n" +
 	"\x14CreateRequestRequest\x12\x19\n" +
 	"\bfrom_geo\x18\x01 \x01(\tR\afromGeo\x12\x15\n" +
 	"\x06to_geo\x18\x02 \x01(\tR\x05toGeo\x12.\n" +
 	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
 	"\x05seats\x18\x04 \x01(\x05R\x05seats\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\tR\x06status\"H\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\"\n" +
+	"\rfrom_point_id\x18\x06 \x01(\tR\vfromPointId\x12\x1e\n" +
+	"\vto_point_id\x18\a \x01(\tR\ttoPointId\x12?\n" +
+	"\x10departure_window\x18\b \x01(\v2\x14.proto.v1.TimeWindowR\x0fdepartureWindow\x125\n" +
+	"\vreturn_trip\x18\t \x01(\v2\x14.proto.v1.ReturnTripR\n" +
+	"returnTrip\x12\x1b\n" +
+	"\tpair_mode\x18\n" +
+	" \x01(\tR\bpairMode\x12\x12\n" +
+	"\x04fare\x18\v \x01(\x01R\x04fare\"\x86\x01\n" +
 	"\x15CreateRequestResponse\x12/\n" +
-	"\arequest\x18\x01 \x01(\v2\x15.proto.v1.RideRequestR\arequest\"#\n" +
+	"\arequest\x18\x01 \x01(\v2\x15.proto.v1.RideRequestR\arequest\x12<\n" +
+	"\x0ereturn_request\x18\x02 \x01(\v2\x15.proto.v1.RideRequestR\rreturnRequest\"#\n" +
 	"\x11GetRequestRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"E\n" +
 	"\x12GetRequestResponse\x12/\n" +
@@ -1456,30 +2642,59 @@ const file_proto_v1_ride_proto_rawDesc = "" +
 	"\x14DeleteRequestRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
 	"\x15DeleteRequestResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"X\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xcb\x02\n" +
 	"\x19ListNearbyRequestsRequest\x12%\n" +
 	"\x0egeohash_prefix\x18\x01 \x01(\tR\rgeohashPrefix\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"O\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12(\n" +
+	"\x10meeting_point_id\x18\x03 \x01(\tR\x0emeetingPointId\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\x12#\n" +
+	"\rradius_meters\x18\x06 \x01(\x01R\fradiusMeters\x12'\n" +
+	"\x0fmin_reliability\x18\a \x01(\x01R\x0eminReliability\x12?\n" +
+	"\x10departure_window\x18\b \x01(\v2\x14.proto.v1.TimeWindowR\x0fdepartureWindow\"O\n" +
 	"\x1aListNearbyRequestsResponse\x121\n" +
 	"\brequests\x18\x01 \x03(\v2\x15.proto.v1.RideRequestR\brequests\"-\n" +
 	"\x15ListMyRequestsRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\"K\n" +
 	"\x16ListMyRequestsResponse\x121\n" +
-	"\brequests\x18\x01 \x03(\v2\x15.proto.v1.RideRequestR\brequests2\xfd\a\n" +
+	"\brequests\x18\x01 \x03(\v2\x15.proto.v1.RideRequestR\brequests\"\xc9\x01\n" +
+	"\vStatusEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x1f\n" +
+	"\vfrom_status\x18\x03 \x01(\tR\n" +
+	"fromStatus\x12\x1b\n" +
+	"\tto_status\x18\x04 \x01(\tR\btoStatus\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"3\n" +
+	"\x16GetOfferHistoryRequest\x12\x19\n" +
+	"\boffer_id\x18\x01 \x01(\tR\aofferId\"H\n" +
+	"\x17GetOfferHistoryResponse\x12-\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.proto.v1.StatusEventR\x06events\"9\n" +
+	"\x18GetRequestHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\"J\n" +
+	"\x19GetRequestHistoryResponse\x12-\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.proto.v1.StatusEventR\x06events2\xe2\n" +
+	"\n" +
 	"\vRideService\x12L\n" +
 	"\vCreateOffer\x12\x1c.proto.v1.CreateOfferRequest\x1a\x1d.proto.v1.CreateOfferResponse\"\x00\x12C\n" +
 	"\bGetOffer\x12\x19.proto.v1.GetOfferRequest\x1a\x1a.proto.v1.GetOfferResponse\"\x00\x12L\n" +
 	"\vUpdateOffer\x12\x1c.proto.v1.UpdateOfferRequest\x1a\x1d.proto.v1.UpdateOfferResponse\"\x00\x12L\n" +
 	"\vDeleteOffer\x12\x1c.proto.v1.DeleteOfferRequest\x1a\x1d.proto.v1.DeleteOfferResponse\"\x00\x12[\n" +
 	"\x10ListNearbyOffers\x12!.proto.v1.ListNearbyOffersRequest\x1a\".proto.v1.ListNearbyOffersResponse\"\x00\x12O\n" +
-	"\fListMyOffers\x12\x1d.proto.v1.ListMyOffersRequest\x1a\x1e.proto.v1.ListMyOffersResponse\"\x00\x12R\n" +
+	"\fListMyOffers\x12\x1d.proto.v1.ListMyOffersRequest\x1a\x1e.proto.v1.ListMyOffersResponse\"\x00\x12U\n" +
+	"\x0eListOfferStops\x12\x1f.proto.v1.ListOfferStopsRequest\x1a .proto.v1.ListOfferStopsResponse\"\x00\x12R\n" +
+	"\rSetAutoAccept\x12\x1e.proto.v1.SetAutoAcceptRequest\x1a\x1f.proto.v1.SetAutoAcceptResponse\"\x00\x12R\n" +
 	"\rCreateRequest\x12\x1e.proto.v1.CreateRequestRequest\x1a\x1f.proto.v1.CreateRequestResponse\"\x00\x12I\n" +
 	"\n" +
 	"GetRequest\x12\x1b.proto.v1.GetRequestRequest\x1a\x1c.proto.v1.GetRequestResponse\"\x00\x12d\n" +
 	"\x13UpdateRequestStatus\x12$.proto.v1.UpdateRequestStatusRequest\x1a%.proto.v1.UpdateRequestStatusResponse\"\x00\x12R\n" +
 	"\rDeleteRequest\x12\x1e.proto.v1.DeleteRequestRequest\x1a\x1f.proto.v1.DeleteRequestResponse\"\x00\x12a\n" +
 	"\x12ListNearbyRequests\x12#.proto.v1.ListNearbyRequestsRequest\x1a$.proto.v1.ListNearbyRequestsResponse\"\x00\x12U\n" +
-	"\x0eListMyRequests\x12\x1f.proto.v1.ListMyRequestsRequest\x1a .proto.v1.ListMyRequestsResponse\"\x00B\x11Z\x0f./proto/v1/rideb\x06proto3"
+	"\x0eListMyRequests\x12\x1f.proto.v1.ListMyRequestsRequest\x1a .proto.v1.ListMyRequestsResponse\"\x00\x12X\n" +
+	"\x0fGetOfferHistory\x12 .proto.v1.GetOfferHistoryRequest\x1a!.proto.v1.GetOfferHistoryResponse\"\x00\x12^\n" +
+	"\x11GetRequestHistory\x12\".proto.v1.GetRequestHistoryRequest\x1a#.proto.v1.GetRequestHistoryResponse\"\x00B\x11Z\x0f./proto/v1/rideb\x06proto3"
 
 var (
 	file_proto_v1_ride_proto_rawDescOnce sync.Once
@@ -1493,80 +2708,127 @@ func file_proto_v1_ride_proto_rawDescGZIP() []byte {
 	return file_proto_v1_ride_proto_rawDescData
 }
 
-var file_proto_v1_ride_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_proto_v1_ride_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_proto_v1_ride_proto_goTypes = []any{
 	(*RideOffer)(nil),                   // 0: proto.v1.RideOffer
-	(*RideRequest)(nil),                 // 1: proto.v1.RideRequest
-	(*CreateOfferRequest)(nil),          // 2: proto.v1.CreateOfferRequest
-	(*CreateOfferResponse)(nil),         // 3: proto.v1.CreateOfferResponse
-	(*GetOfferRequest)(nil),             // 4: proto.v1.GetOfferRequest
-	(*GetOfferResponse)(nil),            // 5: proto.v1.GetOfferResponse
-	(*UpdateOfferRequest)(nil),          // 6: proto.v1.UpdateOfferRequest
-	(*UpdateOfferResponse)(nil),         // 7: proto.v1.UpdateOfferResponse
-	(*DeleteOfferRequest)(nil),          // 8: proto.v1.DeleteOfferRequest
-	(*DeleteOfferResponse)(nil),         // 9: proto.v1.DeleteOfferResponse
-	(*ListNearbyOffersRequest)(nil),     // 10: proto.v1.ListNearbyOffersRequest
-	(*ListNearbyOffersResponse)(nil),    // 11: proto.v1.ListNearbyOffersResponse
-	(*ListMyOffersRequest)(nil),         // 12: proto.v1.ListMyOffersRequest
-	(*ListMyOffersResponse)(nil),        // 13: proto.v1.ListMyOffersResponse
-	(*CreateRequestRequest)(nil),        // 14: proto.v1.CreateRequestRequest
-	(*CreateRequestResponse)(nil),       // 15: proto.v1.CreateRequestResponse
-	(*GetRequestRequest)(nil),           // 16: proto.v1.GetRequestRequest
-	(*GetRequestResponse)(nil),          // 17: proto.v1.GetRequestResponse
-	(*UpdateRequestStatusRequest)(nil),  // 18: proto.v1.UpdateRequestStatusRequest
-	(*UpdateRequestStatusResponse)(nil), // 19: proto.v1.UpdateRequestStatusResponse
-	(*DeleteRequestRequest)(nil),        // 20: proto.v1.DeleteRequestRequest
-	(*DeleteRequestResponse)(nil),       // 21: proto.v1.DeleteRequestResponse
-	(*ListNearbyRequestsRequest)(nil),   // 22: proto.v1.ListNearbyRequestsRequest
-	(*ListNearbyRequestsResponse)(nil),  // 23: proto.v1.ListNearbyRequestsResponse
-	(*ListMyRequestsRequest)(nil),       // 24: proto.v1.ListMyRequestsRequest
-	(*ListMyRequestsResponse)(nil),      // 25: proto.v1.ListMyRequestsResponse
-	(*timestamppb.Timestamp)(nil),       // 26: google.protobuf.Timestamp
+	(*ReturnTrip)(nil),                  // 1: proto.v1.ReturnTrip
+	(*TimeWindow)(nil),                  // 2: proto.v1.TimeWindow
+	(*AutoAccept)(nil),                  // 3: proto.v1.AutoAccept
+	(*Stop)(nil),                        // 4: proto.v1.Stop
+	(*RideRequest)(nil),                 // 5: proto.v1.RideRequest
+	(*CreateOfferRequest)(nil),          // 6: proto.v1.CreateOfferRequest
+	(*CreateOfferResponse)(nil),         // 7: proto.v1.CreateOfferResponse
+	(*GetOfferRequest)(nil),             // 8: proto.v1.GetOfferRequest
+	(*GetOfferResponse)(nil),            // 9: proto.v1.GetOfferResponse
+	(*UpdateOfferRequest)(nil),          // 10: proto.v1.UpdateOfferRequest
+	(*UpdateOfferResponse)(nil),         // 11: proto.v1.UpdateOfferResponse
+	(*DeleteOfferRequest)(nil),          // 12: proto.v1.DeleteOfferRequest
+	(*DeleteOfferResponse)(nil),         // 13: proto.v1.DeleteOfferResponse
+	(*ListNearbyOffersRequest)(nil),     // 14: proto.v1.ListNearbyOffersRequest
+	(*ListNearbyOffersResponse)(nil),    // 15: proto.v1.ListNearbyOffersResponse
+	(*ListMyOffersRequest)(nil),         // 16: proto.v1.ListMyOffersRequest
+	(*ListMyOffersResponse)(nil),        // 17: proto.v1.ListMyOffersResponse
+	(*ListOfferStopsRequest)(nil),       // 18: proto.v1.ListOfferStopsRequest
+	(*ListOfferStopsResponse)(nil),      // 19: proto.v1.ListOfferStopsResponse
+	(*SetAutoAcceptRequest)(nil),        // 20: proto.v1.SetAutoAcceptRequest
+	(*SetAutoAcceptResponse)(nil),       // 21: proto.v1.SetAutoAcceptResponse
+	(*CreateRequestRequest)(nil),        // 22: proto.v1.CreateRequestRequest
+	(*CreateRequestResponse)(nil),       // 23: proto.v1.CreateRequestResponse
+	(*GetRequestRequest)(nil),           // 24: proto.v1.GetRequestRequest
+	(*GetRequestResponse)(nil),          // 25: proto.v1.GetRequestResponse
+	(*UpdateRequestStatusRequest)(nil),  // 26: proto.v1.UpdateRequestStatusRequest
+	(*UpdateRequestStatusResponse)(nil), // 27: proto.v1.UpdateRequestStatusResponse
+	(*DeleteRequestRequest)(nil),        // 28: proto.v1.DeleteRequestRequest
+	(*DeleteRequestResponse)(nil),       // 29: proto.v1.DeleteRequestResponse
+	(*ListNearbyRequestsRequest)(nil),   // 30: proto.v1.ListNearbyRequestsRequest
+	(*ListNearbyRequestsResponse)(nil),  // 31: proto.v1.ListNearbyRequestsResponse
+	(*ListMyRequestsRequest)(nil),       // 32: proto.v1.ListMyRequestsRequest
+	(*ListMyRequestsResponse)(nil),      // 33: proto.v1.ListMyRequestsResponse
+	(*StatusEvent)(nil),                 // 34: proto.v1.StatusEvent
+	(*GetOfferHistoryRequest)(nil),      // 35: proto.v1.GetOfferHistoryRequest
+	(*GetOfferHistoryResponse)(nil),     // 36: proto.v1.GetOfferHistoryResponse
+	(*GetRequestHistoryRequest)(nil),    // 37: proto.v1.GetRequestHistoryRequest
+	(*GetRequestHistoryResponse)(nil),   // 38: proto.v1.GetRequestHistoryResponse
+	(*timestamppb.Timestamp)(nil),       // 39: google.protobuf.Timestamp
 }
 var file_proto_v1_ride_proto_depIdxs = []int32{
-	26, // 0: proto.v1.RideOffer.time:type_name -> google.protobuf.Timestamp
-	26, // 1: proto.v1.RideRequest.time:type_name -> google.protobuf.Timestamp
-	26, // 2: proto.v1.CreateOfferRequest.time:type_name -> google.protobuf.Timestamp
-	0,  // 3: proto.v1.CreateOfferResponse.offer:type_name -> proto.v1.RideOffer
-	0,  // 4: proto.v1.GetOfferResponse.offer:type_name -> proto.v1.RideOffer
-	0,  // 5: proto.v1.UpdateOfferResponse.offer:type_name -> proto.v1.RideOffer
-	0,  // 6: proto.v1.ListNearbyOffersResponse.offers:type_name -> proto.v1.RideOffer
-	0,  // 7: proto.v1.ListMyOffersResponse.offers:type_name -> proto.v1.RideOffer
-	26, // 8: proto.v1.CreateRequestRequest.time:type_name -> google.protobuf.Timestamp
-	1,  // 9: proto.v1.CreateRequestResponse.request:type_name -> proto.v1.RideRequest
-	1,  // 10: proto.v1.GetRequestResponse.request:type_name -> proto.v1.RideRequest
-	1,  // 11: proto.v1.UpdateRequestStatusResponse.request:type_name -> proto.v1.RideRequest
-	1,  // 12: proto.v1.ListNearbyRequestsResponse.requests:type_name -> proto.v1.RideRequest
-	1,  // 13: proto.v1.ListMyRequestsResponse.requests:type_name -> proto.v1.RideRequest
-	2,  // 14: proto.v1.RideService.CreateOffer:input_type -> proto.v1.CreateOfferRequest
-	4,  // 15: proto.v1.RideService.GetOffer:input_type -> proto.v1.GetOfferRequest
-	6,  // 16: proto.v1.RideService.UpdateOffer:input_type -> proto.v1.UpdateOfferRequest
-	8,  // 17: proto.v1.RideService.DeleteOffer:input_type -> proto.v1.DeleteOfferRequest
-	10, // 18: proto.v1.RideService.ListNearbyOffers:input_type -> proto.v1.ListNearbyOffersRequest
-	12, // 19: proto.v1.RideService.ListMyOffers:input_type -> proto.v1.ListMyOffersRequest
-	14, // 20: proto.v1.RideService.CreateRequest:input_type -> proto.v1.CreateRequestRequest
-	16, // 21: proto.v1.RideService.GetRequest:input_type -> proto.v1.GetRequestRequest
-	18, // 22: proto.v1.RideService.UpdateRequestStatus:input_type -> proto.v1.UpdateRequestStatusRequest
-	20, // 23: proto.v1.RideService.DeleteRequest:input_type -> proto.v1.DeleteRequestRequest
-	22, // 24: proto.v1.RideService.ListNearbyRequests:input_type -> proto.v1.ListNearbyRequestsRequest
-	24, // 25: proto.v1.RideService.ListMyRequests:input_type -> proto.v1.ListMyRequestsRequest
-	3,  // 26: proto.v1.RideService.CreateOffer:output_type -> proto.v1.CreateOfferResponse
-	5,  // 27: proto.v1.RideService.GetOffer:output_type -> proto.v1.GetOfferResponse
-	7,  // 28: proto.v1.RideService.UpdateOffer:output_type -> proto.v1.UpdateOfferResponse
-	9,  // 29: proto.v1.RideService.DeleteOffer:output_type -> proto.v1.DeleteOfferResponse
-	11, // 30: proto.v1.RideService.ListNearbyOffers:output_type -> proto.v1.ListNearbyOffersResponse
-	13, // 31: proto.v1.RideService.ListMyOffers:output_type -> proto.v1.ListMyOffersResponse
-	15, // 32: proto.v1.RideService.CreateRequest:output_type -> proto.v1.CreateRequestResponse
-	17, // 33: proto.v1.RideService.GetRequest:output_type -> proto.v1.GetRequestResponse
-	19, // 34: proto.v1.RideService.UpdateRequestStatus:output_type -> proto.v1.UpdateRequestStatusResponse
-	21, // 35: proto.v1.RideService.DeleteRequest:output_type -> proto.v1.DeleteRequestResponse
-	23, // 36: proto.v1.RideService.ListNearbyRequests:output_type -> proto.v1.ListNearbyRequestsResponse
-	25, // 37: proto.v1.RideService.ListMyRequests:output_type -> proto.v1.ListMyRequestsResponse
-	26, // [26:38] is the sub-list for method output_type
-	14, // [14:26] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	39, // 0: proto.v1.RideOffer.time:type_name -> google.protobuf.Timestamp
+	39, // 1: proto.v1.RideOffer.cancelled_at:type_name -> google.protobuf.Timestamp
+	3,  // 2: proto.v1.RideOffer.auto_accept:type_name -> proto.v1.AutoAccept
+	2,  // 3: proto.v1.RideOffer.departure_window:type_name -> proto.v1.TimeWindow
+	39, // 4: proto.v1.ReturnTrip.time:type_name -> google.protobuf.Timestamp
+	2,  // 5: proto.v1.ReturnTrip.departure_window:type_name -> proto.v1.TimeWindow
+	39, // 6: proto.v1.TimeWindow.earliest:type_name -> google.protobuf.Timestamp
+	39, // 7: proto.v1.TimeWindow.latest:type_name -> google.protobuf.Timestamp
+	39, // 8: proto.v1.TimeWindow.arrive_by:type_name -> google.protobuf.Timestamp
+	39, // 9: proto.v1.Stop.planned_time:type_name -> google.protobuf.Timestamp
+	39, // 10: proto.v1.RideRequest.time:type_name -> google.protobuf.Timestamp
+	2,  // 11: proto.v1.RideRequest.departure_window:type_name -> proto.v1.TimeWindow
+	39, // 12: proto.v1.CreateOfferRequest.time:type_name -> google.protobuf.Timestamp
+	4,  // 13: proto.v1.CreateOfferRequest.waypoints:type_name -> proto.v1.Stop
+	3,  // 14: proto.v1.CreateOfferRequest.auto_accept:type_name -> proto.v1.AutoAccept
+	2,  // 15: proto.v1.CreateOfferRequest.departure_window:type_name -> proto.v1.TimeWindow
+	1,  // 16: proto.v1.CreateOfferRequest.return_trip:type_name -> proto.v1.ReturnTrip
+	0,  // 17: proto.v1.CreateOfferResponse.offer:type_name -> proto.v1.RideOffer
+	0,  // 18: proto.v1.CreateOfferResponse.return_offer:type_name -> proto.v1.RideOffer
+	0,  // 19: proto.v1.GetOfferResponse.offer:type_name -> proto.v1.RideOffer
+	0,  // 20: proto.v1.UpdateOfferResponse.offer:type_name -> proto.v1.RideOffer
+	2,  // 21: proto.v1.ListNearbyOffersRequest.departure_window:type_name -> proto.v1.TimeWindow
+	0,  // 22: proto.v1.ListNearbyOffersResponse.offers:type_name -> proto.v1.RideOffer
+	0,  // 23: proto.v1.ListMyOffersResponse.offers:type_name -> proto.v1.RideOffer
+	4,  // 24: proto.v1.ListOfferStopsResponse.stops:type_name -> proto.v1.Stop
+	3,  // 25: proto.v1.SetAutoAcceptRequest.auto_accept:type_name -> proto.v1.AutoAccept
+	0,  // 26: proto.v1.SetAutoAcceptResponse.offer:type_name -> proto.v1.RideOffer
+	39, // 27: proto.v1.CreateRequestRequest.time:type_name -> google.protobuf.Timestamp
+	2,  // 28: proto.v1.CreateRequestRequest.departure_window:type_name -> proto.v1.TimeWindow
+	1,  // 29: proto.v1.CreateRequestRequest.return_trip:type_name -> proto.v1.ReturnTrip
+	5,  // 30: proto.v1.CreateRequestResponse.request:type_name -> proto.v1.RideRequest
+	5,  // 31: proto.v1.CreateRequestResponse.return_request:type_name -> proto.v1.RideRequest
+	5,  // 32: proto.v1.GetRequestResponse.request:type_name -> proto.v1.RideRequest
+	5,  // 33: proto.v1.UpdateRequestStatusResponse.request:type_name -> proto.v1.RideRequest
+	2,  // 34: proto.v1.ListNearbyRequestsRequest.departure_window:type_name -> proto.v1.TimeWindow
+	5,  // 35: proto.v1.ListNearbyRequestsResponse.requests:type_name -> proto.v1.RideRequest
+	5,  // 36: proto.v1.ListMyRequestsResponse.requests:type_name -> proto.v1.RideRequest
+	39, // 37: proto.v1.StatusEvent.created_at:type_name -> google.protobuf.Timestamp
+	34, // 38: proto.v1.GetOfferHistoryResponse.events:type_name -> proto.v1.StatusEvent
+	34, // 39: proto.v1.GetRequestHistoryResponse.events:type_name -> proto.v1.StatusEvent
+	6,  // 40: proto.v1.RideService.CreateOffer:input_type -> proto.v1.CreateOfferRequest
+	8,  // 41: proto.v1.RideService.GetOffer:input_type -> proto.v1.GetOfferRequest
+	10, // 42: proto.v1.RideService.UpdateOffer:input_type -> proto.v1.UpdateOfferRequest
+	12, // 43: proto.v1.RideService.DeleteOffer:input_type -> proto.v1.DeleteOfferRequest
+	14, // 44: proto.v1.RideService.ListNearbyOffers:input_type -> proto.v1.ListNearbyOffersRequest
+	16, // 45: proto.v1.RideService.ListMyOffers:input_type -> proto.v1.ListMyOffersRequest
+	18, // 46: proto.v1.RideService.ListOfferStops:input_type -> proto.v1.ListOfferStopsRequest
+	20, // 47: proto.v1.RideService.SetAutoAccept:input_type -> proto.v1.SetAutoAcceptRequest
+	22, // 48: proto.v1.RideService.CreateRequest:input_type -> proto.v1.CreateRequestRequest
+	24, // 49: proto.v1.RideService.GetRequest:input_type -> proto.v1.GetRequestRequest
+	26, // 50: proto.v1.RideService.UpdateRequestStatus:input_type -> proto.v1.UpdateRequestStatusRequest
+	28, // 51: proto.v1.RideService.DeleteRequest:input_type -> proto.v1.DeleteRequestRequest
+	30, // 52: proto.v1.RideService.ListNearbyRequests:input_type -> proto.v1.ListNearbyRequestsRequest
+	32, // 53: proto.v1.RideService.ListMyRequests:input_type -> proto.v1.ListMyRequestsRequest
+	35, // 54: proto.v1.RideService.GetOfferHistory:input_type -> proto.v1.GetOfferHistoryRequest
+	37, // 55: proto.v1.RideService.GetRequestHistory:input_type -> proto.v1.GetRequestHistoryRequest
+	7,  // 56: proto.v1.RideService.CreateOffer:output_type -> proto.v1.CreateOfferResponse
+	9,  // 57: proto.v1.RideService.GetOffer:output_type -> proto.v1.GetOfferResponse
+	11, // 58: proto.v1.RideService.UpdateOffer:output_type -> proto.v1.UpdateOfferResponse
+	13, // 59: proto.v1.RideService.DeleteOffer:output_type -> proto.v1.DeleteOfferResponse
+	15, // 60: proto.v1.RideService.ListNearbyOffers:output_type -> proto.v1.ListNearbyOffersResponse
+	17, // 61: proto.v1.RideService.ListMyOffers:output_type -> proto.v1.ListMyOffersResponse
+	19, // 62: proto.v1.RideService.ListOfferStops:output_type -> proto.v1.ListOfferStopsResponse
+	21, // 63: proto.v1.RideService.SetAutoAccept:output_type -> proto.v1.SetAutoAcceptResponse
+	23, // 64: proto.v1.RideService.CreateRequest:output_type -> proto.v1.CreateRequestResponse
+	25, // 65: proto.v1.RideService.GetRequest:output_type -> proto.v1.GetRequestResponse
+	27, // 66: proto.v1.RideService.UpdateRequestStatus:output_type -> proto.v1.UpdateRequestStatusResponse
+	29, // 67: proto.v1.RideService.DeleteRequest:output_type -> proto.v1.DeleteRequestResponse
+	31, // 68: proto.v1.RideService.ListNearbyRequests:output_type -> proto.v1.ListNearbyRequestsResponse
+	33, // 69: proto.v1.RideService.ListMyRequests:output_type -> proto.v1.ListMyRequestsResponse
+	36, // 70: proto.v1.RideService.GetOfferHistory:output_type -> proto.v1.GetOfferHistoryResponse
+	38, // 71: proto.v1.RideService.GetRequestHistory:output_type -> proto.v1.GetRequestHistoryResponse
+	56, // [56:72] is the sub-list for method output_type
+	40, // [40:56] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_ride_proto_init() }
@@ -1580,7 +2842,7 @@ func file_proto_v1_ride_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_ride_proto_rawDesc), len(file_proto_v1_ride_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
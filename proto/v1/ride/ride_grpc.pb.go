@@ -25,12 +25,16 @@ const (
 	RideService_DeleteOffer_FullMethodName         = "/proto.v1.RideService/DeleteOffer"
 	RideService_ListNearbyOffers_FullMethodName    = "/proto.v1.RideService/ListNearbyOffers"
 	RideService_ListMyOffers_FullMethodName        = "/proto.v1.RideService/ListMyOffers"
+	RideService_ListOfferStops_FullMethodName      = "/proto.v1.RideService/ListOfferStops"
+	RideService_SetAutoAccept_FullMethodName       = "/proto.v1.RideService/SetAutoAccept"
 	RideService_CreateRequest_FullMethodName       = "/proto.v1.RideService/CreateRequest"
 	RideService_GetRequest_FullMethodName          = "/proto.v1.RideService/GetRequest"
 	RideService_UpdateRequestStatus_FullMethodName = "/proto.v1.RideService/UpdateRequestStatus"
 	RideService_DeleteRequest_FullMethodName       = "/proto.v1.RideService/DeleteRequest"
 	RideService_ListNearbyRequests_FullMethodName  = "/proto.v1.RideService/ListNearbyRequests"
 	RideService_ListMyRequests_FullMethodName      = "/proto.v1.RideService/ListMyRequests"
+	RideService_GetOfferHistory_FullMethodName     = "/proto.v1.RideService/GetOfferHistory"
+	RideService_GetRequestHistory_FullMethodName   = "/proto.v1.RideService/GetRequestHistory"
 )
 
 // RideServiceClient is the client API for RideService service.
@@ -43,12 +47,16 @@ type RideServiceClient interface {
 	DeleteOffer(ctx context.Context, in *DeleteOfferRequest, opts ...grpc.CallOption) (*DeleteOfferResponse, error)
 	ListNearbyOffers(ctx context.Context, in *ListNearbyOffersRequest, opts ...grpc.CallOption) (*ListNearbyOffersResponse, error)
 	ListMyOffers(ctx context.Context, in *ListMyOffersRequest, opts ...grpc.CallOption) (*ListMyOffersResponse, error)
+	ListOfferStops(ctx context.Context, in *ListOfferStopsRequest, opts ...grpc.CallOption) (*ListOfferStopsResponse, error)
+	SetAutoAccept(ctx context.Context, in *SetAutoAcceptRequest, opts ...grpc.CallOption) (*SetAutoAcceptResponse, error)
 	CreateRequest(ctx context.Context, in *CreateRequestRequest, opts ...grpc.CallOption) (*CreateRequestResponse, error)
 	GetRequest(ctx context.Context, in *GetRequestRequest, opts ...grpc.CallOption) (*GetRequestResponse, error)
 	UpdateRequestStatus(ctx context.Context, in *UpdateRequestStatusRequest, opts ...grpc.CallOption) (*UpdateRequestStatusResponse, error)
 	DeleteRequest(ctx context.Context, in *DeleteRequestRequest, opts ...grpc.CallOption) (*DeleteRequestResponse, error)
 	ListNearbyRequests(ctx context.Context, in *ListNearbyRequestsRequest, opts ...grpc.CallOption) (*ListNearbyRequestsResponse, error)
 	ListMyRequests(ctx context.Context, in *ListMyRequestsRequest, opts ...grpc.CallOption) (*ListMyRequestsResponse, error)
+	GetOfferHistory(ctx context.Context, in *GetOfferHistoryRequest, opts ...grpc.CallOption) (*GetOfferHistoryResponse, error)
+	GetRequestHistory(ctx context.Context, in *GetRequestHistoryRequest, opts ...grpc.CallOption) (*GetRequestHistoryResponse, error)
 }
 
 type rideServiceClient struct {
@@ -119,6 +127,26 @@ func (c *rideServiceClient) ListMyOffers(ctx context.Context, in *ListMyOffersRe
 	return out, nil
 }
 
+func (c *rideServiceClient) ListOfferStops(ctx context.Context, in *ListOfferStopsRequest, opts ...grpc.CallOption) (*ListOfferStopsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOfferStopsResponse)
+	err := c.cc.Invoke(ctx, RideService_ListOfferStops_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) SetAutoAccept(ctx context.Context, in *SetAutoAcceptRequest, opts ...grpc.CallOption) (*SetAutoAcceptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAutoAcceptResponse)
+	err := c.cc.Invoke(ctx, RideService_SetAutoAccept_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rideServiceClient) CreateRequest(ctx context.Context, in *CreateRequestRequest, opts ...grpc.CallOption) (*CreateRequestResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateRequestResponse)
@@ -179,6 +207,26 @@ func (c *rideServiceClient) ListMyRequests(ctx context.Context, in *ListMyReques
 	return out, nil
 }
 
+func (c *rideServiceClient) GetOfferHistory(ctx context.Context, in *GetOfferHistoryRequest, opts ...grpc.CallOption) (*GetOfferHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOfferHistoryResponse)
+	err := c.cc.Invoke(ctx, RideService_GetOfferHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rideServiceClient) GetRequestHistory(ctx context.Context, in *GetRequestHistoryRequest, opts ...grpc.CallOption) (*GetRequestHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRequestHistoryResponse)
+	err := c.cc.Invoke(ctx, RideService_GetRequestHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RideServiceServer is the server API for RideService service.
 // All implementations must embed UnimplementedRideServiceServer
 // for forward compatibility.
@@ -189,12 +237,16 @@ type RideServiceServer interface {
 	DeleteOffer(context.Context, *DeleteOfferRequest) (*DeleteOfferResponse, error)
 	ListNearbyOffers(context.Context, *ListNearbyOffersRequest) (*ListNearbyOffersResponse, error)
 	ListMyOffers(context.Context, *ListMyOffersRequest) (*ListMyOffersResponse, error)
+	ListOfferStops(context.Context, *ListOfferStopsRequest) (*ListOfferStopsResponse, error)
+	SetAutoAccept(context.Context, *SetAutoAcceptRequest) (*SetAutoAcceptResponse, error)
 	CreateRequest(context.Context, *CreateRequestRequest) (*CreateRequestResponse, error)
 	GetRequest(context.Context, *GetRequestRequest) (*GetRequestResponse, error)
 	UpdateRequestStatus(context.Context, *UpdateRequestStatusRequest) (*UpdateRequestStatusResponse, error)
 	DeleteRequest(context.Context, *DeleteRequestRequest) (*DeleteRequestResponse, error)
 	ListNearbyRequests(context.Context, *ListNearbyRequestsRequest) (*ListNearbyRequestsResponse, error)
 	ListMyRequests(context.Context, *ListMyRequestsRequest) (*ListMyRequestsResponse, error)
+	GetOfferHistory(context.Context, *GetOfferHistoryRequest) (*GetOfferHistoryResponse, error)
+	GetRequestHistory(context.Context, *GetRequestHistoryRequest) (*GetRequestHistoryResponse, error)
 	mustEmbedUnimplementedRideServiceServer()
 }
 
@@ -223,6 +275,12 @@ func (UnimplementedRideServiceServer) ListNearbyOffers(context.Context, *ListNea
 func (UnimplementedRideServiceServer) ListMyOffers(context.Context, *ListMyOffersRequest) (*ListMyOffersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMyOffers not implemented")
 }
+func (UnimplementedRideServiceServer) ListOfferStops(context.Context, *ListOfferStopsRequest) (*ListOfferStopsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOfferStops not implemented")
+}
+func (UnimplementedRideServiceServer) SetAutoAccept(context.Context, *SetAutoAcceptRequest) (*SetAutoAcceptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoAccept not implemented")
+}
 func (UnimplementedRideServiceServer) CreateRequest(context.Context, *CreateRequestRequest) (*CreateRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRequest not implemented")
 }
@@ -241,6 +299,12 @@ func (UnimplementedRideServiceServer) ListNearbyRequests(context.Context, *ListN
 func (UnimplementedRideServiceServer) ListMyRequests(context.Context, *ListMyRequestsRequest) (*ListMyRequestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMyRequests not implemented")
 }
+func (UnimplementedRideServiceServer) GetOfferHistory(context.Context, *GetOfferHistoryRequest) (*GetOfferHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOfferHistory not implemented")
+}
+func (UnimplementedRideServiceServer) GetRequestHistory(context.Context, *GetRequestHistoryRequest) (*GetRequestHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRequestHistory not implemented")
+}
 func (UnimplementedRideServiceServer) mustEmbedUnimplementedRideServiceServer() {}
 func (UnimplementedRideServiceServer) testEmbeddedByValue()                     {}
 
@@ -370,6 +434,42 @@ func _RideService_ListMyOffers_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RideService_ListOfferStops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOfferStopsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).ListOfferStops(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_ListOfferStops_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).ListOfferStops(ctx, req.(*ListOfferStopsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_SetAutoAccept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAutoAcceptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).SetAutoAccept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_SetAutoAccept_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).SetAutoAccept(ctx, req.(*SetAutoAcceptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RideService_CreateRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateRequestRequest)
 	if err := dec(in); err != nil {
@@ -478,6 +578,42 @@ func _RideService_ListMyRequests_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RideService_GetOfferHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOfferHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).GetOfferHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_GetOfferHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).GetOfferHistory(ctx, req.(*GetOfferHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RideService_GetRequestHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequestHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RideServiceServer).GetRequestHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RideService_GetRequestHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RideServiceServer).GetRequestHistory(ctx, req.(*GetRequestHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RideService_ServiceDesc is the grpc.ServiceDesc for RideService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -509,6 +645,14 @@ var RideService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMyOffers",
 			Handler:    _RideService_ListMyOffers_Handler,
 		},
+		{
+			MethodName: "ListOfferStops",
+			Handler:    _RideService_ListOfferStops_Handler,
+		},
+		{
+			MethodName: "SetAutoAccept",
+			Handler:    _RideService_SetAutoAccept_Handler,
+		},
 		{
 			MethodName: "CreateRequest",
 			Handler:    _RideService_CreateRequest_Handler,
@@ -533,6 +677,14 @@ var RideService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMyRequests",
 			Handler:    _RideService_ListMyRequests_Handler,
 		},
+		{
+			MethodName: "GetOfferHistory",
+			Handler:    _RideService_GetOfferHistory_Handler,
+		},
+		{
+			MethodName: "GetRequestHistory",
+			Handler:    _RideService_GetRequestHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/v1/ride.proto",
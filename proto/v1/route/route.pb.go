@@ -0,0 +1,577 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: proto/v1/route.proto
+
+package route
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LatLng struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatLng) Reset() {
+	*x = LatLng{}
+	mi := &file_proto_v1_route_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatLng) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatLng) ProtoMessage() {}
+
+func (x *LatLng) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_route_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatLng.ProtoReflect.Descriptor instead.
+func (*LatLng) Descriptor() ([]byte, []int) {
+	return file_proto_v1_route_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LatLng) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *LatLng) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type Route struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Meters        float64                `protobuf:"fixed64,1,opt,name=meters,proto3" json:"meters,omitempty"`
+	Seconds       float64                `protobuf:"fixed64,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	Path          []*LatLng              `protobuf:"bytes,3,rep,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Route) Reset() {
+	*x = Route{}
+	mi := &file_proto_v1_route_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Route) ProtoMessage() {}
+
+func (x *Route) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_route_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Route.ProtoReflect.Descriptor instead.
+func (*Route) Descriptor() ([]byte, []int) {
+	return file_proto_v1_route_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Route) GetMeters() float64 {
+	if x != nil {
+		return x.Meters
+	}
+	return 0
+}
+
+func (x *Route) GetSeconds() float64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *Route) GetPath() []*LatLng {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+type GetRouteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromGeo       string                 `protobuf:"bytes,1,opt,name=from_geo,json=fromGeo,proto3" json:"from_geo,omitempty"`
+	ToGeo         string                 `protobuf:"bytes,2,opt,name=to_geo,json=toGeo,proto3" json:"to_geo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRouteRequest) Reset() {
+	*x = GetRouteRequest{}
+	mi := &file_proto_v1_route_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRouteRequest) ProtoMessage() {}
+
+func (x *GetRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_route_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRouteRequest.ProtoReflect.Descriptor instead.
+func (*GetRouteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_route_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetRouteRequest) GetFromGeo() string {
+	if x != nil {
+		return x.FromGeo
+	}
+	return ""
+}
+
+func (x *GetRouteRequest) GetToGeo() string {
+	if x != nil {
+		return x.ToGeo
+	}
+	return ""
+}
+
+type GetRouteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Route         *Route                 `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRouteResponse) Reset() {
+	*x = GetRouteResponse{}
+	mi := &file_proto_v1_route_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRouteResponse) ProtoMessage() {}
+
+func (x *GetRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_route_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRouteResponse.ProtoReflect.Descriptor instead.
+func (*GetRouteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_route_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRouteResponse) GetRoute() *Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+type EstimateDetourRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OfferId string                 `protobuf:"bytes,1,opt,name=offer_id,json=offerId,proto3" json:"offer_id,omitempty"`
+	// default to the offer's from_geo / to_geo
+	PickupGeo     string `protobuf:"bytes,2,opt,name=pickup_geo,json=pickupGeo,proto3" json:"pickup_geo,omitempty"`
+	DropoffGeo    string `protobuf:"bytes,3,opt,name=dropoff_geo,json=dropoffGeo,proto3" json:"dropoff_geo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EstimateDetourRequest) Reset() {
+	*x = EstimateDetourRequest{}
+	mi := &file_proto_v1_route_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimateDetourRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateDetourRequest) ProtoMessage() {}
+
+func (x *EstimateDetourRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_route_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateDetourRequest.ProtoReflect.Descriptor instead.
+func (*EstimateDetourRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_route_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EstimateDetourRequest) GetOfferId() string {
+	if x != nil {
+		return x.OfferId
+	}
+	return ""
+}
+
+func (x *EstimateDetourRequest) GetPickupGeo() string {
+	if x != nil {
+		return x.PickupGeo
+	}
+	return ""
+}
+
+func (x *EstimateDetourRequest) GetDropoffGeo() string {
+	if x != nil {
+		return x.DropoffGeo
+	}
+	return ""
+}
+
+type EstimateDetourResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExtraMeters   float64                `protobuf:"fixed64,1,opt,name=extra_meters,json=extraMeters,proto3" json:"extra_meters,omitempty"`
+	ExtraSeconds  float64                `protobuf:"fixed64,2,opt,name=extra_seconds,json=extraSeconds,proto3" json:"extra_seconds,omitempty"`
+	Direct        *Route                 `protobuf:"bytes,3,opt,name=direct,proto3" json:"direct,omitempty"`
+	WithRider     *Route                 `protobuf:"bytes,4,opt,name=with_rider,json=withRider,proto3" json:"with_rider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EstimateDetourResponse) Reset() {
+	*x = EstimateDetourResponse{}
+	mi := &file_proto_v1_route_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimateDetourResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateDetourResponse) ProtoMessage() {}
+
+func (x *EstimateDetourResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_route_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateDetourResponse.ProtoReflect.Descriptor instead.
+func (*EstimateDetourResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_route_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EstimateDetourResponse) GetExtraMeters() float64 {
+	if x != nil {
+		return x.ExtraMeters
+	}
+	return 0
+}
+
+func (x *EstimateDetourResponse) GetExtraSeconds() float64 {
+	if x != nil {
+		return x.ExtraSeconds
+	}
+	return 0
+}
+
+func (x *EstimateDetourResponse) GetDirect() *Route {
+	if x != nil {
+		return x.Direct
+	}
+	return nil
+}
+
+func (x *EstimateDetourResponse) GetWithRider() *Route {
+	if x != nil {
+		return x.WithRider
+	}
+	return nil
+}
+
+type EstimateFareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromGeo       string                 `protobuf:"bytes,1,opt,name=from_geo,json=fromGeo,proto3" json:"from_geo,omitempty"`
+	ToGeo         string                 `protobuf:"bytes,2,opt,name=to_geo,json=toGeo,proto3" json:"to_geo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EstimateFareRequest) Reset() {
+	*x = EstimateFareRequest{}
+	mi := &file_proto_v1_route_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimateFareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateFareRequest) ProtoMessage() {}
+
+func (x *EstimateFareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_route_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateFareRequest.ProtoReflect.Descriptor instead.
+func (*EstimateFareRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_route_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EstimateFareRequest) GetFromGeo() string {
+	if x != nil {
+		return x.FromGeo
+	}
+	return ""
+}
+
+func (x *EstimateFareRequest) GetToGeo() string {
+	if x != nil {
+		return x.ToGeo
+	}
+	return ""
+}
+
+type EstimateFareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fare          float64                `protobuf:"fixed64,1,opt,name=fare,proto3" json:"fare,omitempty"`
+	Meters        float64                `protobuf:"fixed64,2,opt,name=meters,proto3" json:"meters,omitempty"`
+	Seconds       float64                `protobuf:"fixed64,3,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EstimateFareResponse) Reset() {
+	*x = EstimateFareResponse{}
+	mi := &file_proto_v1_route_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EstimateFareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateFareResponse) ProtoMessage() {}
+
+func (x *EstimateFareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_route_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateFareResponse.ProtoReflect.Descriptor instead.
+func (*EstimateFareResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_route_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EstimateFareResponse) GetFare() float64 {
+	if x != nil {
+		return x.Fare
+	}
+	return 0
+}
+
+func (x *EstimateFareResponse) GetMeters() float64 {
+	if x != nil {
+		return x.Meters
+	}
+	return 0
+}
+
+func (x *EstimateFareResponse) GetSeconds() float64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+var File_proto_v1_route_proto protoreflect.FileDescriptor
+
+const file_proto_v1_route_proto_rawDesc = "" +
+	"\n" +
+	"\x14proto/v1/route.proto\x12\bproto.v1\"B\n" +
+	"\x06LatLng\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"_\n" +
+	"\x05Route\x12\x16\n" +
+	"\x06meters\x18\x01 \x01(\x01R\x06meters\x12\x18\n" +
+	"\aseconds\x18\x02 \x01(\x01R\aseconds\x12$\n" +
+	"\x04path\x18\x03 \x03(\v2\x10.proto.v1.LatLngR\x04path\"C\n" +
+	"\x0fGetRouteRequest\x12\x19\n" +
+	"\bfrom_geo\x18\x01 \x01(\tR\afromGeo\x12\x15\n" +
+	"\x06to_geo\x18\x02 \x01(\tR\x05toGeo\"9\n" +
+	"\x10GetRouteResponse\x12%\n" +
+	"\x05route\x18\x01 \x01(\v2\x0f.proto.v1.RouteR\x05route\"r\n" +
+	"\x15EstimateDetourRequest\x12\x19\n" +
+	"\boffer_id\x18\x01 \x01(\tR\aofferId\x12\x1d\n" +
+	"\n" +
+	"pickup_geo\x18\x02 \x01(\tR\tpickupGeo\x12\x1f\n" +
+	"\vdropoff_geo\x18\x03 \x01(\tR\n" +
+	"dropoffGeo\"\xb9\x01\n" +
+	"\x16EstimateDetourResponse\x12!\n" +
+	"\fextra_meters\x18\x01 \x01(\x01R\vextraMeters\x12#\n" +
+	"\rextra_seconds\x18\x02 \x01(\x01R\fextraSeconds\x12'\n" +
+	"\x06direct\x18\x03 \x01(\v2\x0f.proto.v1.RouteR\x06direct\x12.\n" +
+	"\n" +
+	"with_rider\x18\x04 \x01(\v2\x0f.proto.v1.RouteR\twithRider\"G\n" +
+	"\x13EstimateFareRequest\x12\x19\n" +
+	"\bfrom_geo\x18\x01 \x01(\tR\afromGeo\x12\x15\n" +
+	"\x06to_geo\x18\x02 \x01(\tR\x05toGeo\"\\\n" +
+	"\x14EstimateFareResponse\x12\x12\n" +
+	"\x04fare\x18\x01 \x01(\x01R\x04fare\x12\x16\n" +
+	"\x06meters\x18\x02 \x01(\x01R\x06meters\x12\x18\n" +
+	"\aseconds\x18\x03 \x01(\x01R\aseconds2\xfb\x01\n" +
+	"\fRouteService\x12C\n" +
+	"\bGetRoute\x12\x19.proto.v1.GetRouteRequest\x1a\x1a.proto.v1.GetRouteResponse\"\x00\x12U\n" +
+	"\x0eEstimateDetour\x12\x1f.proto.v1.EstimateDetourRequest\x1a .proto.v1.EstimateDetourResponse\"\x00\x12O\n" +
+	"\fEstimateFare\x12\x1d.proto.v1.EstimateFareRequest\x1a\x1e.proto.v1.EstimateFareResponse\"\x00B\x12Z\x10./proto/v1/routeb\x06proto3"
+
+var (
+	file_proto_v1_route_proto_rawDescOnce sync.Once
+	file_proto_v1_route_proto_rawDescData []byte
+)
+
+func file_proto_v1_route_proto_rawDescGZIP() []byte {
+	file_proto_v1_route_proto_rawDescOnce.Do(func() {
+		file_proto_v1_route_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v1_route_proto_rawDesc), len(file_proto_v1_route_proto_rawDesc)))
+	})
+	return file_proto_v1_route_proto_rawDescData
+}
+
+var file_proto_v1_route_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_v1_route_proto_goTypes = []any{
+	(*LatLng)(nil),                 // 0: proto.v1.LatLng
+	(*Route)(nil),                  // 1: proto.v1.Route
+	(*GetRouteRequest)(nil),        // 2: proto.v1.GetRouteRequest
+	(*GetRouteResponse)(nil),       // 3: proto.v1.GetRouteResponse
+	(*EstimateDetourRequest)(nil),  // 4: proto.v1.EstimateDetourRequest
+	(*EstimateDetourResponse)(nil), // 5: proto.v1.EstimateDetourResponse
+	(*EstimateFareRequest)(nil),    // 6: proto.v1.EstimateFareRequest
+	(*EstimateFareResponse)(nil),   // 7: proto.v1.EstimateFareResponse
+}
+var file_proto_v1_route_proto_depIdxs = []int32{
+	0, // 0: proto.v1.Route.path:type_name -> proto.v1.LatLng
+	1, // 1: proto.v1.GetRouteResponse.route:type_name -> proto.v1.Route
+	1, // 2: proto.v1.EstimateDetourResponse.direct:type_name -> proto.v1.Route
+	1, // 3: proto.v1.EstimateDetourResponse.with_rider:type_name -> proto.v1.Route
+	2, // 4: proto.v1.RouteService.GetRoute:input_type -> proto.v1.GetRouteRequest
+	4, // 5: proto.v1.RouteService.EstimateDetour:input_type -> proto.v1.EstimateDetourRequest
+	6, // 6: proto.v1.RouteService.EstimateFare:input_type -> proto.v1.EstimateFareRequest
+	3, // 7: proto.v1.RouteService.GetRoute:output_type -> proto.v1.GetRouteResponse
+	5, // 8: proto.v1.RouteService.EstimateDetour:output_type -> proto.v1.EstimateDetourResponse
+	7, // 9: proto.v1.RouteService.EstimateFare:output_type -> proto.v1.EstimateFareResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_route_proto_init() }
+func file_proto_v1_route_proto_init() {
+	if File_proto_v1_route_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_route_proto_rawDesc), len(file_proto_v1_route_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_route_proto_goTypes,
+		DependencyIndexes: file_proto_v1_route_proto_depIdxs,
+		MessageInfos:      file_proto_v1_route_proto_msgTypes,
+	}.Build()
+	File_proto_v1_route_proto = out.File
+	file_proto_v1_route_proto_goTypes = nil
+	file_proto_v1_route_proto_depIdxs = nil
+}
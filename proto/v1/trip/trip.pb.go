@@ -0,0 +1,1631 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: proto/v1/trip.proto
+
+package trip
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PickupETA struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Seconds        int64                  `protobuf:"varint,1,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	DistanceMeters float64                `protobuf:"fixed64,2,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`
+	SpeedKmh       float64                `protobuf:"fixed64,3,opt,name=speed_kmh,json=speedKmh,proto3" json:"speed_kmh,omitempty"`
+	// true when the speed comes from the driver's recent track, false for
+	// the configured default
+	Observed bool `protobuf:"varint,4,opt,name=observed,proto3" json:"observed,omitempty"`
+	// when the driver position behind this estimate was taken
+	LocationAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=location_at,json=locationAt,proto3" json:"location_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupETA) Reset() {
+	*x = PickupETA{}
+	mi := &file_proto_v1_trip_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupETA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupETA) ProtoMessage() {}
+
+func (x *PickupETA) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupETA.ProtoReflect.Descriptor instead.
+func (*PickupETA) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PickupETA) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *PickupETA) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+func (x *PickupETA) GetSpeedKmh() float64 {
+	if x != nil {
+		return x.SpeedKmh
+	}
+	return 0
+}
+
+func (x *PickupETA) GetObserved() bool {
+	if x != nil {
+		return x.Observed
+	}
+	return false
+}
+
+func (x *PickupETA) GetLocationAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LocationAt
+	}
+	return nil
+}
+
+type TripJoin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripJoin) Reset() {
+	*x = TripJoin{}
+	mi := &file_proto_v1_trip_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripJoin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripJoin) ProtoMessage() {}
+
+func (x *TripJoin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripJoin.ProtoReflect.Descriptor instead.
+func (*TripJoin) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TripJoin) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type TripPosition struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Latitude  float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// computed from latitude/longitude when empty
+	Geohash       string `protobuf:"bytes,3,opt,name=geohash,proto3" json:"geohash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripPosition) Reset() {
+	*x = TripPosition{}
+	mi := &file_proto_v1_trip_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripPosition) ProtoMessage() {}
+
+func (x *TripPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripPosition.ProtoReflect.Descriptor instead.
+func (*TripPosition) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TripPosition) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *TripPosition) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *TripPosition) GetGeohash() string {
+	if x != nil {
+		return x.Geohash
+	}
+	return ""
+}
+
+type TripLocationUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Update:
+	//
+	//	*TripLocationUpdate_Join
+	//	*TripLocationUpdate_Position
+	Update        isTripLocationUpdate_Update `protobuf_oneof:"update"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripLocationUpdate) Reset() {
+	*x = TripLocationUpdate{}
+	mi := &file_proto_v1_trip_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripLocationUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripLocationUpdate) ProtoMessage() {}
+
+func (x *TripLocationUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripLocationUpdate.ProtoReflect.Descriptor instead.
+func (*TripLocationUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TripLocationUpdate) GetUpdate() isTripLocationUpdate_Update {
+	if x != nil {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *TripLocationUpdate) GetJoin() *TripJoin {
+	if x != nil {
+		if x, ok := x.Update.(*TripLocationUpdate_Join); ok {
+			return x.Join
+		}
+	}
+	return nil
+}
+
+func (x *TripLocationUpdate) GetPosition() *TripPosition {
+	if x != nil {
+		if x, ok := x.Update.(*TripLocationUpdate_Position); ok {
+			return x.Position
+		}
+	}
+	return nil
+}
+
+type isTripLocationUpdate_Update interface {
+	isTripLocationUpdate_Update()
+}
+
+type TripLocationUpdate_Join struct {
+	Join *TripJoin `protobuf:"bytes,1,opt,name=join,proto3,oneof"`
+}
+
+type TripLocationUpdate_Position struct {
+	Position *TripPosition `protobuf:"bytes,2,opt,name=position,proto3,oneof"`
+}
+
+func (*TripLocationUpdate_Join) isTripLocationUpdate_Update() {}
+
+func (*TripLocationUpdate_Position) isTripLocationUpdate_Update() {}
+
+type TripLocationEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Driver    bool                   `protobuf:"varint,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	Latitude  float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Geohash   string                 `protobuf:"bytes,5,opt,name=geohash,proto3" json:"geohash,omitempty"`
+	SpeedMps  float64                `protobuf:"fixed64,6,opt,name=speed_mps,json=speedMps,proto3" json:"speed_mps,omitempty"`
+	At        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=at,proto3" json:"at,omitempty"`
+	Ended     bool                   `protobuf:"varint,8,opt,name=ended,proto3" json:"ended,omitempty"`
+	// set on driver positions sent to riders
+	Eta           *PickupETA `protobuf:"bytes,9,opt,name=eta,proto3" json:"eta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripLocationEvent) Reset() {
+	*x = TripLocationEvent{}
+	mi := &file_proto_v1_trip_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripLocationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripLocationEvent) ProtoMessage() {}
+
+func (x *TripLocationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripLocationEvent.ProtoReflect.Descriptor instead.
+func (*TripLocationEvent) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TripLocationEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TripLocationEvent) GetDriver() bool {
+	if x != nil {
+		return x.Driver
+	}
+	return false
+}
+
+func (x *TripLocationEvent) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *TripLocationEvent) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *TripLocationEvent) GetGeohash() string {
+	if x != nil {
+		return x.Geohash
+	}
+	return ""
+}
+
+func (x *TripLocationEvent) GetSpeedMps() float64 {
+	if x != nil {
+		return x.SpeedMps
+	}
+	return 0
+}
+
+func (x *TripLocationEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *TripLocationEvent) GetEnded() bool {
+	if x != nil {
+		return x.Ended
+	}
+	return false
+}
+
+func (x *TripLocationEvent) GetEta() *PickupETA {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+type ExportTripRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MatchId string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// gpx (default) or geojson
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTripRequest) Reset() {
+	*x = ExportTripRequest{}
+	mi := &file_proto_v1_trip_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTripRequest) ProtoMessage() {}
+
+func (x *ExportTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTripRequest.ProtoReflect.Descriptor instead.
+func (*ExportTripRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExportTripRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *ExportTripRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportTripResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTripResponse) Reset() {
+	*x = ExportTripResponse{}
+	mi := &file_proto_v1_trip_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTripResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTripResponse) ProtoMessage() {}
+
+func (x *ExportTripResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTripResponse.ProtoReflect.Descriptor instead.
+func (*ExportTripResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ExportTripResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportTripResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportTripResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetPickupETARequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPickupETARequest) Reset() {
+	*x = GetPickupETARequest{}
+	mi := &file_proto_v1_trip_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPickupETARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPickupETARequest) ProtoMessage() {}
+
+func (x *GetPickupETARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPickupETARequest.ProtoReflect.Descriptor instead.
+func (*GetPickupETARequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPickupETARequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type GetPickupETAResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Eta           *PickupETA             `protobuf:"bytes,1,opt,name=eta,proto3" json:"eta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPickupETAResponse) Reset() {
+	*x = GetPickupETAResponse{}
+	mi := &file_proto_v1_trip_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPickupETAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPickupETAResponse) ProtoMessage() {}
+
+func (x *GetPickupETAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPickupETAResponse.ProtoReflect.Descriptor instead.
+func (*GetPickupETAResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetPickupETAResponse) GetEta() *PickupETA {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+type ManifestStop struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Seq   int32                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// origin, waypoint, pickup, dropoff or destination
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// pickups and dropoffs
+	MatchId string `protobuf:"bytes,3,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// the offer's stop seq for origin, waypoint and destination
+	StopSeq  int32                  `protobuf:"varint,4,opt,name=stop_seq,json=stopSeq,proto3" json:"stop_seq,omitempty"`
+	Geohash  string                 `protobuf:"bytes,5,opt,name=geohash,proto3" json:"geohash,omitempty"`
+	ArriveAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=arrive_at,json=arriveAt,proto3" json:"arrive_at,omitempty"`
+	// later than arrive_at when the driver waits for a planned time
+	DepartAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=depart_at,json=departAt,proto3" json:"depart_at,omitempty"`
+	// how far past its promised time the stop is reached
+	LateSeconds int32 `protobuf:"varint,8,opt,name=late_seconds,json=lateSeconds,proto3" json:"late_seconds,omitempty"`
+	// the leg from the previous stop
+	LegMeters  float64 `protobuf:"fixed64,9,opt,name=leg_meters,json=legMeters,proto3" json:"leg_meters,omitempty"`
+	LegSeconds int32   `protobuf:"varint,10,opt,name=leg_seconds,json=legSeconds,proto3" json:"leg_seconds,omitempty"`
+	// seats taken when leaving
+	Onboard       int32 `protobuf:"varint,11,opt,name=onboard,proto3" json:"onboard,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManifestStop) Reset() {
+	*x = ManifestStop{}
+	mi := &file_proto_v1_trip_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManifestStop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestStop) ProtoMessage() {}
+
+func (x *ManifestStop) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestStop.ProtoReflect.Descriptor instead.
+func (*ManifestStop) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ManifestStop) GetSeq() int32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ManifestStop) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ManifestStop) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *ManifestStop) GetStopSeq() int32 {
+	if x != nil {
+		return x.StopSeq
+	}
+	return 0
+}
+
+func (x *ManifestStop) GetGeohash() string {
+	if x != nil {
+		return x.Geohash
+	}
+	return ""
+}
+
+func (x *ManifestStop) GetArriveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArriveAt
+	}
+	return nil
+}
+
+func (x *ManifestStop) GetDepartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DepartAt
+	}
+	return nil
+}
+
+func (x *ManifestStop) GetLateSeconds() int32 {
+	if x != nil {
+		return x.LateSeconds
+	}
+	return 0
+}
+
+func (x *ManifestStop) GetLegMeters() float64 {
+	if x != nil {
+		return x.LegMeters
+	}
+	return 0
+}
+
+func (x *ManifestStop) GetLegSeconds() int32 {
+	if x != nil {
+		return x.LegSeconds
+	}
+	return 0
+}
+
+func (x *ManifestStop) GetOnboard() int32 {
+	if x != nil {
+		return x.Onboard
+	}
+	return 0
+}
+
+type TripManifest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RideId  string                 `protobuf:"bytes,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	Stops   []*ManifestStop        `protobuf:"bytes,2,rep,name=stops,proto3" json:"stops,omitempty"`
+	Meters  float64                `protobuf:"fixed64,3,opt,name=meters,proto3" json:"meters,omitempty"`
+	Seconds int32                  `protobuf:"varint,4,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	// what the riders add to the driver's own route
+	DetourMeters  float64 `protobuf:"fixed64,5,opt,name=detour_meters,json=detourMeters,proto3" json:"detour_meters,omitempty"`
+	DetourSeconds int32   `protobuf:"varint,6,opt,name=detour_seconds,json=detourSeconds,proto3" json:"detour_seconds,omitempty"`
+	LateSeconds   int32   `protobuf:"varint,7,opt,name=late_seconds,json=lateSeconds,proto3" json:"late_seconds,omitempty"`
+	// false when the order is the best found in the search budget rather
+	// than the best there is
+	Optimal       bool                   `protobuf:"varint,8,opt,name=optimal,proto3" json:"optimal,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripManifest) Reset() {
+	*x = TripManifest{}
+	mi := &file_proto_v1_trip_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripManifest) ProtoMessage() {}
+
+func (x *TripManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripManifest.ProtoReflect.Descriptor instead.
+func (*TripManifest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TripManifest) GetRideId() string {
+	if x != nil {
+		return x.RideId
+	}
+	return ""
+}
+
+func (x *TripManifest) GetStops() []*ManifestStop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *TripManifest) GetMeters() float64 {
+	if x != nil {
+		return x.Meters
+	}
+	return 0
+}
+
+func (x *TripManifest) GetSeconds() int32 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *TripManifest) GetDetourMeters() float64 {
+	if x != nil {
+		return x.DetourMeters
+	}
+	return 0
+}
+
+func (x *TripManifest) GetDetourSeconds() int32 {
+	if x != nil {
+		return x.DetourSeconds
+	}
+	return 0
+}
+
+func (x *TripManifest) GetLateSeconds() int32 {
+	if x != nil {
+		return x.LateSeconds
+	}
+	return 0
+}
+
+func (x *TripManifest) GetOptimal() bool {
+	if x != nil {
+		return x.Optimal
+	}
+	return false
+}
+
+func (x *TripManifest) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetTripManifestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RideId        string                 `protobuf:"bytes,1,opt,name=ride_id,json=rideId,proto3" json:"ride_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTripManifestRequest) Reset() {
+	*x = GetTripManifestRequest{}
+	mi := &file_proto_v1_trip_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTripManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripManifestRequest) ProtoMessage() {}
+
+func (x *GetTripManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripManifestRequest.ProtoReflect.Descriptor instead.
+func (*GetTripManifestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTripManifestRequest) GetRideId() string {
+	if x != nil {
+		return x.RideId
+	}
+	return ""
+}
+
+type GetTripManifestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifest      *TripManifest          `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	Trip          *Trip                  `protobuf:"bytes,2,opt,name=trip,proto3" json:"trip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTripManifestResponse) Reset() {
+	*x = GetTripManifestResponse{}
+	mi := &file_proto_v1_trip_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTripManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripManifestResponse) ProtoMessage() {}
+
+func (x *GetTripManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripManifestResponse.ProtoReflect.Descriptor instead.
+func (*GetTripManifestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetTripManifestResponse) GetManifest() *TripManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *GetTripManifestResponse) GetTrip() *Trip {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+type TripOffer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DriverId      string                 `protobuf:"bytes,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	OrgId         string                 `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	FromGeo       string                 `protobuf:"bytes,4,opt,name=from_geo,json=fromGeo,proto3" json:"from_geo,omitempty"`
+	ToGeo         string                 `protobuf:"bytes,5,opt,name=to_geo,json=toGeo,proto3" json:"to_geo,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	Seats         int32                  `protobuf:"varint,7,opt,name=seats,proto3" json:"seats,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Fare          float64                `protobuf:"fixed64,9,opt,name=fare,proto3" json:"fare,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripOffer) Reset() {
+	*x = TripOffer{}
+	mi := &file_proto_v1_trip_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripOffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripOffer) ProtoMessage() {}
+
+func (x *TripOffer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripOffer.ProtoReflect.Descriptor instead.
+func (*TripOffer) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TripOffer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TripOffer) GetDriverId() string {
+	if x != nil {
+		return x.DriverId
+	}
+	return ""
+}
+
+func (x *TripOffer) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *TripOffer) GetFromGeo() string {
+	if x != nil {
+		return x.FromGeo
+	}
+	return ""
+}
+
+func (x *TripOffer) GetToGeo() string {
+	if x != nil {
+		return x.ToGeo
+	}
+	return ""
+}
+
+func (x *TripOffer) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *TripOffer) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *TripOffer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TripOffer) GetFare() float64 {
+	if x != nil {
+		return x.Fare
+	}
+	return 0
+}
+
+// a match on the trip plus the rider's profile. Riders looking at their
+// own trip get the other riders without match id or locations
+type TripRider struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	RiderId       string                 `protobuf:"bytes,2,opt,name=rider_id,json=riderId,proto3" json:"rider_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	PhotoUrl      string                 `protobuf:"bytes,4,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"`
+	Rating        float64                `protobuf:"fixed64,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	ReviewCount   int32                  `protobuf:"varint,6,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	PickupGeo     string                 `protobuf:"bytes,8,opt,name=pickup_geo,json=pickupGeo,proto3" json:"pickup_geo,omitempty"`
+	DropoffGeo    string                 `protobuf:"bytes,9,opt,name=dropoff_geo,json=dropoffGeo,proto3" json:"dropoff_geo,omitempty"`
+	PickupStop    int32                  `protobuf:"varint,10,opt,name=pickup_stop,json=pickupStop,proto3" json:"pickup_stop,omitempty"`
+	DropoffStop   int32                  `protobuf:"varint,11,opt,name=dropoff_stop,json=dropoffStop,proto3" json:"dropoff_stop,omitempty"`
+	Seats         int32                  `protobuf:"varint,12,opt,name=seats,proto3" json:"seats,omitempty"`
+	DetourSeconds int32                  `protobuf:"varint,13,opt,name=detour_seconds,json=detourSeconds,proto3" json:"detour_seconds,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripRider) Reset() {
+	*x = TripRider{}
+	mi := &file_proto_v1_trip_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripRider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripRider) ProtoMessage() {}
+
+func (x *TripRider) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripRider.ProtoReflect.Descriptor instead.
+func (*TripRider) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TripRider) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *TripRider) GetRiderId() string {
+	if x != nil {
+		return x.RiderId
+	}
+	return ""
+}
+
+func (x *TripRider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TripRider) GetPhotoUrl() string {
+	if x != nil {
+		return x.PhotoUrl
+	}
+	return ""
+}
+
+func (x *TripRider) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *TripRider) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *TripRider) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TripRider) GetPickupGeo() string {
+	if x != nil {
+		return x.PickupGeo
+	}
+	return ""
+}
+
+func (x *TripRider) GetDropoffGeo() string {
+	if x != nil {
+		return x.DropoffGeo
+	}
+	return ""
+}
+
+func (x *TripRider) GetPickupStop() int32 {
+	if x != nil {
+		return x.PickupStop
+	}
+	return 0
+}
+
+func (x *TripRider) GetDropoffStop() int32 {
+	if x != nil {
+		return x.DropoffStop
+	}
+	return 0
+}
+
+func (x *TripRider) GetSeats() int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return 0
+}
+
+func (x *TripRider) GetDetourSeconds() int32 {
+	if x != nil {
+		return x.DetourSeconds
+	}
+	return 0
+}
+
+func (x *TripRider) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type TripGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Riders        []*TripRider           `protobuf:"bytes,2,rep,name=riders,proto3" json:"riders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripGroup) Reset() {
+	*x = TripGroup{}
+	mi := &file_proto_v1_trip_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripGroup) ProtoMessage() {}
+
+func (x *TripGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripGroup.ProtoReflect.Descriptor instead.
+func (*TripGroup) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TripGroup) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TripGroup) GetRiders() []*TripRider {
+	if x != nil {
+		return x.Riders
+	}
+	return nil
+}
+
+type Trip struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Offer *TripOffer             `protobuf:"bytes,1,opt,name=offer,proto3" json:"offer,omitempty"`
+	// matches by status, accepted first
+	Groups []*TripGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	// most seats taken on any leg of the route
+	SeatsUsed     int32 `protobuf:"varint,3,opt,name=seats_used,json=seatsUsed,proto3" json:"seats_used,omitempty"`
+	SeatsFree     int32 `protobuf:"varint,4,opt,name=seats_free,json=seatsFree,proto3" json:"seats_free,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Trip) Reset() {
+	*x = Trip{}
+	mi := &file_proto_v1_trip_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Trip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trip) ProtoMessage() {}
+
+func (x *Trip) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trip.ProtoReflect.Descriptor instead.
+func (*Trip) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Trip) GetOffer() *TripOffer {
+	if x != nil {
+		return x.Offer
+	}
+	return nil
+}
+
+func (x *Trip) GetGroups() []*TripGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *Trip) GetSeatsUsed() int32 {
+	if x != nil {
+		return x.SeatsUsed
+	}
+	return 0
+}
+
+func (x *Trip) GetSeatsFree() int32 {
+	if x != nil {
+		return x.SeatsFree
+	}
+	return 0
+}
+
+type ListMyTripsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// defaults to 20
+	Limit         int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTripsRequest) Reset() {
+	*x = ListMyTripsRequest{}
+	mi := &file_proto_v1_trip_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTripsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTripsRequest) ProtoMessage() {}
+
+func (x *ListMyTripsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTripsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyTripsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListMyTripsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListMyTripsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Trips         []*Trip                `protobuf:"bytes,1,rep,name=trips,proto3" json:"trips,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTripsResponse) Reset() {
+	*x = ListMyTripsResponse{}
+	mi := &file_proto_v1_trip_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTripsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTripsResponse) ProtoMessage() {}
+
+func (x *ListMyTripsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_trip_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTripsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyTripsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_trip_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListMyTripsResponse) GetTrips() []*Trip {
+	if x != nil {
+		return x.Trips
+	}
+	return nil
+}
+
+var File_proto_v1_trip_proto protoreflect.FileDescriptor
+
+const file_proto_v1_trip_proto_rawDesc = "" +
+	"\n" +
+	"\x13proto/v1/trip.proto\x12\bproto.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc4\x01\n" +
+	"\tPickupETA\x12\x18\n" +
+	"\aseconds\x18\x01 \x01(\x03R\aseconds\x12'\n" +
+	"\x0fdistance_meters\x18\x02 \x01(\x01R\x0edistanceMeters\x12\x1b\n" +
+	"\tspeed_kmh\x18\x03 \x01(\x01R\bspeedKmh\x12\x1a\n" +
+	"\bobserved\x18\x04 \x01(\bR\bobserved\x12;\n" +
+	"\vlocation_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"locationAt\"%\n" +
+	"\bTripJoin\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"b\n" +
+	"\fTripPosition\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x18\n" +
+	"\ageohash\x18\x03 \x01(\tR\ageohash\"~\n" +
+	"\x12TripLocationUpdate\x12(\n" +
+	"\x04join\x18\x01 \x01(\v2\x12.proto.v1.TripJoinH\x00R\x04join\x124\n" +
+	"\bposition\x18\x02 \x01(\v2\x16.proto.v1.TripPositionH\x00R\bpositionB\b\n" +
+	"\x06update\"\x9e\x02\n" +
+	"\x11TripLocationEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06driver\x18\x02 \x01(\bR\x06driver\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\x12\x18\n" +
+	"\ageohash\x18\x05 \x01(\tR\ageohash\x12\x1b\n" +
+	"\tspeed_mps\x18\x06 \x01(\x01R\bspeedMps\x12*\n" +
+	"\x02at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x14\n" +
+	"\x05ended\x18\b \x01(\bR\x05ended\x12%\n" +
+	"\x03eta\x18\t \x01(\v2\x13.proto.v1.PickupETAR\x03eta\"F\n" +
+	"\x11ExportTripRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"g\n" +
+	"\x12ExportTripResponse\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"0\n" +
+	"\x13GetPickupETARequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"=\n" +
+	"\x14GetPickupETAResponse\x12%\n" +
+	"\x03eta\x18\x01 \x01(\v2\x13.proto.v1.PickupETAR\x03eta\"\xf3\x02\n" +
+	"\fManifestStop\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x05R\x03seq\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x19\n" +
+	"\bmatch_id\x18\x03 \x01(\tR\amatchId\x12\x19\n" +
+	"\bstop_seq\x18\x04 \x01(\x05R\astopSeq\x12\x18\n" +
+	"\ageohash\x18\x05 \x01(\tR\ageohash\x127\n" +
+	"\tarrive_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\barriveAt\x127\n" +
+	"\tdepart_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bdepartAt\x12!\n" +
+	"\flate_seconds\x18\b \x01(\x05R\vlateSeconds\x12\x1d\n" +
+	"\n" +
+	"leg_meters\x18\t \x01(\x01R\tlegMeters\x12\x1f\n" +
+	"\vleg_seconds\x18\n" +
+	" \x01(\x05R\n" +
+	"legSeconds\x12\x18\n" +
+	"\aonboard\x18\v \x01(\x05R\aonboard\"\xcb\x02\n" +
+	"\fTripManifest\x12\x17\n" +
+	"\aride_id\x18\x01 \x01(\tR\x06rideId\x12,\n" +
+	"\x05stops\x18\x02 \x03(\v2\x16.proto.v1.ManifestStopR\x05stops\x12\x16\n" +
+	"\x06meters\x18\x03 \x01(\x01R\x06meters\x12\x18\n" +
+	"\aseconds\x18\x04 \x01(\x05R\aseconds\x12#\n" +
+	"\rdetour_meters\x18\x05 \x01(\x01R\fdetourMeters\x12%\n" +
+	"\x0edetour_seconds\x18\x06 \x01(\x05R\rdetourSeconds\x12!\n" +
+	"\flate_seconds\x18\a \x01(\x05R\vlateSeconds\x12\x18\n" +
+	"\aoptimal\x18\b \x01(\bR\aoptimal\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"1\n" +
+	"\x16GetTripManifestRequest\x12\x17\n" +
+	"\aride_id\x18\x01 \x01(\tR\x06rideId\"q\n" +
+	"\x17GetTripManifestResponse\x122\n" +
+	"\bmanifest\x18\x01 \x01(\v2\x16.proto.v1.TripManifestR\bmanifest\x12\"\n" +
+	"\x04trip\x18\x02 \x01(\v2\x0e.proto.v1.TripR\x04trip\"\xf3\x01\n" +
+	"\tTripOffer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tdriver_id\x18\x02 \x01(\tR\bdriverId\x12\x15\n" +
+	"\x06org_id\x18\x03 \x01(\tR\x05orgId\x12\x19\n" +
+	"\bfrom_geo\x18\x04 \x01(\tR\afromGeo\x12\x15\n" +
+	"\x06to_geo\x18\x05 \x01(\tR\x05toGeo\x12.\n" +
+	"\x04time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
+	"\x05seats\x18\a \x01(\x05R\x05seats\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x12\n" +
+	"\x04fare\x18\t \x01(\x01R\x04fare\"\xc1\x03\n" +
+	"\tTripRider\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x19\n" +
+	"\brider_id\x18\x02 \x01(\tR\ariderId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1b\n" +
+	"\tphoto_url\x18\x04 \x01(\tR\bphotoUrl\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\x01R\x06rating\x12!\n" +
+	"\freview_count\x18\x06 \x01(\x05R\vreviewCount\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"pickup_geo\x18\b \x01(\tR\tpickupGeo\x12\x1f\n" +
+	"\vdropoff_geo\x18\t \x01(\tR\n" +
+	"dropoffGeo\x12\x1f\n" +
+	"\vpickup_stop\x18\n" +
+	" \x01(\x05R\n" +
+	"pickupStop\x12!\n" +
+	"\fdropoff_stop\x18\v \x01(\x05R\vdropoffStop\x12\x14\n" +
+	"\x05seats\x18\f \x01(\x05R\x05seats\x12%\n" +
+	"\x0edetour_seconds\x18\r \x01(\x05R\rdetourSeconds\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"P\n" +
+	"\tTripGroup\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12+\n" +
+	"\x06riders\x18\x02 \x03(\v2\x13.proto.v1.TripRiderR\x06riders\"\x9c\x01\n" +
+	"\x04Trip\x12)\n" +
+	"\x05offer\x18\x01 \x01(\v2\x13.proto.v1.TripOfferR\x05offer\x12+\n" +
+	"\x06groups\x18\x02 \x03(\v2\x13.proto.v1.TripGroupR\x06groups\x12\x1d\n" +
+	"\n" +
+	"seats_used\x18\x03 \x01(\x05R\tseatsUsed\x12\x1d\n" +
+	"\n" +
+	"seats_free\x18\x04 \x01(\x05R\tseatsFree\"*\n" +
+	"\x12ListMyTripsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\";\n" +
+	"\x13ListMyTripsResponse\x12$\n" +
+	"\x05trips\x18\x01 \x03(\v2\x0e.proto.v1.TripR\x05trips2\xa7\x03\n" +
+	"\vTripService\x12T\n" +
+	"\x11ShareTripLocation\x12\x1c.proto.v1.TripLocationUpdate\x1a\x1b.proto.v1.TripLocationEvent\"\x00(\x010\x01\x12I\n" +
+	"\n" +
+	"ExportTrip\x12\x1b.proto.v1.ExportTripRequest\x1a\x1c.proto.v1.ExportTripResponse\"\x00\x12O\n" +
+	"\fGetPickupETA\x12\x1d.proto.v1.GetPickupETARequest\x1a\x1e.proto.v1.GetPickupETAResponse\"\x00\x12X\n" +
+	"\x0fGetTripManifest\x12 .proto.v1.GetTripManifestRequest\x1a!.proto.v1.GetTripManifestResponse\"\x00\x12L\n" +
+	"\vListMyTrips\x12\x1c.proto.v1.ListMyTripsRequest\x1a\x1d.proto.v1.ListMyTripsResponse\"\x00B\x11Z\x0f./proto/v1/tripb\x06proto3"
+
+var (
+	file_proto_v1_trip_proto_rawDescOnce sync.Once
+	file_proto_v1_trip_proto_rawDescData []byte
+)
+
+func file_proto_v1_trip_proto_rawDescGZIP() []byte {
+	file_proto_v1_trip_proto_rawDescOnce.Do(func() {
+		file_proto_v1_trip_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v1_trip_proto_rawDesc), len(file_proto_v1_trip_proto_rawDesc)))
+	})
+	return file_proto_v1_trip_proto_rawDescData
+}
+
+var file_proto_v1_trip_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_proto_v1_trip_proto_goTypes = []any{
+	(*PickupETA)(nil),               // 0: proto.v1.PickupETA
+	(*TripJoin)(nil),                // 1: proto.v1.TripJoin
+	(*TripPosition)(nil),            // 2: proto.v1.TripPosition
+	(*TripLocationUpdate)(nil),      // 3: proto.v1.TripLocationUpdate
+	(*TripLocationEvent)(nil),       // 4: proto.v1.TripLocationEvent
+	(*ExportTripRequest)(nil),       // 5: proto.v1.ExportTripRequest
+	(*ExportTripResponse)(nil),      // 6: proto.v1.ExportTripResponse
+	(*GetPickupETARequest)(nil),     // 7: proto.v1.GetPickupETARequest
+	(*GetPickupETAResponse)(nil),    // 8: proto.v1.GetPickupETAResponse
+	(*ManifestStop)(nil),            // 9: proto.v1.ManifestStop
+	(*TripManifest)(nil),            // 10: proto.v1.TripManifest
+	(*GetTripManifestRequest)(nil),  // 11: proto.v1.GetTripManifestRequest
+	(*GetTripManifestResponse)(nil), // 12: proto.v1.GetTripManifestResponse
+	(*TripOffer)(nil),               // 13: proto.v1.TripOffer
+	(*TripRider)(nil),               // 14: proto.v1.TripRider
+	(*TripGroup)(nil),               // 15: proto.v1.TripGroup
+	(*Trip)(nil),                    // 16: proto.v1.Trip
+	(*ListMyTripsRequest)(nil),      // 17: proto.v1.ListMyTripsRequest
+	(*ListMyTripsResponse)(nil),     // 18: proto.v1.ListMyTripsResponse
+	(*timestamppb.Timestamp)(nil),   // 19: google.protobuf.Timestamp
+}
+var file_proto_v1_trip_proto_depIdxs = []int32{
+	19, // 0: proto.v1.PickupETA.location_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: proto.v1.TripLocationUpdate.join:type_name -> proto.v1.TripJoin
+	2,  // 2: proto.v1.TripLocationUpdate.position:type_name -> proto.v1.TripPosition
+	19, // 3: proto.v1.TripLocationEvent.at:type_name -> google.protobuf.Timestamp
+	0,  // 4: proto.v1.TripLocationEvent.eta:type_name -> proto.v1.PickupETA
+	0,  // 5: proto.v1.GetPickupETAResponse.eta:type_name -> proto.v1.PickupETA
+	19, // 6: proto.v1.ManifestStop.arrive_at:type_name -> google.protobuf.Timestamp
+	19, // 7: proto.v1.ManifestStop.depart_at:type_name -> google.protobuf.Timestamp
+	9,  // 8: proto.v1.TripManifest.stops:type_name -> proto.v1.ManifestStop
+	19, // 9: proto.v1.TripManifest.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 10: proto.v1.GetTripManifestResponse.manifest:type_name -> proto.v1.TripManifest
+	16, // 11: proto.v1.GetTripManifestResponse.trip:type_name -> proto.v1.Trip
+	19, // 12: proto.v1.TripOffer.time:type_name -> google.protobuf.Timestamp
+	19, // 13: proto.v1.TripRider.created_at:type_name -> google.protobuf.Timestamp
+	14, // 14: proto.v1.TripGroup.riders:type_name -> proto.v1.TripRider
+	13, // 15: proto.v1.Trip.offer:type_name -> proto.v1.TripOffer
+	15, // 16: proto.v1.Trip.groups:type_name -> proto.v1.TripGroup
+	16, // 17: proto.v1.ListMyTripsResponse.trips:type_name -> proto.v1.Trip
+	3,  // 18: proto.v1.TripService.ShareTripLocation:input_type -> proto.v1.TripLocationUpdate
+	5,  // 19: proto.v1.TripService.ExportTrip:input_type -> proto.v1.ExportTripRequest
+	7,  // 20: proto.v1.TripService.GetPickupETA:input_type -> proto.v1.GetPickupETARequest
+	11, // 21: proto.v1.TripService.GetTripManifest:input_type -> proto.v1.GetTripManifestRequest
+	17, // 22: proto.v1.TripService.ListMyTrips:input_type -> proto.v1.ListMyTripsRequest
+	4,  // 23: proto.v1.TripService.ShareTripLocation:output_type -> proto.v1.TripLocationEvent
+	6,  // 24: proto.v1.TripService.ExportTrip:output_type -> proto.v1.ExportTripResponse
+	8,  // 25: proto.v1.TripService.GetPickupETA:output_type -> proto.v1.GetPickupETAResponse
+	12, // 26: proto.v1.TripService.GetTripManifest:output_type -> proto.v1.GetTripManifestResponse
+	18, // 27: proto.v1.TripService.ListMyTrips:output_type -> proto.v1.ListMyTripsResponse
+	23, // [23:28] is the sub-list for method output_type
+	18, // [18:23] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_trip_proto_init() }
+func file_proto_v1_trip_proto_init() {
+	if File_proto_v1_trip_proto != nil {
+		return
+	}
+	file_proto_v1_trip_proto_msgTypes[3].OneofWrappers = []any{
+		(*TripLocationUpdate_Join)(nil),
+		(*TripLocationUpdate_Position)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_trip_proto_rawDesc), len(file_proto_v1_trip_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_trip_proto_goTypes,
+		DependencyIndexes: file_proto_v1_trip_proto_depIdxs,
+		MessageInfos:      file_proto_v1_trip_proto_msgTypes,
+	}.Build()
+	File_proto_v1_trip_proto = out.File
+	file_proto_v1_trip_proto_goTypes = nil
+	file_proto_v1_trip_proto_depIdxs = nil
+}
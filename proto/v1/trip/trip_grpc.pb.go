@@ -0,0 +1,296 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: proto/v1/trip.proto
+
+package trip
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TripService_ShareTripLocation_FullMethodName = "/proto.v1.TripService/ShareTripLocation"
+	TripService_ExportTrip_FullMethodName        = "/proto.v1.TripService/ExportTrip"
+	TripService_GetPickupETA_FullMethodName      = "/proto.v1.TripService/GetPickupETA"
+	TripService_GetTripManifest_FullMethodName   = "/proto.v1.TripService/GetTripManifest"
+	TripService_ListMyTrips_FullMethodName       = "/proto.v1.TripService/ListMyTrips"
+)
+
+// TripServiceClient is the client API for TripService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TripServiceClient interface {
+	// ShareTripLocation is the live location channel of an accepted match.
+	// The first client message must be a join, then positions. The driver's
+	// positions go to every rider of the ride, a rider's are only stored.
+	// The server ends the stream with ended=true once the match completes.
+	ShareTripLocation(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[TripLocationUpdate, TripLocationEvent], error)
+	// ExportTrip renders the recorded tracks of a completed match as GPX or
+	// GeoJSON. Only its participants and admins may export it.
+	ExportTrip(ctx context.Context, in *ExportTripRequest, opts ...grpc.CallOption) (*ExportTripResponse, error)
+	// GetPickupETA estimates when the driver of an accepted match reaches the
+	// rider's pickup point
+	GetPickupETA(ctx context.Context, in *GetPickupETARequest, opts ...grpc.CallOption) (*GetPickupETAResponse, error)
+	// GetTripManifest is the order an offer's pickups and dropoffs are
+	// made in, with planned times. It is rebuilt whenever a rider is
+	// accepted or leaves. Riders get the other riders' stops without their
+	// match or location
+	GetTripManifest(ctx context.Context, in *GetTripManifestRequest, opts ...grpc.CallOption) (*GetTripManifestResponse, error)
+	// ListMyTrips is the caller's offers as a driver with their riders,
+	// latest departure first. Manifests are left out
+	ListMyTrips(ctx context.Context, in *ListMyTripsRequest, opts ...grpc.CallOption) (*ListMyTripsResponse, error)
+}
+
+type tripServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTripServiceClient(cc grpc.ClientConnInterface) TripServiceClient {
+	return &tripServiceClient{cc}
+}
+
+func (c *tripServiceClient) ShareTripLocation(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[TripLocationUpdate, TripLocationEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TripService_ServiceDesc.Streams[0], TripService_ShareTripLocation_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TripLocationUpdate, TripLocationEvent]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TripService_ShareTripLocationClient = grpc.BidiStreamingClient[TripLocationUpdate, TripLocationEvent]
+
+func (c *tripServiceClient) ExportTrip(ctx context.Context, in *ExportTripRequest, opts ...grpc.CallOption) (*ExportTripResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportTripResponse)
+	err := c.cc.Invoke(ctx, TripService_ExportTrip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) GetPickupETA(ctx context.Context, in *GetPickupETARequest, opts ...grpc.CallOption) (*GetPickupETAResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPickupETAResponse)
+	err := c.cc.Invoke(ctx, TripService_GetPickupETA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) GetTripManifest(ctx context.Context, in *GetTripManifestRequest, opts ...grpc.CallOption) (*GetTripManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTripManifestResponse)
+	err := c.cc.Invoke(ctx, TripService_GetTripManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripServiceClient) ListMyTrips(ctx context.Context, in *ListMyTripsRequest, opts ...grpc.CallOption) (*ListMyTripsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyTripsResponse)
+	err := c.cc.Invoke(ctx, TripService_ListMyTrips_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TripServiceServer is the server API for TripService service.
+// All implementations must embed UnimplementedTripServiceServer
+// for forward compatibility.
+type TripServiceServer interface {
+	// ShareTripLocation is the live location channel of an accepted match.
+	// The first client message must be a join, then positions. The driver's
+	// positions go to every rider of the ride, a rider's are only stored.
+	// The server ends the stream with ended=true once the match completes.
+	ShareTripLocation(grpc.BidiStreamingServer[TripLocationUpdate, TripLocationEvent]) error
+	// ExportTrip renders the recorded tracks of a completed match as GPX or
+	// GeoJSON. Only its participants and admins may export it.
+	ExportTrip(context.Context, *ExportTripRequest) (*ExportTripResponse, error)
+	// GetPickupETA estimates when the driver of an accepted match reaches the
+	// rider's pickup point
+	GetPickupETA(context.Context, *GetPickupETARequest) (*GetPickupETAResponse, error)
+	// GetTripManifest is the order an offer's pickups and dropoffs are
+	// made in, with planned times. It is rebuilt whenever a rider is
+	// accepted or leaves. Riders get the other riders' stops without their
+	// match or location
+	GetTripManifest(context.Context, *GetTripManifestRequest) (*GetTripManifestResponse, error)
+	// ListMyTrips is the caller's offers as a driver with their riders,
+	// latest departure first. Manifests are left out
+	ListMyTrips(context.Context, *ListMyTripsRequest) (*ListMyTripsResponse, error)
+	mustEmbedUnimplementedTripServiceServer()
+}
+
+// UnimplementedTripServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTripServiceServer struct{}
+
+func (UnimplementedTripServiceServer) ShareTripLocation(grpc.BidiStreamingServer[TripLocationUpdate, TripLocationEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method ShareTripLocation not implemented")
+}
+func (UnimplementedTripServiceServer) ExportTrip(context.Context, *ExportTripRequest) (*ExportTripResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTrip not implemented")
+}
+func (UnimplementedTripServiceServer) GetPickupETA(context.Context, *GetPickupETARequest) (*GetPickupETAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPickupETA not implemented")
+}
+func (UnimplementedTripServiceServer) GetTripManifest(context.Context, *GetTripManifestRequest) (*GetTripManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripManifest not implemented")
+}
+func (UnimplementedTripServiceServer) ListMyTrips(context.Context, *ListMyTripsRequest) (*ListMyTripsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyTrips not implemented")
+}
+func (UnimplementedTripServiceServer) mustEmbedUnimplementedTripServiceServer() {}
+func (UnimplementedTripServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeTripServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TripServiceServer will
+// result in compilation errors.
+type UnsafeTripServiceServer interface {
+	mustEmbedUnimplementedTripServiceServer()
+}
+
+func RegisterTripServiceServer(s grpc.ServiceRegistrar, srv TripServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTripServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TripService_ServiceDesc, srv)
+}
+
+func _TripService_ShareTripLocation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TripServiceServer).ShareTripLocation(&grpc.GenericServerStream[TripLocationUpdate, TripLocationEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TripService_ShareTripLocationServer = grpc.BidiStreamingServer[TripLocationUpdate, TripLocationEvent]
+
+func _TripService_ExportTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).ExportTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_ExportTrip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).ExportTrip(ctx, req.(*ExportTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_GetPickupETA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPickupETARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).GetPickupETA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_GetPickupETA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).GetPickupETA(ctx, req.(*GetPickupETARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_GetTripManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).GetTripManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_GetTripManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).GetTripManifest(ctx, req.(*GetTripManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripService_ListMyTrips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyTripsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripServiceServer).ListMyTrips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripService_ListMyTrips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripServiceServer).ListMyTrips(ctx, req.(*ListMyTripsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TripService_ServiceDesc is the grpc.ServiceDesc for TripService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TripService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.v1.TripService",
+	HandlerType: (*TripServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportTrip",
+			Handler:    _TripService_ExportTrip_Handler,
+		},
+		{
+			MethodName: "GetPickupETA",
+			Handler:    _TripService_GetPickupETA_Handler,
+		},
+		{
+			MethodName: "GetTripManifest",
+			Handler:    _TripService_GetTripManifest_Handler,
+		},
+		{
+			MethodName: "ListMyTrips",
+			Handler:    _TripService_ListMyTrips_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ShareTripLocation",
+			Handler:       _TripService_ShareTripLocation_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/v1/trip.proto",
+}
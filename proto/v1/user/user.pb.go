@@ -9,6 +9,7 @@ package user
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -29,6 +30,9 @@ type User struct {
 	PhotoUrl      string                 `protobuf:"bytes,4,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"`
 	Geohash       string                 `protobuf:"bytes,5,opt,name=geohash,proto3" json:"geohash,omitempty"`
 	LastSeen      int64                  `protobuf:"varint,6,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	OrgId         string                 `protobuf:"bytes,7,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Role          string                 `protobuf:"bytes,8,opt,name=role,proto3" json:"role,omitempty"`
+	Reliability   *Reliability           `protobuf:"bytes,9,opt,name=reliability,proto3" json:"reliability,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -105,6 +109,97 @@ func (x *User) GetLastSeen() int64 {
 	return 0
 }
 
+func (x *User) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *User) GetReliability() *Reliability {
+	if x != nil {
+		return x.Reliability
+	}
+	return nil
+}
+
+// how well the user keeps the rides they agreed to, from their match history
+type Reliability struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 to 100, 100 without any history
+	Score         float64 `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	Completed     int32   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	LateCancels   int32   `protobuf:"varint,3,opt,name=late_cancels,json=lateCancels,proto3" json:"late_cancels,omitempty"`
+	NoShows       int32   `protobuf:"varint,4,opt,name=no_shows,json=noShows,proto3" json:"no_shows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reliability) Reset() {
+	*x = Reliability{}
+	mi := &file_proto_v1_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reliability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reliability) ProtoMessage() {}
+
+func (x *Reliability) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reliability.ProtoReflect.Descriptor instead.
+func (*Reliability) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Reliability) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Reliability) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *Reliability) GetLateCancels() int32 {
+	if x != nil {
+		return x.LateCancels
+	}
+	return 0
+}
+
+func (x *Reliability) GetNoShows() int32 {
+	if x != nil {
+		return x.NoShows
+	}
+	return 0
+}
+
 type GetMeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -113,7 +208,7 @@ type GetMeRequest struct {
 
 func (x *GetMeRequest) Reset() {
 	*x = GetMeRequest{}
-	mi := &file_proto_v1_user_proto_msgTypes[1]
+	mi := &file_proto_v1_user_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -125,7 +220,7 @@ func (x *GetMeRequest) String() string {
 func (*GetMeRequest) ProtoMessage() {}
 
 func (x *GetMeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_user_proto_msgTypes[1]
+	mi := &file_proto_v1_user_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -138,7 +233,7 @@ func (x *GetMeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMeRequest.ProtoReflect.Descriptor instead.
 func (*GetMeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_user_proto_rawDescGZIP(), []int{1}
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{2}
 }
 
 type GetMeResponse struct {
@@ -150,7 +245,7 @@ type GetMeResponse struct {
 
 func (x *GetMeResponse) Reset() {
 	*x = GetMeResponse{}
-	mi := &file_proto_v1_user_proto_msgTypes[2]
+	mi := &file_proto_v1_user_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -162,7 +257,7 @@ func (x *GetMeResponse) String() string {
 func (*GetMeResponse) ProtoMessage() {}
 
 func (x *GetMeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_user_proto_msgTypes[2]
+	mi := &file_proto_v1_user_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -175,7 +270,7 @@ func (x *GetMeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMeResponse.ProtoReflect.Descriptor instead.
 func (*GetMeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_user_proto_rawDescGZIP(), []int{2}
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetMeResponse) GetUser() *User {
@@ -194,7 +289,7 @@ type GetUserRequest struct {
 
 func (x *GetUserRequest) Reset() {
 	*x = GetUserRequest{}
-	mi := &file_proto_v1_user_proto_msgTypes[3]
+	mi := &file_proto_v1_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -206,7 +301,7 @@ func (x *GetUserRequest) String() string {
 func (*GetUserRequest) ProtoMessage() {}
 
 func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_user_proto_msgTypes[3]
+	mi := &file_proto_v1_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,7 +314,7 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_user_proto_rawDescGZIP(), []int{3}
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetUserRequest) GetUserId() string {
@@ -238,7 +333,7 @@ type GetUserResponse struct {
 
 func (x *GetUserResponse) Reset() {
 	*x = GetUserResponse{}
-	mi := &file_proto_v1_user_proto_msgTypes[4]
+	mi := &file_proto_v1_user_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -250,7 +345,7 @@ func (x *GetUserResponse) String() string {
 func (*GetUserResponse) ProtoMessage() {}
 
 func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_user_proto_msgTypes[4]
+	mi := &file_proto_v1_user_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -263,7 +358,7 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
 func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_user_proto_rawDescGZIP(), []int{4}
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetUserResponse) GetUser() *User {
@@ -284,7 +379,7 @@ type UpdateMeRequest struct {
 
 func (x *UpdateMeRequest) Reset() {
 	*x = UpdateMeRequest{}
-	mi := &file_proto_v1_user_proto_msgTypes[5]
+	mi := &file_proto_v1_user_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -296,7 +391,7 @@ func (x *UpdateMeRequest) String() string {
 func (*UpdateMeRequest) ProtoMessage() {}
 
 func (x *UpdateMeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_user_proto_msgTypes[5]
+	mi := &file_proto_v1_user_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,7 +404,7 @@ func (x *UpdateMeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_user_proto_rawDescGZIP(), []int{5}
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateMeRequest) GetName() string {
@@ -342,7 +437,7 @@ type UpdateMeResponse struct {
 
 func (x *UpdateMeResponse) Reset() {
 	*x = UpdateMeResponse{}
-	mi := &file_proto_v1_user_proto_msgTypes[6]
+	mi := &file_proto_v1_user_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -354,7 +449,7 @@ func (x *UpdateMeResponse) String() string {
 func (*UpdateMeResponse) ProtoMessage() {}
 
 func (x *UpdateMeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_user_proto_msgTypes[6]
+	mi := &file_proto_v1_user_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -367,7 +462,7 @@ func (x *UpdateMeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMeResponse.ProtoReflect.Descriptor instead.
 func (*UpdateMeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_user_proto_rawDescGZIP(), []int{6}
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateMeResponse) GetUser() *User {
@@ -386,7 +481,7 @@ type ListUsersRequest struct {
 
 func (x *ListUsersRequest) Reset() {
 	*x = ListUsersRequest{}
-	mi := &file_proto_v1_user_proto_msgTypes[7]
+	mi := &file_proto_v1_user_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -398,7 +493,7 @@ func (x *ListUsersRequest) String() string {
 func (*ListUsersRequest) ProtoMessage() {}
 
 func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_user_proto_msgTypes[7]
+	mi := &file_proto_v1_user_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,7 +506,7 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_user_proto_rawDescGZIP(), []int{7}
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListUsersRequest) GetUserIds() []string {
@@ -430,7 +525,7 @@ type ListUsersResponse struct {
 
 func (x *ListUsersResponse) Reset() {
 	*x = ListUsersResponse{}
-	mi := &file_proto_v1_user_proto_msgTypes[8]
+	mi := &file_proto_v1_user_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -442,7 +537,7 @@ func (x *ListUsersResponse) String() string {
 func (*ListUsersResponse) ProtoMessage() {}
 
 func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_user_proto_msgTypes[8]
+	mi := &file_proto_v1_user_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,7 +550,7 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
 func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_user_proto_rawDescGZIP(), []int{8}
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListUsersResponse) GetUsers() []*User {
@@ -465,40 +560,739 @@ func (x *ListUsersResponse) GetUsers() []*User {
 	return nil
 }
 
-var File_proto_v1_user_proto protoreflect.FileDescriptor
+// kind is "block" or "mute"
+type BlockedUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_v1_user_proto_rawDesc = "" +
-	"\n" +
-	"\x13proto/v1/user.proto\x12\bproto.v1\"\x94\x01\n" +
-	"\x04User\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1b\n" +
-	"\tphoto_url\x18\x04 \x01(\tR\bphotoUrl\x12\x18\n" +
-	"\ageohash\x18\x05 \x01(\tR\ageohash\x12\x1b\n" +
-	"\tlast_seen\x18\x06 \x01(\x03R\blastSeen\"\x0e\n" +
-	"\fGetMeRequest\"3\n" +
-	"\rGetMeResponse\x12\"\n" +
-	"\x04user\x18\x01 \x01(\v2\x0e.proto.v1.UserR\x04user\")\n" +
-	"\x0eGetUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"5\n" +
-	"\x0fGetUserResponse\x12\"\n" +
-	"\x04user\x18\x01 \x01(\v2\x0e.proto.v1.UserR\x04user\"\\\n" +
-	"\x0fUpdateMeRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
-	"\tphoto_url\x18\x02 \x01(\tR\bphotoUrl\x12\x18\n" +
-	"\ageohash\x18\x03 \x01(\tR\ageohash\"6\n" +
-	"\x10UpdateMeResponse\x12\"\n" +
-	"\x04user\x18\x01 \x01(\v2\x0e.proto.v1.UserR\x04user\"-\n" +
-	"\x10ListUsersRequest\x12\x19\n" +
-	"\buser_ids\x18\x01 \x03(\tR\auserIds\"9\n" +
-	"\x11ListUsersResponse\x12$\n" +
-	"\x05users\x18\x01 \x03(\v2\x0e.proto.v1.UserR\x05users2\x90\x02\n" +
+func (x *BlockedUser) Reset() {
+	*x = BlockedUser{}
+	mi := &file_proto_v1_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedUser) ProtoMessage() {}
+
+func (x *BlockedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedUser.ProtoReflect.Descriptor instead.
+func (*BlockedUser) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BlockedUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockedUser) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BlockedUser) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type BlockUserRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// mute only hides their chat messages, a block hides them everywhere
+	Mute          bool `protobuf:"varint,2,opt,name=mute,proto3" json:"mute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_proto_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserRequest) ProtoMessage() {}
+
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BlockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BlockUserRequest) GetMute() bool {
+	if x != nil {
+		return x.Mute
+	}
+	return false
+}
+
+type BlockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_proto_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResponse) ProtoMessage() {}
+
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BlockUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UnblockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_proto_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserRequest) ProtoMessage() {}
+
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UnblockUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnblockUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	mi := &file_proto_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnblockUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserResponse) ProtoMessage() {}
+
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UnblockUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListBlockedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedRequest) Reset() {
+	*x = ListBlockedRequest{}
+	mi := &file_proto_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedRequest) ProtoMessage() {}
+
+func (x *ListBlockedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListBlockedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListBlockedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*BlockedUser         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedResponse) Reset() {
+	*x = ListBlockedResponse{}
+	mi := &file_proto_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedResponse) ProtoMessage() {}
+
+func (x *ListBlockedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListBlockedResponse) GetUsers() []*BlockedUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// favorites are riders the user's offers can accept without asking
+type FavoriteUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FavoriteUser) Reset() {
+	*x = FavoriteUser{}
+	mi := &file_proto_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FavoriteUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteUser) ProtoMessage() {}
+
+func (x *FavoriteUser) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteUser.ProtoReflect.Descriptor instead.
+func (*FavoriteUser) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FavoriteUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FavoriteUser) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AddFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteRequest) Reset() {
+	*x = AddFavoriteRequest{}
+	mi := &file_proto_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteRequest) ProtoMessage() {}
+
+func (x *AddFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*AddFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AddFavoriteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AddFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddFavoriteResponse) Reset() {
+	*x = AddFavoriteResponse{}
+	mi := &file_proto_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddFavoriteResponse) ProtoMessage() {}
+
+func (x *AddFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*AddFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AddFavoriteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RemoveFavoriteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteRequest) Reset() {
+	*x = RemoveFavoriteRequest{}
+	mi := &file_proto_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteRequest) ProtoMessage() {}
+
+func (x *RemoveFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RemoveFavoriteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RemoveFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFavoriteResponse) Reset() {
+	*x = RemoveFavoriteResponse{}
+	mi := &file_proto_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFavoriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFavoriteResponse) ProtoMessage() {}
+
+func (x *RemoveFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFavoriteResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFavoriteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RemoveFavoriteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListFavoritesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesRequest) Reset() {
+	*x = ListFavoritesRequest{}
+	mi := &file_proto_v1_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesRequest) ProtoMessage() {}
+
+func (x *ListFavoritesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesRequest.ProtoReflect.Descriptor instead.
+func (*ListFavoritesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListFavoritesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFavoritesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*FavoriteUser        `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFavoritesResponse) Reset() {
+	*x = ListFavoritesResponse{}
+	mi := &file_proto_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesResponse) ProtoMessage() {}
+
+func (x *ListFavoritesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFavoritesResponse.ProtoReflect.Descriptor instead.
+func (*ListFavoritesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListFavoritesResponse) GetUsers() []*FavoriteUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+var File_proto_v1_user_proto protoreflect.FileDescriptor
+
+const file_proto_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"\x13proto/v1/user.proto\x12\bproto.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf8\x01\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1b\n" +
+	"\tphoto_url\x18\x04 \x01(\tR\bphotoUrl\x12\x18\n" +
+	"\ageohash\x18\x05 \x01(\tR\ageohash\x12\x1b\n" +
+	"\tlast_seen\x18\x06 \x01(\x03R\blastSeen\x12\x15\n" +
+	"\x06org_id\x18\a \x01(\tR\x05orgId\x12\x12\n" +
+	"\x04role\x18\b \x01(\tR\x04role\x127\n" +
+	"\vreliability\x18\t \x01(\v2\x15.proto.v1.ReliabilityR\vreliability\"\x7f\n" +
+	"\vReliability\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x01R\x05score\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\x05R\tcompleted\x12!\n" +
+	"\flate_cancels\x18\x03 \x01(\x05R\vlateCancels\x12\x19\n" +
+	"\bno_shows\x18\x04 \x01(\x05R\anoShows\"\x0e\n" +
+	"\fGetMeRequest\"3\n" +
+	"\rGetMeResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.proto.v1.UserR\x04user\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"5\n" +
+	"\x0fGetUserResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.proto.v1.UserR\x04user\"\\\n" +
+	"\x0fUpdateMeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tphoto_url\x18\x02 \x01(\tR\bphotoUrl\x12\x18\n" +
+	"\ageohash\x18\x03 \x01(\tR\ageohash\"6\n" +
+	"\x10UpdateMeResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.proto.v1.UserR\x04user\"-\n" +
+	"\x10ListUsersRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"9\n" +
+	"\x11ListUsersResponse\x12$\n" +
+	"\x05users\x18\x01 \x03(\v2\x0e.proto.v1.UserR\x05users\"u\n" +
+	"\vBlockedUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"?\n" +
+	"\x10BlockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04mute\x18\x02 \x01(\bR\x04mute\"-\n" +
+	"\x11BlockUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"-\n" +
+	"\x12UnblockUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"/\n" +
+	"\x13UnblockUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"*\n" +
+	"\x12ListBlockedRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"B\n" +
+	"\x13ListBlockedResponse\x12+\n" +
+	"\x05users\x18\x01 \x03(\v2\x15.proto.v1.BlockedUserR\x05users\"b\n" +
+	"\fFavoriteUser\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"-\n" +
+	"\x12AddFavoriteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"/\n" +
+	"\x13AddFavoriteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"0\n" +
+	"\x15RemoveFavoriteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"2\n" +
+	"\x16RemoveFavoriteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\",\n" +
+	"\x14ListFavoritesRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"E\n" +
+	"\x15ListFavoritesResponse\x12,\n" +
+	"\x05users\x18\x01 \x03(\v2\x16.proto.v1.FavoriteUserR\x05users2\xe1\x05\n" +
 	"\vUserService\x128\n" +
 	"\x05GetMe\x12\x16.proto.v1.GetMeRequest\x1a\x17.proto.v1.GetMeResponse\x12>\n" +
 	"\aGetUser\x12\x18.proto.v1.GetUserRequest\x1a\x19.proto.v1.GetUserResponse\x12A\n" +
 	"\bUpdateMe\x12\x19.proto.v1.UpdateMeRequest\x1a\x1a.proto.v1.UpdateMeResponse\x12D\n" +
-	"\tListUsers\x12\x1a.proto.v1.ListUsersRequest\x1a\x1b.proto.v1.ListUsersResponseB\x11Z\x0f./proto/v1/userb\x06proto3"
+	"\tListUsers\x12\x1a.proto.v1.ListUsersRequest\x1a\x1b.proto.v1.ListUsersResponse\x12D\n" +
+	"\tBlockUser\x12\x1a.proto.v1.BlockUserRequest\x1a\x1b.proto.v1.BlockUserResponse\x12J\n" +
+	"\vUnblockUser\x12\x1c.proto.v1.UnblockUserRequest\x1a\x1d.proto.v1.UnblockUserResponse\x12J\n" +
+	"\vListBlocked\x12\x1c.proto.v1.ListBlockedRequest\x1a\x1d.proto.v1.ListBlockedResponse\x12J\n" +
+	"\vAddFavorite\x12\x1c.proto.v1.AddFavoriteRequest\x1a\x1d.proto.v1.AddFavoriteResponse\x12S\n" +
+	"\x0eRemoveFavorite\x12\x1f.proto.v1.RemoveFavoriteRequest\x1a .proto.v1.RemoveFavoriteResponse\x12P\n" +
+	"\rListFavorites\x12\x1e.proto.v1.ListFavoritesRequest\x1a\x1f.proto.v1.ListFavoritesResponseB\x11Z\x0f./proto/v1/userb\x06proto3"
 
 var (
 	file_proto_v1_user_proto_rawDescOnce sync.Once
@@ -512,36 +1306,69 @@ func file_proto_v1_user_proto_rawDescGZIP() []byte {
 	return file_proto_v1_user_proto_rawDescData
 }
 
-var file_proto_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_proto_v1_user_proto_goTypes = []any{
-	(*User)(nil),              // 0: proto.v1.User
-	(*GetMeRequest)(nil),      // 1: proto.v1.GetMeRequest
-	(*GetMeResponse)(nil),     // 2: proto.v1.GetMeResponse
-	(*GetUserRequest)(nil),    // 3: proto.v1.GetUserRequest
-	(*GetUserResponse)(nil),   // 4: proto.v1.GetUserResponse
-	(*UpdateMeRequest)(nil),   // 5: proto.v1.UpdateMeRequest
-	(*UpdateMeResponse)(nil),  // 6: proto.v1.UpdateMeResponse
-	(*ListUsersRequest)(nil),  // 7: proto.v1.ListUsersRequest
-	(*ListUsersResponse)(nil), // 8: proto.v1.ListUsersResponse
+	(*User)(nil),                   // 0: proto.v1.User
+	(*Reliability)(nil),            // 1: proto.v1.Reliability
+	(*GetMeRequest)(nil),           // 2: proto.v1.GetMeRequest
+	(*GetMeResponse)(nil),          // 3: proto.v1.GetMeResponse
+	(*GetUserRequest)(nil),         // 4: proto.v1.GetUserRequest
+	(*GetUserResponse)(nil),        // 5: proto.v1.GetUserResponse
+	(*UpdateMeRequest)(nil),        // 6: proto.v1.UpdateMeRequest
+	(*UpdateMeResponse)(nil),       // 7: proto.v1.UpdateMeResponse
+	(*ListUsersRequest)(nil),       // 8: proto.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),      // 9: proto.v1.ListUsersResponse
+	(*BlockedUser)(nil),            // 10: proto.v1.BlockedUser
+	(*BlockUserRequest)(nil),       // 11: proto.v1.BlockUserRequest
+	(*BlockUserResponse)(nil),      // 12: proto.v1.BlockUserResponse
+	(*UnblockUserRequest)(nil),     // 13: proto.v1.UnblockUserRequest
+	(*UnblockUserResponse)(nil),    // 14: proto.v1.UnblockUserResponse
+	(*ListBlockedRequest)(nil),     // 15: proto.v1.ListBlockedRequest
+	(*ListBlockedResponse)(nil),    // 16: proto.v1.ListBlockedResponse
+	(*FavoriteUser)(nil),           // 17: proto.v1.FavoriteUser
+	(*AddFavoriteRequest)(nil),     // 18: proto.v1.AddFavoriteRequest
+	(*AddFavoriteResponse)(nil),    // 19: proto.v1.AddFavoriteResponse
+	(*RemoveFavoriteRequest)(nil),  // 20: proto.v1.RemoveFavoriteRequest
+	(*RemoveFavoriteResponse)(nil), // 21: proto.v1.RemoveFavoriteResponse
+	(*ListFavoritesRequest)(nil),   // 22: proto.v1.ListFavoritesRequest
+	(*ListFavoritesResponse)(nil),  // 23: proto.v1.ListFavoritesResponse
+	(*timestamppb.Timestamp)(nil),  // 24: google.protobuf.Timestamp
 }
 var file_proto_v1_user_proto_depIdxs = []int32{
-	0, // 0: proto.v1.GetMeResponse.user:type_name -> proto.v1.User
-	0, // 1: proto.v1.GetUserResponse.user:type_name -> proto.v1.User
-	0, // 2: proto.v1.UpdateMeResponse.user:type_name -> proto.v1.User
-	0, // 3: proto.v1.ListUsersResponse.users:type_name -> proto.v1.User
-	1, // 4: proto.v1.UserService.GetMe:input_type -> proto.v1.GetMeRequest
-	3, // 5: proto.v1.UserService.GetUser:input_type -> proto.v1.GetUserRequest
-	5, // 6: proto.v1.UserService.UpdateMe:input_type -> proto.v1.UpdateMeRequest
-	7, // 7: proto.v1.UserService.ListUsers:input_type -> proto.v1.ListUsersRequest
-	2, // 8: proto.v1.UserService.GetMe:output_type -> proto.v1.GetMeResponse
-	4, // 9: proto.v1.UserService.GetUser:output_type -> proto.v1.GetUserResponse
-	6, // 10: proto.v1.UserService.UpdateMe:output_type -> proto.v1.UpdateMeResponse
-	8, // 11: proto.v1.UserService.ListUsers:output_type -> proto.v1.ListUsersResponse
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	1,  // 0: proto.v1.User.reliability:type_name -> proto.v1.Reliability
+	0,  // 1: proto.v1.GetMeResponse.user:type_name -> proto.v1.User
+	0,  // 2: proto.v1.GetUserResponse.user:type_name -> proto.v1.User
+	0,  // 3: proto.v1.UpdateMeResponse.user:type_name -> proto.v1.User
+	0,  // 4: proto.v1.ListUsersResponse.users:type_name -> proto.v1.User
+	24, // 5: proto.v1.BlockedUser.created_at:type_name -> google.protobuf.Timestamp
+	10, // 6: proto.v1.ListBlockedResponse.users:type_name -> proto.v1.BlockedUser
+	24, // 7: proto.v1.FavoriteUser.created_at:type_name -> google.protobuf.Timestamp
+	17, // 8: proto.v1.ListFavoritesResponse.users:type_name -> proto.v1.FavoriteUser
+	2,  // 9: proto.v1.UserService.GetMe:input_type -> proto.v1.GetMeRequest
+	4,  // 10: proto.v1.UserService.GetUser:input_type -> proto.v1.GetUserRequest
+	6,  // 11: proto.v1.UserService.UpdateMe:input_type -> proto.v1.UpdateMeRequest
+	8,  // 12: proto.v1.UserService.ListUsers:input_type -> proto.v1.ListUsersRequest
+	11, // 13: proto.v1.UserService.BlockUser:input_type -> proto.v1.BlockUserRequest
+	13, // 14: proto.v1.UserService.UnblockUser:input_type -> proto.v1.UnblockUserRequest
+	15, // 15: proto.v1.UserService.ListBlocked:input_type -> proto.v1.ListBlockedRequest
+	18, // 16: proto.v1.UserService.AddFavorite:input_type -> proto.v1.AddFavoriteRequest
+	20, // 17: proto.v1.UserService.RemoveFavorite:input_type -> proto.v1.RemoveFavoriteRequest
+	22, // 18: proto.v1.UserService.ListFavorites:input_type -> proto.v1.ListFavoritesRequest
+	3,  // 19: proto.v1.UserService.GetMe:output_type -> proto.v1.GetMeResponse
+	5,  // 20: proto.v1.UserService.GetUser:output_type -> proto.v1.GetUserResponse
+	7,  // 21: proto.v1.UserService.UpdateMe:output_type -> proto.v1.UpdateMeResponse
+	9,  // 22: proto.v1.UserService.ListUsers:output_type -> proto.v1.ListUsersResponse
+	12, // 23: proto.v1.UserService.BlockUser:output_type -> proto.v1.BlockUserResponse
+	14, // 24: proto.v1.UserService.UnblockUser:output_type -> proto.v1.UnblockUserResponse
+	16, // 25: proto.v1.UserService.ListBlocked:output_type -> proto.v1.ListBlockedResponse
+	19, // 26: proto.v1.UserService.AddFavorite:output_type -> proto.v1.AddFavoriteResponse
+	21, // 27: proto.v1.UserService.RemoveFavorite:output_type -> proto.v1.RemoveFavoriteResponse
+	23, // 28: proto.v1.UserService.ListFavorites:output_type -> proto.v1.ListFavoritesResponse
+	19, // [19:29] is the sub-list for method output_type
+	9,  // [9:19] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_user_proto_init() }
@@ -555,7 +1382,7 @@ func file_proto_v1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_user_proto_rawDesc), len(file_proto_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"time"
+
+	"hope/db"
+
+	"gorm.io/gorm"
+)
+
+// Change is who moves a row to a new status and why. Repositories append
+// it to the row's history together with the status it replaced
+type Change struct {
+	// empty for changes the system makes on its own
+	ActorID string
+	Reason  string
+	// defaults to now
+	At time.Time
+}
+
+// stamped is c with At filled in
+func (c Change) stamped() Change {
+	if c.At.IsZero() {
+		c.At = time.Now().UTC()
+	}
+	return c
+}
+
+func (c Change) event(from, to string) db.StatusChange {
+	c = c.stamped()
+	return db.StatusChange{ActorID: c.ActorID, FromStatus: from, ToStatus: to, Reason: c.Reason, CreatedAt: c.At}
+}
+
+// statusOf reads the status of row id of model within tx
+func statusOf(tx *gorm.DB, model interface{}, id string) (string, error) {
+	var statuses []string
+	if err := tx.Model(model).Where("id = ?", id).Pluck("status", &statuses).Error; err != nil {
+		return "", err
+	}
+	if len(statuses) == 0 {
+		return "", gorm.ErrRecordNotFound
+	}
+	return statuses[0], nil
+}
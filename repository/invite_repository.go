@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"hope/db"
+
+	"gorm.io/gorm"
+)
+
+type InviteRepository interface {
+	Create(ctx context.Context, invite *db.Invite) error
+	FindByID(ctx context.Context, id string) (*db.Invite, error)
+	FindByCode(ctx context.Context, code string) (*db.Invite, error)
+	FindUsableByEmail(ctx context.Context, email string, now time.Time) (*db.Invite, error)
+	Redeem(ctx context.Context, id string) error
+	Revoke(ctx context.Context, id string, at time.Time) error
+	ListByOrg(ctx context.Context, orgID string, limit int) ([]db.Invite, error)
+}
+
+type inviteRepository struct {
+	db *gorm.DB
+}
+
+func NewInviteRepository(db *gorm.DB) InviteRepository {
+	return &inviteRepository{db: db}
+}
+
+func (r *inviteRepository) Create(ctx context.Context, invite *db.Invite) error {
+	if invite == nil {
+		return errors.New("invite is nil")
+	}
+	return r.db.WithContext(ctx).Create(invite).Error
+}
+
+func (r *inviteRepository) FindByID(ctx context.Context, id string) (*db.Invite, error) {
+	if id == "" {
+		return nil, nil
+	}
+	var out db.Invite
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		Take(&out).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &out, err
+}
+
+func (r *inviteRepository) FindByCode(ctx context.Context, code string) (*db.Invite, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, nil
+	}
+	var out db.Invite
+	err := r.db.WithContext(ctx).
+		Where("code = ?", code).
+		Take(&out).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &out, err
+}
+
+// FindUsableByEmail returns the newest invite addressed to email that is not
+// revoked, expired or used up
+func (r *inviteRepository) FindUsableByEmail(ctx context.Context, email string, now time.Time) (*db.Invite, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return nil, nil
+	}
+	var out db.Invite
+	err := r.db.WithContext(ctx).
+		Where("email = ? AND revoked_at IS NULL AND expires_at > ? AND (max_uses = 0 OR uses < max_uses)", email, now).
+		Order("created_at DESC").
+		Take(&out).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &out, err
+}
+
+// Redeem bumps the use counter, the guard in the WHERE clause keeps two
+// concurrent logins from both taking the last use
+func (r *inviteRepository) Redeem(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("id required")
+	}
+	tx := r.db.WithContext(ctx).Model(&db.Invite{}).
+		Where("id = ? AND revoked_at IS NULL AND (max_uses = 0 OR uses < max_uses)", id).
+		Update("uses", gorm.Expr("uses + 1"))
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return errors.New("invite no longer usable")
+	}
+	return nil
+}
+
+func (r *inviteRepository) Revoke(ctx context.Context, id string, at time.Time) error {
+	if id == "" {
+		return errors.New("id required")
+	}
+	return r.db.WithContext(ctx).Model(&db.Invite{}).
+		Where("id = ?", id).
+		Update("revoked_at", at).Error
+}
+
+func (r *inviteRepository) ListByOrg(ctx context.Context, orgID string, limit int) ([]db.Invite, error) {
+	var out []db.Invite
+	q := r.db.WithContext(ctx).
+		Where("org_id = ?", orgID).
+		Order("created_at DESC")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	err := q.Find(&out).Error
+	return out, err
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"hope/db"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type LocationSettingRepository interface {
+	Upsert(ctx context.Context, setting *db.LocationSetting) error
+	FindByUserID(ctx context.Context, userID string) (*db.LocationSetting, error)
+	// FindByUserIDs is keyed by user id, users without a row are simply absent
+	FindByUserIDs(ctx context.Context, userIDs []string) (map[string]db.LocationSetting, error)
+}
+
+type locationSettingRepository struct {
+	db *gorm.DB
+}
+
+func NewLocationSettingRepository(db *gorm.DB) LocationSettingRepository {
+	return &locationSettingRepository{db: db}
+}
+
+func (r *locationSettingRepository) Upsert(ctx context.Context, setting *db.LocationSetting) error {
+	if setting == nil {
+		return errors.New("setting is nil")
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"visibility", "updated_at"}),
+		}).
+		Create(setting).Error
+}
+
+func (r *locationSettingRepository) FindByUserID(ctx context.Context, userID string) (*db.LocationSetting, error) {
+	if userID == "" {
+		return nil, nil
+	}
+	var out db.LocationSetting
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Take(&out).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &out, err
+}
+
+func (r *locationSettingRepository) FindByUserIDs(ctx context.Context, userIDs []string) (map[string]db.LocationSetting, error) {
+	out := make(map[string]db.LocationSetting, len(userIDs))
+	if len(userIDs) == 0 {
+		return out, nil
+	}
+	var rows []db.LocationSetting
+	if err := r.db.WithContext(ctx).
+		Where("user_id IN ?", userIDs).
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		out[row.UserID] = row
+	}
+	return out, nil
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"hope/db"
+
+	"gorm.io/gorm"
+)
+
+type LocationViewRepository interface {
+	CreateBatch(ctx context.Context, views []db.LocationView) error
+	ListByOwner(ctx context.Context, ownerID string, limit int, before time.Time) ([]db.LocationView, error)
+}
+
+type locationViewRepository struct {
+	db *gorm.DB
+}
+
+func NewLocationViewRepository(db *gorm.DB) LocationViewRepository {
+	return &locationViewRepository{db: db}
+}
+
+func (r *locationViewRepository) CreateBatch(ctx context.Context, views []db.LocationView) error {
+	if len(views) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(&views).Error
+}
+
+func (r *locationViewRepository) ListByOwner(ctx context.Context, ownerID string, limit int, before time.Time) ([]db.LocationView, error) {
+	var out []db.LocationView
+	q := r.db.WithContext(ctx).
+		Where("owner_id = ? AND viewed_at < ?", ownerID, before).
+		Order("viewed_at DESC")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	err := q.Find(&out).Error
+	return out, err
+}
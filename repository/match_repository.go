@@ -4,19 +4,76 @@ import (
 	"context"
 	"errors"
 	"hope/db"
+	"time"
 	"gorm.io/gorm"
 )
 
 type MatchRepository interface {
-	Create(ctx context.Context, match *db.Match) error
+	// Create and the status changes below append to the match's history.
+	// A status change only applies while the match is still in from,
+	// otherwise it fails with an invalid state error
+	Create(ctx context.Context, match *db.Match, c Change) error
 	FindByID(ctx context.Context, id string) (*db.Match, error)
-	UpdateStatus(ctx context.Context, matchID string, status string) error
+	UpdateStatus(ctx context.Context, matchID, from, status string, c Change) error
+	// Cancel moves the match to status (withdrawn or cancelled) and records
+	// who did it, when and why, and whether it was late
+	Cancel(ctx context.Context, matchID, from, status string, c Change, late bool) error
+	// ReportNoShow moves the match to no_show, userID being who missed it
+	ReportNoShow(ctx context.Context, matchID, from, userID string, c Change) error
+	// SetPin replaces the match's check-in PIN, resetting wrong guesses
+	SetPin(ctx context.Context, matchID, pinHash string, expires time.Time) error
+	// PinMissed counts a wrong guess at the PIN
+	PinMissed(ctx context.Context, matchID string) error
+	// CheckIn records the rider as picked up at and retires the PIN
+	CheckIn(ctx context.Context, matchID string, at time.Time) error
+	// Complete moves the match to completed
+	Complete(ctx context.Context, matchID, from string, c Change) error
+	// Promote moves a waitlisted match to promoted, holding its seats
+	// until confirmBy
+	Promote(ctx context.Context, matchID, from string, confirmBy time.Time, c Change) error
+	// Accept moves the match to accepted with the pickup time agreed on
+	// and the departure it assumes
+	Accept(ctx context.Context, matchID, from string, pickupAt, departAt time.Time, c Change) error
+	// ListWaitlistRides is the rides with waitlisted matches or with
+	// promoted ones whose hold lapsed before the given time
+	ListWaitlistRides(ctx context.Context, lapsedBefore time.Time) ([]string, error)
+	// ListEvents is the match's status history, oldest first
+	ListEvents(ctx context.Context, matchID string) ([]db.MatchEvent, error)
+	// CounterFare puts p's fare on the table for the match, counts the
+	// counter and appends it to the negotiation. Create appends the fare a
+	// match starts out with when the rider proposed one
+	CounterFare(ctx context.Context, p *db.FareProposal) error
+	// ListFareProposals is the match's fare negotiation, oldest first
+	ListFareProposals(ctx context.Context, matchID string) ([]db.FareProposal, error)
+	// TrackRecords counts how each user's matches ended, on either side.
+	// Users without any are left out
+	TrackRecords(ctx context.Context, userIDs []string) (map[string]TrackRecord, error)
 	FindByRideID(ctx context.Context, rideID string) ([]db.Match, error)
+	// FindByRideIDs is FindByRideID for several rides in one query
+	FindByRideIDs(ctx context.Context, rideIDs []string) ([]db.Match, error)
 	FindByRiderID(ctx context.Context, riderID string) ([]db.Match, error)
+	// FindByPairID is both legs of a round trip
+	FindByPairID(ctx context.Context, pairID string) ([]db.Match, error)
 	FindActiveByRide(ctx context.Context, rideID string) (*db.Match, error)
 	ListByDriverID(ctx context.Context, driverID string, limit int) ([]db.Match, error)
+	// ListAcceptedForUser returns accepted matches on either side, with Ride loaded
+	ListAcceptedForUser(ctx context.Context, userID string) ([]db.Match, error)
+	// ListForRiderDepartingBetween is the rider's matches in one of
+	// statuses on rides leaving from from until before to, with Ride loaded
+	ListForRiderDepartingBetween(ctx context.Context, riderID string, statuses []string, from, to time.Time) ([]db.Match, error)
 }
 
+// TrackRecord is how a user's matches ended: trips completed, accepted
+// matches they cancelled late and matches they did not show up for
+type TrackRecord struct {
+	Completed   int
+	LateCancels int
+	NoShows     int
+}
+
+// errMatchChanged is a transition losing the race to another one
+var errMatchChanged = errors.New("invalid state: the match was changed in the meantime")
+
 type matchRepository struct {
 	db *gorm.DB
 }
@@ -25,11 +82,97 @@ func NewMatchRepository(db *gorm.DB) MatchRepository {
 	return &matchRepository{db: db}
 }
 
-func (r *matchRepository) Create(ctx context.Context, match *db.Match) error {
+func (r *matchRepository) Create(ctx context.Context, match *db.Match, c Change) error {
 	if match == nil {
 		return errors.New("match is nil")
 	}
-	return r.db.WithContext(ctx).Create(match).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return createMatch(tx, match, c)
+	})
+}
+
+// createMatch is Create inside a transaction the caller runs
+func createMatch(tx *gorm.DB, match *db.Match, c Change) error {
+	if err := tx.Create(match).Error; err != nil {
+		return err
+	}
+	if match.FareBy != "" {
+		p := &db.FareProposal{MatchID: match.ID, ActorID: match.FareBy, Fare: match.Fare, CreatedAt: c.stamped().At}
+		if err := tx.Create(p).Error; err != nil {
+			return err
+		}
+	}
+	return tx.Create(&db.MatchEvent{MatchID: match.ID, StatusChange: c.event("", match.Status)}).Error
+}
+
+// transition applies fields, status among them, to the match if it is
+// still in from and appends the change to its history in one transaction
+func (r *matchRepository) transition(ctx context.Context, matchID, from string, fields map[string]interface{}, c Change) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return transitionMatch(tx, matchID, from, fields, c)
+	})
+}
+
+// transitionMatch is transition inside a transaction the caller runs
+func transitionMatch(tx *gorm.DB, matchID, from string, fields map[string]interface{}, c Change) error {
+	res := tx.Model(&db.Match{}).Where("id = ? AND status = ?", matchID, from).Updates(fields)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return errMatchChanged
+	}
+	to, _ := fields["status"].(string)
+	return tx.Create(&db.MatchEvent{MatchID: matchID, StatusChange: c.event(from, to)}).Error
+}
+
+// cancelFields are the columns Cancel sets, c must be stamped
+func cancelFields(status string, c Change, late bool) map[string]interface{} {
+	return map[string]interface{}{
+		"status":        status,
+		"cancelled_by":  c.ActorID,
+		"cancelled_at":  c.At,
+		"cancel_reason": c.Reason,
+		"late_cancel":   late,
+	}
+}
+
+func (r *matchRepository) ListEvents(ctx context.Context, matchID string) ([]db.MatchEvent, error) {
+	var out []db.MatchEvent
+	err := r.db.WithContext(ctx).
+		Where("match_id = ?", matchID).
+		Order("id ASC").
+		Find(&out).Error
+	return out, err
+}
+
+func (r *matchRepository) CounterFare(ctx context.Context, p *db.FareProposal) error {
+	if p == nil || p.MatchID == "" {
+		return errors.New("proposal and matchID required")
+	}
+	if p.CreatedAt.IsZero() {
+		p.CreatedAt = time.Now().UTC()
+	}
+	fields := map[string]interface{}{
+		"fare":          p.Fare,
+		"fare_by":       p.ActorID,
+		"fare_counters": gorm.Expr("fare_counters + 1"),
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&db.Match{}).Where("id = ?", p.MatchID).Updates(fields).Error; err != nil {
+			return err
+		}
+		return tx.Create(p).Error
+	})
+}
+
+func (r *matchRepository) ListFareProposals(ctx context.Context, matchID string) ([]db.FareProposal, error) {
+	var out []db.FareProposal
+	err := r.db.WithContext(ctx).
+		Where("match_id = ?", matchID).
+		Order("id ASC").
+		Find(&out).Error
+	return out, err
 }
 
 func (r *matchRepository) FindByID(ctx context.Context, id string) (*db.Match, error) {
@@ -44,6 +187,159 @@ func (r *matchRepository) FindByID(ctx context.Context, id string) (*db.Match, e
 	return &out, err
 }
 
+func (r *matchRepository) Cancel(ctx context.Context, matchID, from, status string, c Change, late bool) error {
+	if matchID == "" || status == "" {
+		return errors.New("matchID and status required")
+	}
+	c = c.stamped()
+	return r.transition(ctx, matchID, from, cancelFields(status, c, late), c)
+}
+
+func (r *matchRepository) ReportNoShow(ctx context.Context, matchID, from, userID string, c Change) error {
+	if matchID == "" || userID == "" {
+		return errors.New("matchID and userID required")
+	}
+	c = c.stamped()
+	return r.transition(ctx, matchID, from, map[string]interface{}{
+		"status":              "no_show",
+		"no_show_user_id":     userID,
+		"no_show_reported_at": c.At,
+	}, c)
+}
+
+func (r *matchRepository) SetPin(ctx context.Context, matchID, pinHash string, expires time.Time) error {
+	if matchID == "" || pinHash == "" {
+		return errors.New("matchID and pin required")
+	}
+	return r.db.WithContext(ctx).
+		Model(&db.Match{}).
+		Where("id = ?", matchID).
+		Updates(map[string]interface{}{
+			"pin_hash":     pinHash,
+			"pin_expires":  expires,
+			"pin_attempts": 0,
+		}).Error
+}
+
+func (r *matchRepository) PinMissed(ctx context.Context, matchID string) error {
+	if matchID == "" {
+		return errors.New("matchID required")
+	}
+	return r.db.WithContext(ctx).
+		Model(&db.Match{}).
+		Where("id = ?", matchID).
+		Update("pin_attempts", gorm.Expr("pin_attempts + 1")).Error
+}
+
+func (r *matchRepository) CheckIn(ctx context.Context, matchID string, at time.Time) error {
+	if matchID == "" {
+		return errors.New("matchID required")
+	}
+	return r.db.WithContext(ctx).
+		Model(&db.Match{}).
+		Where("id = ?", matchID).
+		Updates(map[string]interface{}{
+			"started_at":  at,
+			"pin_hash":    "",
+			"pin_expires": nil,
+		}).Error
+}
+
+func (r *matchRepository) Complete(ctx context.Context, matchID, from string, c Change) error {
+	if matchID == "" {
+		return errors.New("matchID required")
+	}
+	c = c.stamped()
+	return r.transition(ctx, matchID, from, map[string]interface{}{
+		"status":       "completed",
+		"completed_at": c.At,
+	}, c)
+}
+
+func (r *matchRepository) Promote(ctx context.Context, matchID, from string, confirmBy time.Time, c Change) error {
+	if matchID == "" {
+		return errors.New("matchID required")
+	}
+	return r.transition(ctx, matchID, from, map[string]interface{}{
+		"status":     "promoted",
+		"confirm_by": confirmBy,
+	}, c)
+}
+
+func (r *matchRepository) Accept(ctx context.Context, matchID, from string, pickupAt, departAt time.Time, c Change) error {
+	if matchID == "" {
+		return errors.New("matchID required")
+	}
+	return r.transition(ctx, matchID, from, map[string]interface{}{
+		"status":    "accepted",
+		"pickup_at": pickupAt,
+		"depart_at": departAt,
+	}, c)
+}
+
+func (r *matchRepository) ListWaitlistRides(ctx context.Context, lapsedBefore time.Time) ([]string, error) {
+	var out []string
+	err := r.db.WithContext(ctx).
+		Model(&db.Match{}).
+		Distinct("ride_id").
+		Where("status = ? OR (status = ? AND confirm_by < ?)", "waitlisted", "promoted", lapsedBefore).
+		Pluck("ride_id", &out).Error
+	return out, err
+}
+
+func (r *matchRepository) TrackRecords(ctx context.Context, userIDs []string) (map[string]TrackRecord, error) {
+	out := make(map[string]TrackRecord, len(userIDs))
+	if len(userIDs) == 0 {
+		return out, nil
+	}
+	type count struct {
+		UserID string
+		N      int
+	}
+	tally := func(column, where string, args ...interface{}) ([]count, error) {
+		var rows []count
+		err := r.db.WithContext(ctx).
+			Model(&db.Match{}).
+			Select(column+" AS user_id, COUNT(*) AS n").
+			Where(column+" IN ?", userIDs).
+			Where(where, args...).
+			Group(column).
+			Scan(&rows).Error
+		return rows, err
+	}
+
+	// a completed match is a trip for both of its sides
+	for _, column := range []string{"rider_id", "driver_id"} {
+		rows, err := tally(column, "status = ?", "completed")
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			rec := out[row.UserID]
+			rec.Completed += row.N
+			out[row.UserID] = rec
+		}
+	}
+	rows, err := tally("cancelled_by", "late_cancel = ?", true)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		rec := out[row.UserID]
+		rec.LateCancels = row.N
+		out[row.UserID] = rec
+	}
+	if rows, err = tally("no_show_user_id", "status = ?", "no_show"); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		rec := out[row.UserID]
+		rec.NoShows = row.N
+		out[row.UserID] = rec
+	}
+	return out, nil
+}
+
 func (r *matchRepository) FindByRideID(ctx context.Context, rideID string) ([]db.Match, error) {
 	if rideID == "" {
 		return []db.Match{}, nil
@@ -68,14 +364,23 @@ func (r *matchRepository) FindByRiderID(ctx context.Context, riderID string) ([]
 	return out, err
 }
 
-func (r *matchRepository) UpdateStatus(ctx context.Context, matchID string, status string) error {
+func (r *matchRepository) FindByPairID(ctx context.Context, pairID string) ([]db.Match, error) {
+	if pairID == "" {
+		return []db.Match{}, nil
+	}
+	var out []db.Match
+	err := r.db.WithContext(ctx).
+		Where("pair_id = ?", pairID).
+		Order("created_at ASC").
+		Find(&out).Error
+	return out, err
+}
+
+func (r *matchRepository) UpdateStatus(ctx context.Context, matchID, from, status string, c Change) error {
 	if matchID == "" || status == "" {
 		return errors.New("matchID and status required")
 	}
-	return r.db.WithContext(ctx).
-		Model(&db.Match{}).
-		Where("id = ?", matchID).
-		Update("status", status).Error
+	return r.transition(ctx, matchID, from, map[string]interface{}{"status": status}, c)
 }
 
 
@@ -94,6 +399,18 @@ func (r *matchRepository) FindActiveByRide(ctx context.Context, rideID string) (
 	return &out, err
 }
 
+func (r *matchRepository) FindByRideIDs(ctx context.Context, rideIDs []string) ([]db.Match, error) {
+	var out []db.Match
+	if len(rideIDs) == 0 {
+		return out, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("ride_id IN ?", rideIDs).
+		Order("created_at DESC").
+		Find(&out).Error
+	return out, err
+}
+
 func (r *matchRepository) ListByDriverID(ctx context.Context, driverID string, limit int) ([]db.Match, error) {
 	if driverID == "" {
 		return []db.Match{}, nil
@@ -108,3 +425,29 @@ func (r *matchRepository) ListByDriverID(ctx context.Context, driverID string, l
 	err := q.Find(&out).Error
 	return out, err
 }
+
+func (r *matchRepository) ListAcceptedForUser(ctx context.Context, userID string) ([]db.Match, error) {
+	if userID == "" {
+		return []db.Match{}, nil
+	}
+	var out []db.Match
+	err := r.db.WithContext(ctx).
+		Preload("Ride").
+		Where("(rider_id = ? OR driver_id = ?) AND status = ?", userID, userID, "accepted").
+		Find(&out).Error
+	return out, err
+}
+
+func (r *matchRepository) ListForRiderDepartingBetween(ctx context.Context, riderID string, statuses []string, from, to time.Time) ([]db.Match, error) {
+	var out []db.Match
+	if riderID == "" || len(statuses) == 0 {
+		return out, nil
+	}
+	err := r.db.WithContext(ctx).
+		Joins("Ride").
+		Where("matches.rider_id = ? AND matches.status IN ?", riderID, statuses).
+		Where("`Ride`.`time` >= ? AND `Ride`.`time` < ?", from, to).
+		Order("`Ride`.`time` ASC").
+		Find(&out).Error
+	return out, err
+}
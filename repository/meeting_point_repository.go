@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"hope/db"
+
+	"gorm.io/gorm"
+)
+
+type MeetingPointRepository interface {
+	Create(ctx context.Context, p *db.MeetingPoint) error
+	FindByID(ctx context.Context, id string) (*db.MeetingPoint, error)
+	// ListNearby only returns points of orgs in orgIDs
+	ListNearby(ctx context.Context, orgIDs []string, geohashPrefix string, limit int) ([]db.MeetingPoint, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type meetingPointRepository struct {
+	db *gorm.DB
+}
+
+func NewMeetingPointRepository(db *gorm.DB) MeetingPointRepository {
+	return &meetingPointRepository{db: db}
+}
+
+func (r *meetingPointRepository) Create(ctx context.Context, p *db.MeetingPoint) error {
+	if p == nil {
+		return errors.New("meeting point is nil")
+	}
+	return r.db.WithContext(ctx).Create(p).Error
+}
+
+func (r *meetingPointRepository) FindByID(ctx context.Context, id string) (*db.MeetingPoint, error) {
+	if id == "" {
+		return nil, nil
+	}
+	var out db.MeetingPoint
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		Take(&out).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &out, err
+}
+
+func (r *meetingPointRepository) ListNearby(ctx context.Context, orgIDs []string, geohashPrefix string, limit int) ([]db.MeetingPoint, error) {
+	var out []db.MeetingPoint
+	if len(orgIDs) == 0 {
+		return out, nil
+	}
+	q := r.db.WithContext(ctx).
+		Where("geohash LIKE ? AND org_id IN ?", geohashPrefix+"%", orgIDs).
+		Order("name ASC")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	err := q.Find(&out).Error
+	return out, err
+}
+
+func (r *meetingPointRepository) Delete(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("id required")
+	}
+	return r.db.WithContext(ctx).
+		Delete(&db.MeetingPoint{}, "id = ?", id).Error
+}
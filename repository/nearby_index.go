@@ -0,0 +1,546 @@
+package repository
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"hope/config"
+	"hope/db"
+	"hope/geo"
+	"hope/spatial"
+
+	"gorm.io/gorm"
+)
+
+// The indexed repositories keep active offers, active requests and user
+// locations in a geohash trie so nearby and radius searches skip the
+// database. Every write still goes to the database first and the index is
+// only touched once it succeeded. If loading the index fails at startup the
+// plain repository is used instead.
+
+// likeAny is "(col LIKE ? OR col LIKE ? ...)" over geohash cells
+func likeAny(column string, cells []string) (string, []interface{}) {
+	parts := make([]string, 0, len(cells))
+	args := make([]interface{}, 0, len(cells))
+	for _, c := range cells {
+		parts = append(parts, column+" LIKE ?")
+		args = append(args, c+"%")
+	}
+	if len(parts) == 0 {
+		return "1 = 0", nil
+	}
+	return "(" + strings.Join(parts, " OR ") + ")", args
+}
+
+// withinRadius drops rows further than meters from lat/lon and sorts the
+// rest closest first
+func withinRadius[T any](rows []T, hash func(T) string, lat, lon, meters float64, limit int) []T {
+	type hit struct {
+		row  T
+		dist float64
+	}
+	hits := make([]hit, 0, len(rows))
+	for _, r := range rows {
+		y, x, err := geo.Decode(hash(r))
+		if err != nil {
+			continue
+		}
+		if d := geo.Distance(lat, lon, y, x); d <= meters {
+			hits = append(hits, hit{row: r, dist: d})
+		}
+	}
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].dist < hits[j].dist })
+	out := make([]T, 0, len(hits))
+	for _, h := range hits {
+		out = append(out, h.row)
+	}
+	return truncate(out, limit)
+}
+
+func truncate[T any](rows []T, limit int) []T {
+	if limit > 0 && len(rows) > limit {
+		return rows[:limit]
+	}
+	return rows
+}
+
+func orgFilter(orgIDs []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(orgIDs))
+	for _, id := range orgIDs {
+		set[id] = struct{}{}
+	}
+	return set
+}
+
+// the index holds pointers so searches don't copy every candidate row,
+// callers only get copies of what survives the limit
+func values[T any](rows []*T) []T {
+	out := make([]T, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, *r)
+	}
+	return out
+}
+
+func hitValues[T any](hits []spatial.Hit[*T], limit int) []T {
+	hits = truncate(hits, limit)
+	out := make([]T, 0, len(hits))
+	for _, h := range hits {
+		out = append(out, *h.Value)
+	}
+	return out
+}
+
+type indexedRideOfferRepository struct {
+	RideOfferRepository
+	idx *spatial.Index[*db.RideOffer]
+	// write+reload+index has to happen as one step or two updates to the
+	// same offer could leave the older row in the index
+	mu sync.Mutex
+}
+
+// NewIndexedRideOfferRepository is NewrideOfferRepository with nearby and
+// radius searches served from memory
+func NewIndexedRideOfferRepository(gdb *gorm.DB, cfg config.NearbyIndex) RideOfferRepository {
+	base := NewrideOfferRepository(gdb)
+	if !cfg.Enabled {
+		return base
+	}
+	var rows []db.RideOffer
+	if err := gdb.Where("status = ?", "active").Find(&rows).Error; err != nil {
+		log.Printf("nearby index: loading offers failed, searching the database instead: %v", err)
+		return base
+	}
+	r := &indexedRideOfferRepository{RideOfferRepository: base, idx: spatial.NewIndex[*db.RideOffer]()}
+	for _, o := range rows {
+		r.put(o)
+	}
+	return r
+}
+
+func (r *indexedRideOfferRepository) put(o db.RideOffer) {
+	if o.Status != "active" {
+		r.idx.Remove(o.ID)
+		return
+	}
+	o.Driver, o.Waypoints, o.Matches, o.ChatMessages, o.Reviews = nil, nil, nil, nil, nil
+	r.idx.Upsert(o.ID, o.FromGeo, &o)
+}
+
+// reload picks up what hooks changed on the row, e.g. status going to
+// matched when the last seat is taken
+func (r *indexedRideOfferRepository) reload(ctx context.Context, id string) {
+	o, err := r.RideOfferRepository.FindByID(ctx, id)
+	if err != nil || o == nil {
+		r.idx.Remove(id)
+		return
+	}
+	r.put(*o)
+}
+
+func (r *indexedRideOfferRepository) Create(ctx context.Context, offer *db.RideOffer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.RideOfferRepository.Create(ctx, offer); err != nil {
+		return err
+	}
+	r.put(*offer)
+	return nil
+}
+
+func (r *indexedRideOfferRepository) CreatePair(ctx context.Context, out, back *db.RideOffer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.RideOfferRepository.CreatePair(ctx, out, back); err != nil {
+		return err
+	}
+	r.put(*out)
+	r.put(*back)
+	return nil
+}
+
+func (r *indexedRideOfferRepository) Update(ctx context.Context, offer *db.RideOffer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.RideOfferRepository.Update(ctx, offer); err != nil {
+		return err
+	}
+	r.reload(ctx, offer.ID)
+	return nil
+}
+
+func (r *indexedRideOfferRepository) Cancel(ctx context.Context, offer *db.RideOffer, matches []db.Match, c Change) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.RideOfferRepository.Cancel(ctx, offer, matches, c); err != nil {
+		return err
+	}
+	r.reload(ctx, offer.ID)
+	return nil
+}
+
+func (r *indexedRideOfferRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.RideOfferRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.idx.Remove(id)
+	return nil
+}
+
+func (r *indexedRideOfferRepository) MoveDriverToOrg(ctx context.Context, driverID, orgID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.RideOfferRepository.MoveDriverToOrg(ctx, driverID, orgID); err != nil {
+		return err
+	}
+	offers, err := r.RideOfferRepository.ListDriverActiveOffers(ctx, driverID, 0)
+	if err != nil {
+		return err
+	}
+	for _, o := range offers {
+		r.put(o)
+	}
+	return nil
+}
+
+func (r *indexedRideOfferRepository) ListNearbyOffers(ctx context.Context, orgIDs []string, geohashPrefix string, limit int) ([]db.RideOffer, error) {
+	if len(orgIDs) == 0 {
+		return nil, nil
+	}
+	orgs := orgFilter(orgIDs)
+	out := r.idx.Prefix(geohashPrefix, func(o *db.RideOffer) bool {
+		_, ok := orgs[o.OrgID]
+		return ok
+	})
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].Time.Equal(out[j].Time) {
+			return out[i].Time.Before(out[j].Time)
+		}
+		return out[i].ID < out[j].ID
+	})
+	return values(truncate(out, limit)), nil
+}
+
+func (r *indexedRideOfferRepository) ListOffersWithinRadius(ctx context.Context, orgIDs []string, lat, lon, meters float64, limit int) ([]db.RideOffer, error) {
+	if len(orgIDs) == 0 || meters <= 0 {
+		return nil, nil
+	}
+	orgs := orgFilter(orgIDs)
+	hits := r.idx.Radius(lat, lon, meters, func(o *db.RideOffer) bool {
+		_, ok := orgs[o.OrgID]
+		return ok
+	})
+	return hitValues(hits, limit), nil
+}
+
+type indexedRideRequestRepository struct {
+	RideRequestRepository
+	idx *spatial.Index[*db.RideRequest]
+	mu  sync.Mutex
+}
+
+// NewIndexedRideRequestRepository is NewRideRequestRepository with nearby
+// and radius searches served from memory
+func NewIndexedRideRequestRepository(gdb *gorm.DB, cfg config.NearbyIndex) RideRequestRepository {
+	base := NewRideRequestRepository(gdb)
+	if !cfg.Enabled {
+		return base
+	}
+	var rows []db.RideRequest
+	if err := gdb.Where("status = ?", "active").Find(&rows).Error; err != nil {
+		log.Printf("nearby index: loading requests failed, searching the database instead: %v", err)
+		return base
+	}
+	r := &indexedRideRequestRepository{RideRequestRepository: base, idx: spatial.NewIndex[*db.RideRequest]()}
+	for _, req := range rows {
+		r.put(req)
+	}
+	return r
+}
+
+func (r *indexedRideRequestRepository) put(req db.RideRequest) {
+	if req.Status != "active" {
+		r.idx.Remove(req.ID)
+		return
+	}
+	req.Rider = nil
+	r.idx.Upsert(req.ID, req.FromGeo, &req)
+}
+
+func (r *indexedRideRequestRepository) Create(ctx context.Context, req *db.RideRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.RideRequestRepository.Create(ctx, req); err != nil {
+		return err
+	}
+	r.put(*req)
+	return nil
+}
+
+// reload picks up the request's row after a status change
+func (r *indexedRideRequestRepository) reload(ctx context.Context, id string) {
+	req, err := r.RideRequestRepository.FindByID(ctx, id)
+	if err != nil || req == nil {
+		r.idx.Remove(id)
+		return
+	}
+	r.put(*req)
+}
+
+func (r *indexedRideRequestRepository) CreatePair(ctx context.Context, out, back *db.RideRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.RideRequestRepository.CreatePair(ctx, out, back); err != nil {
+		return err
+	}
+	r.put(*out)
+	r.put(*back)
+	return nil
+}
+
+func (r *indexedRideRequestRepository) UpdateStatus(ctx context.Context, id string, status string, c Change) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.RideRequestRepository.UpdateStatus(ctx, id, status, c); err != nil {
+		return err
+	}
+	r.reload(ctx, id)
+	return nil
+}
+
+func (r *indexedRideRequestRepository) Take(ctx context.Context, taken []Taken, c Change) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.RideRequestRepository.Take(ctx, taken, c); err != nil {
+		return err
+	}
+	for _, t := range taken {
+		r.reload(ctx, t.RequestID)
+	}
+	return nil
+}
+
+func (r *indexedRideRequestRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.RideRequestRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	r.idx.Remove(id)
+	return nil
+}
+
+func (r *indexedRideRequestRepository) MoveUserToOrg(ctx context.Context, userID, orgID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.RideRequestRepository.MoveUserToOrg(ctx, userID, orgID); err != nil {
+		return err
+	}
+	reqs, err := r.RideRequestRepository.ListActiveByUser(ctx, userID, 0)
+	if err != nil {
+		return err
+	}
+	for _, req := range reqs {
+		r.put(req)
+	}
+	return nil
+}
+
+func (r *indexedRideRequestRepository) ListNearby(ctx context.Context, orgIDs []string, geohashPrefix string, limit int) ([]db.RideRequest, error) {
+	if len(orgIDs) == 0 {
+		return nil, nil
+	}
+	orgs := orgFilter(orgIDs)
+	out := r.idx.Prefix(geohashPrefix, func(req *db.RideRequest) bool {
+		_, ok := orgs[req.OrgID]
+		return ok
+	})
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].Time.Equal(out[j].Time) {
+			return out[i].Time.Before(out[j].Time)
+		}
+		return out[i].ID < out[j].ID
+	})
+	return values(truncate(out, limit)), nil
+}
+
+func (r *indexedRideRequestRepository) ListWithinRadius(ctx context.Context, orgIDs []string, lat, lon, meters float64, limit int) ([]db.RideRequest, error) {
+	if len(orgIDs) == 0 || meters <= 0 {
+		return nil, nil
+	}
+	orgs := orgFilter(orgIDs)
+	hits := r.idx.Radius(lat, lon, meters, func(req *db.RideRequest) bool {
+		_, ok := orgs[req.OrgID]
+		return ok
+	})
+	return hitValues(hits, limit), nil
+}
+
+// indexedUserLocationRepository also applies the freshness cutoff, so with
+// the index turned off (idx nil) it still filters what the database returns
+type indexedUserLocationRepository struct {
+	UserLocationRepository
+	idx *spatial.Index[*db.UserLocation]
+	ttl time.Duration
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// NewIndexedUserLocationRepository is NewUserLocationRepository with
+// nearby and radius searches limited to fresh locations and served from
+// memory
+func NewIndexedUserLocationRepository(gdb *gorm.DB, cfg config.NearbyIndex) UserLocationRepository {
+	r := &indexedUserLocationRepository{UserLocationRepository: NewUserLocationRepository(gdb), ttl: cfg.LocationTTL}
+	if !cfg.Enabled {
+		return r
+	}
+	q := gdb.Model(&db.UserLocation{})
+	if r.ttl > 0 {
+		q = q.Where("updated_at >= ?", time.Now().Add(-r.ttl))
+	}
+	var rows []db.UserLocation
+	if err := q.Find(&rows).Error; err != nil {
+		log.Printf("nearby index: loading locations failed, searching the database instead: %v", err)
+		return r
+	}
+	r.idx = spatial.NewIndex[*db.UserLocation]()
+	for _, l := range rows {
+		r.put(l)
+	}
+	r.lastSweep = time.Now()
+	return r
+}
+
+func (r *indexedUserLocationRepository) fresh(l *db.UserLocation, now time.Time) bool {
+	return r.ttl <= 0 || now.Sub(l.UpdatedAt) <= r.ttl
+}
+
+func (r *indexedUserLocationRepository) put(l db.UserLocation) {
+	l.User = nil
+	r.idx.Upsert(l.UserID, l.Geohash, &l)
+}
+
+// sweep drops stale locations at most once per ttl so the index doesn't
+// keep everyone who ever shared a position
+func (r *indexedUserLocationRepository) sweep(now time.Time) {
+	if r.ttl <= 0 || now.Sub(r.lastSweep) < r.ttl {
+		return
+	}
+	r.lastSweep = now
+	r.idx.RemoveIf(func(l *db.UserLocation) bool { return !r.fresh(l, now) })
+}
+
+func (r *indexedUserLocationRepository) Upsert(ctx context.Context, loc *db.UserLocation) error {
+	if r.idx == nil {
+		return r.UserLocationRepository.Upsert(ctx, loc)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.UserLocationRepository.Upsert(ctx, loc); err != nil {
+		return err
+	}
+	r.put(*loc)
+	r.sweep(time.Now())
+	return nil
+}
+
+func (r *indexedUserLocationRepository) Delete(ctx context.Context, userID string) error {
+	if r.idx == nil {
+		return r.UserLocationRepository.Delete(ctx, userID)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.UserLocationRepository.Delete(ctx, userID); err != nil {
+		return err
+	}
+	r.idx.Remove(userID)
+	return nil
+}
+
+func (r *indexedUserLocationRepository) MoveToOrg(ctx context.Context, userID, orgID string) error {
+	if r.idx == nil {
+		return r.UserLocationRepository.MoveToOrg(ctx, userID, orgID)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.UserLocationRepository.MoveToOrg(ctx, userID, orgID); err != nil {
+		return err
+	}
+	loc, err := r.UserLocationRepository.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if loc == nil {
+		r.idx.Remove(userID)
+		return nil
+	}
+	if r.fresh(loc, time.Now()) {
+		r.put(*loc)
+	}
+	return nil
+}
+
+func (r *indexedUserLocationRepository) ListNearby(ctx context.Context, orgIDs []string, geohashPrefix string, limit int) ([]db.UserLocation, error) {
+	if len(orgIDs) == 0 {
+		return nil, nil
+	}
+	now := time.Now()
+	if r.idx == nil {
+		// newest first, so cutting at the first stale row keeps the limit exact
+		locs, err := r.UserLocationRepository.ListNearby(ctx, orgIDs, geohashPrefix, limit)
+		if err != nil {
+			return nil, err
+		}
+		for i, l := range locs {
+			if !r.fresh(&l, now) {
+				return locs[:i], nil
+			}
+		}
+		return locs, nil
+	}
+	orgs := orgFilter(orgIDs)
+	out := r.idx.Prefix(geohashPrefix, func(l *db.UserLocation) bool {
+		_, ok := orgs[l.OrgID]
+		return ok && r.fresh(l, now)
+	})
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].UpdatedAt.Equal(out[j].UpdatedAt) {
+			return out[i].UpdatedAt.After(out[j].UpdatedAt)
+		}
+		return out[i].UserID < out[j].UserID
+	})
+	return values(truncate(out, limit)), nil
+}
+
+func (r *indexedUserLocationRepository) ListWithinRadius(ctx context.Context, orgIDs []string, lat, lon, meters float64, limit int) ([]db.UserLocation, error) {
+	if len(orgIDs) == 0 || meters <= 0 {
+		return nil, nil
+	}
+	now := time.Now()
+	if r.idx == nil {
+		locs, err := r.UserLocationRepository.ListWithinRadius(ctx, orgIDs, lat, lon, meters, 0)
+		if err != nil {
+			return nil, err
+		}
+		out := locs[:0]
+		for _, l := range locs {
+			if r.fresh(&l, now) {
+				out = append(out, l)
+			}
+		}
+		return truncate(out, limit), nil
+	}
+	orgs := orgFilter(orgIDs)
+	hits := r.idx.Radius(lat, lon, meters, func(l *db.UserLocation) bool {
+		_, ok := orgs[l.OrgID]
+		return ok && r.fresh(l, now)
+	})
+	return hitValues(hits, limit), nil
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"hope/config"
+	"hope/db"
+	"hope/geo"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// benchDB opens the database from DB_* without migrating it, the nearby
+// benchmarks only read. They are skipped when DB_HOST is unset
+func benchDB(b *testing.B) *gorm.DB {
+	b.Helper()
+	cfg := config.GetDatabaseConfig()
+	if cfg.Host == "" {
+		b.Skip("DB_HOST not set")
+	}
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=True&loc=Local",
+		cfg.User, cfg.Password, cfg.Host, cfg.Port, cfg.Database)
+	gdb, err := gorm.Open(mysql.Open(dsn), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		b.Fatalf("database: %v", err)
+	}
+	return gdb
+}
+
+// benchCenters takes query centers and org ids from active offers already
+// in the database
+func benchCenters(b *testing.B, gdb *gorm.DB) (orgIDs, centers []string) {
+	b.Helper()
+	var rows []db.RideOffer
+	if err := gdb.Where("status = ?", "active").Limit(1000).Find(&rows).Error; err != nil {
+		b.Fatalf("load offers: %v", err)
+	}
+	if len(rows) == 0 {
+		b.Skip("no active offers to search around")
+	}
+	seen := map[string]bool{}
+	for _, o := range rows {
+		centers = append(centers, o.FromGeo)
+		if !seen[o.OrgID] {
+			seen[o.OrgID] = true
+			orgIDs = append(orgIDs, o.OrgID)
+		}
+	}
+	return orgIDs, centers
+}
+
+func benchNearbyOffers(b *testing.B, indexed bool) {
+	gdb := benchDB(b)
+	orgIDs, centers := benchCenters(b, gdb)
+	repo := NewrideOfferRepository(gdb)
+	if indexed {
+		repo = NewIndexedRideOfferRepository(gdb, config.NearbyIndex{Enabled: true})
+	}
+	ctx := context.Background()
+
+	b.Run("prefix", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			c := centers[i%len(centers)]
+			if len(c) > 5 {
+				c = c[:5]
+			}
+			if _, err := repo.ListNearbyOffers(ctx, orgIDs, c, 50); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("radius", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			lat, lon, _ := geo.Decode(centers[i%len(centers)])
+			if _, err := repo.ListOffersWithinRadius(ctx, orgIDs, lat, lon, 2000, 50); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkNearbyOffersIndex(b *testing.B) { benchNearbyOffers(b, true) }
+
+func BenchmarkNearbyOffersSQL(b *testing.B) { benchNearbyOffers(b, false) }
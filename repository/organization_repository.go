@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"hope/db"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OrganizationRepository interface {
+	Create(ctx context.Context, org *db.Organization) error
+	FindByID(ctx context.Context, id string) (*db.Organization, error)
+	FindByDomain(ctx context.Context, domain string) (*db.Organization, error)
+	List(ctx context.Context, limit int) ([]db.Organization, error)
+	AddDomain(ctx context.Context, domain *db.OrganizationDomain) error
+	RemoveDomain(ctx context.Context, domain string) error
+	ListDomains(ctx context.Context, orgID string) ([]db.OrganizationDomain, error)
+	CreateSharing(ctx context.Context, agreement *db.OrgSharingAgreement) error
+	FindSharing(ctx context.Context, orgID, partnerOrgID string) (*db.OrgSharingAgreement, error)
+	DeleteSharing(ctx context.Context, id string) error
+	ListSharing(ctx context.Context, orgID string) ([]db.OrgSharingAgreement, error)
+	ListPartnerOrgIDs(ctx context.Context, orgID string) ([]string, error)
+	// FindFarePolicy is nil for orgs that did not set their own
+	FindFarePolicy(ctx context.Context, orgID string) (*db.OrgFarePolicy, error)
+	// SaveFarePolicy replaces the org's fare policy
+	SaveFarePolicy(ctx context.Context, policy *db.OrgFarePolicy) error
+}
+
+type organizationRepository struct {
+	db *gorm.DB
+}
+
+func NewOrganizationRepository(db *gorm.DB) OrganizationRepository {
+	return &organizationRepository{db: db}
+}
+
+func (r *organizationRepository) Create(ctx context.Context, org *db.Organization) error {
+	if org == nil {
+		return errors.New("organization is nil")
+	}
+	return r.db.WithContext(ctx).Create(org).Error
+}
+
+func (r *organizationRepository) FindByID(ctx context.Context, id string) (*db.Organization, error) {
+	if id == "" {
+		return nil, nil
+	}
+	var out db.Organization
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		Take(&out).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &out, err
+}
+
+func (r *organizationRepository) FindByDomain(ctx context.Context, domain string) (*db.Organization, error) {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	if domain == "" {
+		return nil, nil
+	}
+	var out db.Organization
+	err := r.db.WithContext(ctx).
+		Joins("JOIN organization_domains d ON d.org_id = organizations.id").
+		Where("d.domain = ?", domain).
+		Take(&out).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &out, err
+}
+
+func (r *organizationRepository) List(ctx context.Context, limit int) ([]db.Organization, error) {
+	var out []db.Organization
+	q := r.db.WithContext(ctx).
+		Preload("Domains").
+		Order("name ASC")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	err := q.Find(&out).Error
+	return out, err
+}
+
+func (r *organizationRepository) AddDomain(ctx context.Context, domain *db.OrganizationDomain) error {
+	if domain == nil {
+		return errors.New("domain is nil")
+	}
+	return r.db.WithContext(ctx).Create(domain).Error
+}
+
+func (r *organizationRepository) RemoveDomain(ctx context.Context, domain string) error {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	if domain == "" {
+		return errors.New("domain required")
+	}
+	return r.db.WithContext(ctx).
+		Delete(&db.OrganizationDomain{}, "domain = ?", domain).Error
+}
+
+func (r *organizationRepository) ListDomains(ctx context.Context, orgID string) ([]db.OrganizationDomain, error) {
+	var out []db.OrganizationDomain
+	err := r.db.WithContext(ctx).
+		Where("org_id = ?", orgID).
+		Order("domain ASC").
+		Find(&out).Error
+	return out, err
+}
+
+func (r *organizationRepository) CreateSharing(ctx context.Context, agreement *db.OrgSharingAgreement) error {
+	if agreement == nil {
+		return errors.New("agreement is nil")
+	}
+	return r.db.WithContext(ctx).Create(agreement).Error
+}
+
+// FindSharing looks the pair up in both directions since agreements are symmetric
+func (r *organizationRepository) FindSharing(ctx context.Context, orgID, partnerOrgID string) (*db.OrgSharingAgreement, error) {
+	var out db.OrgSharingAgreement
+	err := r.db.WithContext(ctx).
+		Where("(org_id = ? AND partner_org_id = ?) OR (org_id = ? AND partner_org_id = ?)",
+			orgID, partnerOrgID, partnerOrgID, orgID).
+		Take(&out).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &out, err
+}
+
+func (r *organizationRepository) DeleteSharing(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("id required")
+	}
+	return r.db.WithContext(ctx).
+		Delete(&db.OrgSharingAgreement{}, "id = ?", id).Error
+}
+
+func (r *organizationRepository) ListSharing(ctx context.Context, orgID string) ([]db.OrgSharingAgreement, error) {
+	var out []db.OrgSharingAgreement
+	err := r.db.WithContext(ctx).
+		Where("org_id = ? OR partner_org_id = ?", orgID, orgID).
+		Order("created_at DESC").
+		Find(&out).Error
+	return out, err
+}
+
+func (r *organizationRepository) ListPartnerOrgIDs(ctx context.Context, orgID string) ([]string, error) {
+	if orgID == "" {
+		return []string{}, nil
+	}
+	agreements, err := r.ListSharing(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, 0, len(agreements))
+	for _, a := range agreements {
+		if a.OrgID == orgID {
+			out = append(out, a.PartnerOrgID)
+		} else {
+			out = append(out, a.OrgID)
+		}
+	}
+	return out, nil
+}
+
+func (r *organizationRepository) FindFarePolicy(ctx context.Context, orgID string) (*db.OrgFarePolicy, error) {
+	if orgID == "" {
+		return nil, nil
+	}
+	var out db.OrgFarePolicy
+	err := r.db.WithContext(ctx).Where("org_id = ?", orgID).Take(&out).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &out, err
+}
+
+func (r *organizationRepository) SaveFarePolicy(ctx context.Context, policy *db.OrgFarePolicy) error {
+	if policy == nil {
+		return errors.New("policy is nil")
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "org_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"min_ratio", "max_ratio", "max_counters", "updated_by", "updated_at"}),
+		}).
+		Create(policy).Error
+}
@@ -15,6 +15,15 @@ type ReviewRepository interface {
 	Delete(ctx context.Context, reviewID string) error
 	ListReceivedByUser(ctx context.Context, userID string, limit int) ([]db.Review, error)
 	GetByID(ctx context.Context, id string) (*db.Review, error)
+	// RatingsFor is the average score each user received, users without
+	// reviews are left out
+	RatingsFor(ctx context.Context, userIDs []string) (map[string]Rating, error)
+}
+
+// Rating summarizes the reviews a user received
+type Rating struct {
+	Average float64
+	Count   int
 }
 
 type reviewRepository struct {
@@ -87,3 +96,28 @@ func (r *reviewRepository) GetByID(ctx context.Context, id string) (*db.Review,
 	}
 	return &out, err
 }
+
+func (r *reviewRepository) RatingsFor(ctx context.Context, userIDs []string) (map[string]Rating, error) {
+	out := make(map[string]Rating, len(userIDs))
+	if len(userIDs) == 0 {
+		return out, nil
+	}
+	var rows []struct {
+		ToUserID string
+		Average  float64
+		Count    int
+	}
+	err := r.db.WithContext(ctx).
+		Model(&db.Review{}).
+		Select("to_user_id, AVG(score) AS average, COUNT(*) AS count").
+		Where("to_user_id IN ?", userIDs).
+		Group("to_user_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		out[row.ToUserID] = Rating{Average: row.Average, Count: row.Count}
+	}
+	return out, nil
+}
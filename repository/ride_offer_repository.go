@@ -4,18 +4,40 @@ import (
 	"context"
 	"errors"
 	"hope/db"
+	"hope/geo"
+	"time"
 	"gorm.io/gorm"
 )
 
 type RideOfferRepository interface {
+	// Create, Update when it changes the status, and Delete append to the
+	// offer's history. Only its driver changes an offer, so they are the actor
 	Create(ctx context.Context, offer *db.RideOffer) error
+	// CreatePair is Create for both legs of a round trip, neither is kept
+	// when one fails
+	CreatePair(ctx context.Context, out, back *db.RideOffer) error
 	FindByID(ctx context.Context, id string) (*db.RideOffer, error)
 	Update(ctx context.Context, offer *db.RideOffer) error
 	Delete(ctx context.Context, id string) error
-	ListNearbyOffers(ctx context.Context, geohashPrefix string, limit int) ([]db.RideOffer, error)
+	// Cancel saves the cancelled offer and cancels matches with it in one
+	// transaction, each from the status it was read in and late when its
+	// LateCancel is set
+	Cancel(ctx context.Context, offer *db.RideOffer, matches []db.Match, c Change) error
+	// ListEvents is the offer's status history, oldest first
+	ListEvents(ctx context.Context, offerID string) ([]db.OfferEvent, error)
+	ListNearbyOffers(ctx context.Context, orgIDs []string, geohashPrefix string, limit int) ([]db.RideOffer, error)
+	// ListOffersWithinRadius is ListNearbyOffers around a point, closest first
+	ListOffersWithinRadius(ctx context.Context, orgIDs []string, lat, lon, meters float64, limit int) ([]db.RideOffer, error)
 	FindByIDWithDriver(ctx context.Context, id string) (*db.RideOffer, error)
 	ListDriverActiveOffers(ctx context.Context, driverID string, limit int) ([]db.RideOffer, error)
 	ListByDriver(ctx context.Context, driverID string, limit int) ([]db.RideOffer, error)
+	// FindByPairID is both legs of a round trip, outbound first
+	FindByPairID(ctx context.Context, pairID string) ([]db.RideOffer, error)
+	// ListDepartingBetween is the driver's open offers leaving from from
+	// until before to, earliest first
+	ListDepartingBetween(ctx context.Context, driverID string, from, to time.Time) ([]db.RideOffer, error)
+	// MoveDriverToOrg moves the driver's active and matched offers to orgID
+	MoveDriverToOrg(ctx context.Context, driverID, orgID string) error
 }
 
 type rideOfferRepository struct {
@@ -31,7 +53,30 @@ func (r *rideOfferRepository) Create(ctx context.Context, offer *db.RideOffer) e
 	if offer == nil {
 		return errors.New("offer is nil")
 	}
-	return r.db.WithContext(ctx).Create(offer).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return createOffer(tx, offer)
+	})
+}
+
+// createOffer is Create inside a transaction the caller runs
+func createOffer(tx *gorm.DB, offer *db.RideOffer) error {
+	if err := tx.Create(offer).Error; err != nil {
+		return err
+	}
+	c := Change{ActorID: offer.DriverID}
+	return tx.Create(&db.OfferEvent{OfferID: offer.ID, StatusChange: c.event("", offer.Status)}).Error
+}
+
+func (r *rideOfferRepository) CreatePair(ctx context.Context, out, back *db.RideOffer) error {
+	if out == nil || back == nil {
+		return errors.New("offer is nil")
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := createOffer(tx, out); err != nil {
+			return err
+		}
+		return createOffer(tx, back)
+	})
 }
 
 func (r *rideOfferRepository) FindByID(ctx context.Context, id string) (*db.RideOffer, error) {
@@ -52,21 +97,82 @@ func (r *rideOfferRepository) Update(ctx context.Context, offer *db.RideOffer) e
 	if offer == nil || offer.ID == "" {
 		return errors.New("offer or ID missing")
 	}
-	return r.db.WithContext(ctx).Save(offer).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return saveOffer(tx, offer)
+	})
+}
+
+// saveOffer is Update inside a transaction the caller runs
+func saveOffer(tx *gorm.DB, offer *db.RideOffer) error {
+	from, err := statusOf(tx, &db.RideOffer{}, offer.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if err := tx.Save(offer).Error; err != nil {
+		return err
+	}
+	if offer.Status == from {
+		return nil
+	}
+	c := Change{ActorID: offer.DriverID}
+	if offer.Status == "cancelled" {
+		c = Change{ActorID: offer.CancelledBy, Reason: offer.CancelReason}
+	}
+	return tx.Create(&db.OfferEvent{OfferID: offer.ID, StatusChange: c.event(from, offer.Status)}).Error
+}
+
+func (r *rideOfferRepository) Cancel(ctx context.Context, offer *db.RideOffer, matches []db.Match, c Change) error {
+	if offer == nil || offer.ID == "" {
+		return errors.New("offer or ID missing")
+	}
+	c = c.stamped()
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, m := range matches {
+			if err := transitionMatch(tx, m.ID, m.Status, cancelFields("cancelled", c, m.LateCancel), c); err != nil {
+				return err
+			}
+		}
+		return saveOffer(tx, offer)
+	})
 }
 
 func (r *rideOfferRepository) Delete(ctx context.Context, id string) error {
 	if id == "" {
 		return errors.New("id required")
 	}
-	return r.db.WithContext(ctx).
-		Delete(&db.RideOffer{}, "id = ?", id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var offer db.RideOffer
+		if err := tx.Select("id", "driver_id", "status").Where("id = ?", id).Take(&offer).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+		if err := tx.Delete(&db.RideOffer{}, "id = ?", id).Error; err != nil {
+			return err
+		}
+		c := Change{ActorID: offer.DriverID}
+		return tx.Create(&db.OfferEvent{OfferID: id, StatusChange: c.event(offer.Status, "deleted")}).Error
+	})
+}
+
+func (r *rideOfferRepository) ListEvents(ctx context.Context, offerID string) ([]db.OfferEvent, error) {
+	var out []db.OfferEvent
+	err := r.db.WithContext(ctx).
+		Where("offer_id = ?", offerID).
+		Order("id ASC").
+		Find(&out).Error
+	return out, err
 }
 
-func (r *rideOfferRepository) ListNearbyOffers(ctx context.Context, geohashPrefix string, limit int) ([]db.RideOffer, error) {
+// ListNearbyOffers only returns active offers owned by one of orgIDs
+func (r *rideOfferRepository) ListNearbyOffers(ctx context.Context, orgIDs []string, geohashPrefix string, limit int) ([]db.RideOffer, error) {
 	var offers []db.RideOffer
+	if len(orgIDs) == 0 {
+		return offers, nil
+	}
 	q := r.db.WithContext(ctx).
-		Where("from_geo LIKE ?", geohashPrefix+"%").
+		Where("from_geo LIKE ? AND org_id IN ? AND status = ?", geohashPrefix+"%", orgIDs, "active").
 		Order("time ASC")
 	if limit > 0 {
 		q = q.Limit(limit)
@@ -75,6 +181,22 @@ func (r *rideOfferRepository) ListNearbyOffers(ctx context.Context, geohashPrefi
 	return offers, err
 }
 
+func (r *rideOfferRepository) ListOffersWithinRadius(ctx context.Context, orgIDs []string, lat, lon, meters float64, limit int) ([]db.RideOffer, error) {
+	var offers []db.RideOffer
+	if len(orgIDs) == 0 || meters <= 0 {
+		return offers, nil
+	}
+	cells, args := likeAny("from_geo", geo.Cover(lat, lon, meters))
+	err := r.db.WithContext(ctx).
+		Where(cells, args...).
+		Where("org_id IN ? AND status = ?", orgIDs, "active").
+		Find(&offers).Error
+	if err != nil {
+		return nil, err
+	}
+	return withinRadius(offers, func(o db.RideOffer) string { return o.FromGeo }, lat, lon, meters, limit), nil
+}
+
 func (r *rideOfferRepository) FindByIDWithDriver(ctx context.Context, id string) (*db.RideOffer, error) {
 	if id == "" {
 		return nil, nil
@@ -115,3 +237,32 @@ func (r *rideOfferRepository) ListByDriver(ctx context.Context, driverID string,
 	return offers, err
 
 }
+
+func (r *rideOfferRepository) FindByPairID(ctx context.Context, pairID string) ([]db.RideOffer, error) {
+	if pairID == "" {
+		return []db.RideOffer{}, nil
+	}
+	var offers []db.RideOffer
+	err := r.db.WithContext(ctx).
+		Where("pair_id = ?", pairID).
+		Order("time ASC").
+		Find(&offers).Error
+	return offers, err
+}
+
+func (r *rideOfferRepository) ListDepartingBetween(ctx context.Context, driverID string, from, to time.Time) ([]db.RideOffer, error) {
+	var offers []db.RideOffer
+	err := r.db.WithContext(ctx).
+		Where("driver_id = ? AND status IN ?", driverID, []string{"active", "matched"}).
+		Where("time >= ? AND time < ?", from, to).
+		Order("time ASC").
+		Find(&offers).Error
+	return offers, err
+}
+
+func (r *rideOfferRepository) MoveDriverToOrg(ctx context.Context, driverID, orgID string) error {
+	return r.db.WithContext(ctx).
+		Model(&db.RideOffer{}).
+		Where("driver_id = ? AND status IN ?", driverID, []string{"active", "matched"}).
+		Update("org_id", orgID).Error
+}
@@ -4,21 +4,54 @@ import (
 	"context"
 	"errors"
 	"hope/db"
+	"hope/geo"
+	"time"
 	"gorm.io/gorm"
 )
 
 
 type RideRequestRepository interface {
+	// Create, UpdateStatus and Delete append to the request's history,
+	// Create and Delete with its rider as the actor
 	Create(ctx context.Context, req *db.RideRequest) error
+	// CreatePair is Create for both legs of a round trip, neither is kept
+	// when one fails
+	CreatePair(ctx context.Context, out, back *db.RideRequest) error
+	// Take creates each taken request's offer and match and moves the
+	// request from active to matched, all in one transaction. A request
+	// that is no longer active fails it with an invalid state error
+	Take(ctx context.Context, taken []Taken, c Change) error
 	FindByID(ctx context.Context, id string) (*db.RideRequest, error)
-	UpdateStatus(ctx context.Context, id string, status string) error
+	UpdateStatus(ctx context.Context, id string, status string, c Change) error
 	Delete(ctx context.Context, id string) error
-	ListNearby(ctx context.Context, geohashPrefix string, limit int) ([]db.RideRequest, error)
+	// ListEvents is the request's status history, oldest first
+	ListEvents(ctx context.Context, requestID string) ([]db.RequestEvent, error)
+	ListNearby(ctx context.Context, orgIDs []string, geohashPrefix string, limit int) ([]db.RideRequest, error)
+	// ListWithinRadius is ListNearby around a point, closest first
+	ListWithinRadius(ctx context.Context, orgIDs []string, lat, lon, meters float64, limit int) ([]db.RideRequest, error)
 	ListByUser(ctx context.Context, userID string, limit int) ([]db.RideRequest, error)
+	// FindByPairID is both legs of a round trip, outbound first
+	FindByPairID(ctx context.Context, pairID string) ([]db.RideRequest, error)
 	FindByIDWithUser(ctx context.Context, id string) (*db.RideRequest, error)
 	ListActiveByUser(ctx context.Context, userID string, limit int) ([]db.RideRequest, error)
+	// ListDepartingBetween is the user's active requests leaving from from
+	// until before to, earliest first
+	ListDepartingBetween(ctx context.Context, userID string, from, to time.Time) ([]db.RideRequest, error)
+	// MoveUserToOrg moves the user's active and matched requests to orgID
+	MoveUserToOrg(ctx context.Context, userID, orgID string) error
 }
 
+// Taken is a request a driver took: the offer made of it and the rider's
+// match on that offer
+type Taken struct {
+	RequestID string
+	Offer     *db.RideOffer
+	Match     *db.Match
+}
+
+// errRequestTaken is Take losing the race to another driver or the rider
+var errRequestTaken = errors.New("invalid state: the request is no longer active")
+
 type rideRequestRepository struct {
 	db *gorm.DB
 }
@@ -31,7 +64,58 @@ func (r *rideRequestRepository) Create(ctx context.Context, req *db.RideRequest)
 	if req == nil {
 		return errors.New("request is nil")
 	}
-	return r.db.WithContext(ctx).Create(req).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return createRequest(tx, req)
+	})
+}
+
+// createRequest is Create inside a transaction the caller runs
+func createRequest(tx *gorm.DB, req *db.RideRequest) error {
+	if err := tx.Create(req).Error; err != nil {
+		return err
+	}
+	c := Change{ActorID: req.UserID}
+	return tx.Create(&db.RequestEvent{RequestID: req.ID, StatusChange: c.event("", req.Status)}).Error
+}
+
+func (r *rideRequestRepository) CreatePair(ctx context.Context, out, back *db.RideRequest) error {
+	if out == nil || back == nil {
+		return errors.New("request is nil")
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := createRequest(tx, out); err != nil {
+			return err
+		}
+		return createRequest(tx, back)
+	})
+}
+
+func (r *rideRequestRepository) Take(ctx context.Context, taken []Taken, c Change) error {
+	c = c.stamped()
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, t := range taken {
+			if t.Offer == nil || t.Match == nil {
+				return errors.New("offer or match missing")
+			}
+			res := tx.Model(&db.RideRequest{}).Where("id = ? AND status = ?", t.RequestID, "active").Update("status", "matched")
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected == 0 {
+				return errRequestTaken
+			}
+			if err := tx.Create(&db.RequestEvent{RequestID: t.RequestID, StatusChange: c.event("active", "matched")}).Error; err != nil {
+				return err
+			}
+			if err := createOffer(tx, t.Offer); err != nil {
+				return err
+			}
+			if err := createMatch(tx, t.Match, c); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 func (r *rideRequestRepository) FindByID(ctx context.Context, id string) (*db.RideRequest, error) {
@@ -48,10 +132,14 @@ func (r *rideRequestRepository) FindByID(ctx context.Context, id string) (*db.Ri
 	return &out, err
 }
 
-func (r *rideRequestRepository) ListNearby(ctx context.Context, geohashPrefix string, limit int) ([]db.RideRequest, error) {
+// ListNearby only returns active requests owned by one of orgIDs
+func (r *rideRequestRepository) ListNearby(ctx context.Context, orgIDs []string, geohashPrefix string, limit int) ([]db.RideRequest, error) {
 	var reqs []db.RideRequest
+	if len(orgIDs) == 0 {
+		return reqs, nil
+	}
 	q := r.db.WithContext(ctx).
-		Where("from_geo LIKE ?", geohashPrefix+"%").
+		Where("from_geo LIKE ? AND org_id IN ? AND status = ?", geohashPrefix+"%", orgIDs, "active").
 		Order("time ASC")
 	if limit > 0 {
 		q = q.Limit(limit)
@@ -60,6 +148,22 @@ func (r *rideRequestRepository) ListNearby(ctx context.Context, geohashPrefix st
 	return reqs, err
 }
 
+func (r *rideRequestRepository) ListWithinRadius(ctx context.Context, orgIDs []string, lat, lon, meters float64, limit int) ([]db.RideRequest, error) {
+	var reqs []db.RideRequest
+	if len(orgIDs) == 0 || meters <= 0 {
+		return reqs, nil
+	}
+	cells, args := likeAny("from_geo", geo.Cover(lat, lon, meters))
+	err := r.db.WithContext(ctx).
+		Where(cells, args...).
+		Where("org_id IN ? AND status = ?", orgIDs, "active").
+		Find(&reqs).Error
+	if err != nil {
+		return nil, err
+	}
+	return withinRadius(reqs, func(r db.RideRequest) string { return r.FromGeo }, lat, lon, meters, limit), nil
+}
+
 func (r *rideRequestRepository) ListByUser(ctx context.Context, userID string, limit int) ([]db.RideRequest, error) {
 	var reqs []db.RideRequest
 	q := r.db.WithContext(ctx).
@@ -72,22 +176,61 @@ func (r *rideRequestRepository) ListByUser(ctx context.Context, userID string, l
 	return reqs, err
 }
 
-func (r *rideRequestRepository) UpdateStatus(ctx context.Context, id string, status string) error {
+func (r *rideRequestRepository) FindByPairID(ctx context.Context, pairID string) ([]db.RideRequest, error) {
+	if pairID == "" {
+		return []db.RideRequest{}, nil
+	}
+	var reqs []db.RideRequest
+	err := r.db.WithContext(ctx).
+		Where("pair_id = ?", pairID).
+		Order("time ASC").
+		Find(&reqs).Error
+	return reqs, err
+}
+
+func (r *rideRequestRepository) UpdateStatus(ctx context.Context, id string, status string, c Change) error {
 	if id == "" || status == "" {
 		return errors.New("id and status required")
 	}
-	return r.db.WithContext(ctx).
-		Model(&db.RideRequest{}).
-		Where("id = ?", id).
-		Update("status", status).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		from, err := statusOf(tx, &db.RideRequest{}, id)
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&db.RideRequest{}).Where("id = ?", id).Update("status", status).Error; err != nil {
+			return err
+		}
+		return tx.Create(&db.RequestEvent{RequestID: id, StatusChange: c.event(from, status)}).Error
+	})
 }
 
 func (r *rideRequestRepository) Delete(ctx context.Context, id string) error {
 	if id == "" {
 		return errors.New("id required")
 	}
-	return r.db.WithContext(ctx).
-		Delete(&db.RideRequest{}, "id = ?", id).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var req db.RideRequest
+		if err := tx.Select("id", "user_id", "status").Where("id = ?", id).Take(&req).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+		if err := tx.Delete(&db.RideRequest{}, "id = ?", id).Error; err != nil {
+			return err
+		}
+		c := Change{ActorID: req.UserID}
+		return tx.Create(&db.RequestEvent{RequestID: id, StatusChange: c.event(req.Status, "deleted")}).Error
+	})
+}
+
+func (r *rideRequestRepository) ListEvents(ctx context.Context, requestID string) ([]db.RequestEvent, error) {
+	var out []db.RequestEvent
+	err := r.db.WithContext(ctx).
+		Where("request_id = ?", requestID).
+		Order("id ASC").
+		Find(&out).Error
+	return out, err
 }
 
 func (r *rideRequestRepository) FindByIDWithUser(ctx context.Context, id string) (*db.RideRequest, error) {
@@ -116,3 +259,20 @@ func (r *rideRequestRepository) ListActiveByUser(ctx context.Context, userID str
 	err := q.Find(&reqs).Error
 	return reqs, err
 }
+
+func (r *rideRequestRepository) ListDepartingBetween(ctx context.Context, userID string, from, to time.Time) ([]db.RideRequest, error) {
+	var reqs []db.RideRequest
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status = ?", userID, "active").
+		Where("time >= ? AND time < ?", from, to).
+		Order("time ASC").
+		Find(&reqs).Error
+	return reqs, err
+}
+
+func (r *rideRequestRepository) MoveUserToOrg(ctx context.Context, userID, orgID string) error {
+	return r.db.WithContext(ctx).
+		Model(&db.RideRequest{}).
+		Where("user_id = ? AND status IN ?", userID, []string{"active", "matched"}).
+		Update("org_id", orgID).Error
+}
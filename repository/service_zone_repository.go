@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"hope/db"
+
+	"gorm.io/gorm"
+)
+
+type ServiceZoneRepository interface {
+	Create(ctx context.Context, zone *db.ServiceZone) error
+	FindByID(ctx context.Context, id string) (*db.ServiceZone, error)
+	ListByOrg(ctx context.Context, orgID string) ([]db.ServiceZone, error)
+	Delete(ctx context.Context, id string) error
+}
+
+type serviceZoneRepository struct {
+	db *gorm.DB
+}
+
+func NewServiceZoneRepository(db *gorm.DB) ServiceZoneRepository {
+	return &serviceZoneRepository{db: db}
+}
+
+func (r *serviceZoneRepository) Create(ctx context.Context, zone *db.ServiceZone) error {
+	if zone == nil {
+		return errors.New("zone is nil")
+	}
+	return r.db.WithContext(ctx).Create(zone).Error
+}
+
+func (r *serviceZoneRepository) FindByID(ctx context.Context, id string) (*db.ServiceZone, error) {
+	if id == "" {
+		return nil, nil
+	}
+	var out db.ServiceZone
+	err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		Take(&out).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return &out, err
+}
+
+func (r *serviceZoneRepository) ListByOrg(ctx context.Context, orgID string) ([]db.ServiceZone, error) {
+	var out []db.ServiceZone
+	err := r.db.WithContext(ctx).
+		Where("org_id = ?", orgID).
+		Order("created_at ASC").
+		Find(&out).Error
+	return out, err
+}
+
+func (r *serviceZoneRepository) Delete(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("id required")
+	}
+	return r.db.WithContext(ctx).
+		Delete(&db.ServiceZone{}, "id = ?", id).Error
+}
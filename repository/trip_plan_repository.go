@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"hope/db"
+
+	"gorm.io/gorm"
+)
+
+type TripPlanRepository interface {
+	// FindByRide is rideID's plan with its stops in order, nil when none
+	// has been built yet
+	FindByRide(ctx context.Context, rideID string) (*db.TripPlan, error)
+	// Save replaces the ride's plan and all of its stops
+	Save(ctx context.Context, plan *db.TripPlan) error
+}
+
+type tripPlanRepository struct {
+	db *gorm.DB
+}
+
+func NewTripPlanRepository(db *gorm.DB) TripPlanRepository {
+	return &tripPlanRepository{db: db}
+}
+
+func (r *tripPlanRepository) FindByRide(ctx context.Context, rideID string) (*db.TripPlan, error) {
+	var plan db.TripPlan
+	err := r.db.WithContext(ctx).
+		Preload("Stops", func(tx *gorm.DB) *gorm.DB { return tx.Order("seq ASC") }).
+		Where("ride_id = ?", rideID).
+		First(&plan).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+func (r *tripPlanRepository) Save(ctx context.Context, plan *db.TripPlan) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("ride_id = ?", plan.RideID).Delete(&db.TripPlanStop{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("ride_id = ?", plan.RideID).Delete(&db.TripPlan{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(plan).Error
+	})
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"hope/db"
+
+	"gorm.io/gorm"
+)
+
+type TripPointRepository interface {
+	Create(ctx context.Context, p *db.TripPoint) error
+	// ListTrack is userID's points on rideID, oldest first
+	ListTrack(ctx context.Context, rideID, userID string) ([]db.TripPoint, error)
+	// ListRecentByUser is userID's points since the given time on any ride, oldest first
+	ListRecentByUser(ctx context.Context, userID string, since time.Time) ([]db.TripPoint, error)
+	DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+type tripPointRepository struct {
+	db *gorm.DB
+}
+
+func NewTripPointRepository(db *gorm.DB) TripPointRepository {
+	return &tripPointRepository{db: db}
+}
+
+func (r *tripPointRepository) Create(ctx context.Context, p *db.TripPoint) error {
+	if p == nil {
+		return errors.New("trip point is nil")
+	}
+	if p.RecordedAt.IsZero() {
+		p.RecordedAt = time.Now().UTC()
+	}
+	return r.db.WithContext(ctx).Create(p).Error
+}
+
+func (r *tripPointRepository) ListTrack(ctx context.Context, rideID, userID string) ([]db.TripPoint, error) {
+	var out []db.TripPoint
+	if rideID == "" || userID == "" {
+		return out, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("ride_id = ? AND user_id = ?", rideID, userID).
+		Order("recorded_at ASC").
+		Find(&out).Error
+	return out, err
+}
+
+func (r *tripPointRepository) DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).
+		Where("recorded_at < ?", cutoff).
+		Delete(&db.TripPoint{})
+	return res.RowsAffected, res.Error
+}
+
+func (r *tripPointRepository) ListRecentByUser(ctx context.Context, userID string, since time.Time) ([]db.TripPoint, error) {
+	var out []db.TripPoint
+	if userID == "" {
+		return out, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND recorded_at >= ?", userID, since).
+		Order("recorded_at ASC").
+		Find(&out).Error
+	return out, err
+}
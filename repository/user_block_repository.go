@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"hope/db"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UserBlockRepository interface {
+	Upsert(ctx context.Context, block *db.UserBlock) error
+	Delete(ctx context.Context, blockerID, blockedID string) error
+	ListByBlocker(ctx context.Context, blockerID string, limit int) ([]db.UserBlock, error)
+	// ListInvolving returns every row where userID is either side
+	ListInvolving(ctx context.Context, userID string) ([]db.UserBlock, error)
+	ExistsBetween(ctx context.Context, a, b, kind string) (bool, error)
+}
+
+type userBlockRepository struct {
+	db *gorm.DB
+}
+
+func NewUserBlockRepository(db *gorm.DB) UserBlockRepository {
+	return &userBlockRepository{db: db}
+}
+
+// Upsert lets a mute be upgraded to a block (and back) without a delete first
+func (r *userBlockRepository) Upsert(ctx context.Context, block *db.UserBlock) error {
+	if block == nil {
+		return errors.New("block is nil")
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "blocker_id"}, {Name: "blocked_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"kind", "created_at"}),
+		}).
+		Create(block).Error
+}
+
+func (r *userBlockRepository) Delete(ctx context.Context, blockerID, blockedID string) error {
+	if blockerID == "" || blockedID == "" {
+		return errors.New("blockerID and blockedID required")
+	}
+	return r.db.WithContext(ctx).
+		Delete(&db.UserBlock{}, "blocker_id = ? AND blocked_id = ?", blockerID, blockedID).Error
+}
+
+func (r *userBlockRepository) ListByBlocker(ctx context.Context, blockerID string, limit int) ([]db.UserBlock, error) {
+	var out []db.UserBlock
+	q := r.db.WithContext(ctx).
+		Where("blocker_id = ?", blockerID).
+		Order("created_at DESC")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	err := q.Find(&out).Error
+	return out, err
+}
+
+func (r *userBlockRepository) ListInvolving(ctx context.Context, userID string) ([]db.UserBlock, error) {
+	var out []db.UserBlock
+	if userID == "" {
+		return out, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("blocker_id = ? OR blocked_id = ?", userID, userID).
+		Find(&out).Error
+	return out, err
+}
+
+// ExistsBetween checks both directions
+func (r *userBlockRepository) ExistsBetween(ctx context.Context, a, b, kind string) (bool, error) {
+	if a == "" || b == "" {
+		return false, nil
+	}
+	var n int64
+	err := r.db.WithContext(ctx).Model(&db.UserBlock{}).
+		Where("((blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)) AND kind = ?", a, b, b, a, kind).
+		Count(&n).Error
+	return n > 0, err
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"hope/db"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UserFavoriteRepository interface {
+	// Add keeps the first time a favorite was added when it is added again
+	Add(ctx context.Context, fav *db.UserFavorite) error
+	Remove(ctx context.Context, userID, favoriteID string) error
+	ListByUser(ctx context.Context, userID string, limit int) ([]db.UserFavorite, error)
+	Exists(ctx context.Context, userID, favoriteID string) (bool, error)
+}
+
+type userFavoriteRepository struct {
+	db *gorm.DB
+}
+
+func NewUserFavoriteRepository(db *gorm.DB) UserFavoriteRepository {
+	return &userFavoriteRepository{db: db}
+}
+
+func (r *userFavoriteRepository) Add(ctx context.Context, fav *db.UserFavorite) error {
+	if fav == nil {
+		return errors.New("favorite is nil")
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(fav).Error
+}
+
+func (r *userFavoriteRepository) Remove(ctx context.Context, userID, favoriteID string) error {
+	if userID == "" || favoriteID == "" {
+		return errors.New("userID and favoriteID required")
+	}
+	return r.db.WithContext(ctx).
+		Delete(&db.UserFavorite{}, "user_id = ? AND favorite_id = ?", userID, favoriteID).Error
+}
+
+func (r *userFavoriteRepository) ListByUser(ctx context.Context, userID string, limit int) ([]db.UserFavorite, error) {
+	var out []db.UserFavorite
+	q := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	err := q.Find(&out).Error
+	return out, err
+}
+
+func (r *userFavoriteRepository) Exists(ctx context.Context, userID, favoriteID string) (bool, error) {
+	if userID == "" || favoriteID == "" {
+		return false, nil
+	}
+	var n int64
+	err := r.db.WithContext(ctx).Model(&db.UserFavorite{}).
+		Where("user_id = ? AND favorite_id = ?", userID, favoriteID).
+		Count(&n).Error
+	return n > 0, err
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"time"
 	"hope/db"
+	"hope/geo"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -12,8 +13,13 @@ import (
 type UserLocationRepository interface {
 	Upsert(ctx context.Context, loc *db.UserLocation) error
 	GetByUserID(ctx context.Context, userID string) (*db.UserLocation, error)
-	ListNearby(ctx context.Context, geohashPrefix string, limit int) ([]db.UserLocation, error)
+	ListNearby(ctx context.Context, orgIDs []string, geohashPrefix string, limit int) ([]db.UserLocation, error)
+	// ListWithinRadius is ListNearby around a point, closest first
+	ListWithinRadius(ctx context.Context, orgIDs []string, lat, lon, meters float64, limit int) ([]db.UserLocation, error)
 	Delete(ctx context.Context, userID string) error
+	// MoveToOrg moves the user's location to orgID without touching
+	// updated_at, a stale position stays stale
+	MoveToOrg(ctx context.Context, userID, orgID string) error
 }
 
 type userLocationRepository struct {
@@ -38,6 +44,7 @@ func (r *userLocationRepository) Upsert(ctx context.Context, loc *db.UserLocatio
 				"latitude":   gorm.Expr("VALUES(latitude)"),
 				"longitude":  gorm.Expr("VALUES(longitude)"),
 				"geohash":    gorm.Expr("VALUES(geohash)"),
+				"org_id":     gorm.Expr("VALUES(org_id)"),
 				"updated_at": gorm.Expr("VALUES(updated_at)"),
 			}),
 		}).
@@ -60,10 +67,14 @@ func (r *userLocationRepository) GetByUserID(ctx context.Context, userID string)
 }
 
 
-func (r *userLocationRepository) ListNearby(ctx context.Context, geohashPrefix string, limit int) ([]db.UserLocation, error) {
+// ListNearby only returns locations of users in one of orgIDs
+func (r *userLocationRepository) ListNearby(ctx context.Context, orgIDs []string, geohashPrefix string, limit int) ([]db.UserLocation, error) {
 	var out []db.UserLocation
+	if len(orgIDs) == 0 {
+		return out, nil
+	}
 	q := r.db.WithContext(ctx).
-		Where("geohash LIKE ?", geohashPrefix+"%").
+		Where("geohash LIKE ? AND org_id IN ?", geohashPrefix+"%", orgIDs).
 		Order("updated_at DESC")
 	if limit > 0 {
 		q = q.Limit(limit)
@@ -72,6 +83,22 @@ func (r *userLocationRepository) ListNearby(ctx context.Context, geohashPrefix s
 	return out, err
 }
 
+func (r *userLocationRepository) ListWithinRadius(ctx context.Context, orgIDs []string, lat, lon, meters float64, limit int) ([]db.UserLocation, error) {
+	var out []db.UserLocation
+	if len(orgIDs) == 0 || meters <= 0 {
+		return out, nil
+	}
+	cells, args := likeAny("geohash", geo.Cover(lat, lon, meters))
+	err := r.db.WithContext(ctx).
+		Where(cells, args...).
+		Where("org_id IN ?", orgIDs).
+		Find(&out).Error
+	if err != nil {
+		return nil, err
+	}
+	return withinRadius(out, func(l db.UserLocation) string { return l.Geohash }, lat, lon, meters, limit), nil
+}
+
 func (r *userLocationRepository) Delete(ctx context.Context, userID string) error {
 	if userID == "" {
 		return errors.New("userID required")
@@ -79,3 +106,10 @@ func (r *userLocationRepository) Delete(ctx context.Context, userID string) erro
 	return r.db.WithContext(ctx).
 		Delete(&db.UserLocation{}, "user_id = ?", userID).Error
 }
+
+func (r *userLocationRepository) MoveToOrg(ctx context.Context, userID, orgID string) error {
+	return r.db.WithContext(ctx).
+		Model(&db.UserLocation{}).
+		Where("user_id = ?", userID).
+		UpdateColumn("org_id", orgID).Error
+}
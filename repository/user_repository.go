@@ -15,6 +15,8 @@ type UserRepository interface {
 	Create(ctx context.Context, user *db.User) error
 	FindByEmail(ctx context.Context, email string) (*db.User, error)
 	FindByID(ctx context.Context, id string) (*db.User, error)
+	// FindByIDs loads several users at once, unknown ids are skipped
+	FindByIDs(ctx context.Context, ids []string) ([]db.User, error)
 	Update(ctx context.Context, user *db.User) error
 	Delete(ctx context.Context, id string) error
 	FindByIDWithLocation(ctx context.Context, id string) (*db.User, error)
@@ -64,6 +66,17 @@ func (r *userRepository) FindByID(ctx context.Context, id string) (*db.User, err
 	return &user, nil
 }
 
+func (r *userRepository) FindByIDs(ctx context.Context, ids []string) ([]db.User, error) {
+	var users []db.User
+	if len(ids) == 0 {
+		return users, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("id IN ?", ids).
+		Find(&users).Error
+	return users, err
+}
+
 func (r *userRepository) FindByIDWithLocation(ctx context.Context, id string) (*db.User, error) {
 	var user db.User
 	err := r.db.WithContext(ctx).
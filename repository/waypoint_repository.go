@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+
+	"hope/db"
+
+	"gorm.io/gorm"
+)
+
+// waypoints are written together with their offer (RideOffer.Waypoints),
+// this repository only reads them
+type WaypointRepository interface {
+	// ListByRide is rideID's stops ordered by seq
+	ListByRide(ctx context.Context, rideID string) ([]db.Waypoint, error)
+	// ListByRides is the stops of several rides, ordered by ride and seq
+	ListByRides(ctx context.Context, rideIDs []string) ([]db.Waypoint, error)
+}
+
+type waypointRepository struct {
+	db *gorm.DB
+}
+
+func NewWaypointRepository(db *gorm.DB) WaypointRepository {
+	return &waypointRepository{db: db}
+}
+
+func (r *waypointRepository) ListByRide(ctx context.Context, rideID string) ([]db.Waypoint, error) {
+	var out []db.Waypoint
+	if rideID == "" {
+		return out, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("ride_id = ?", rideID).
+		Order("seq ASC").
+		Find(&out).Error
+	return out, err
+}
+
+func (r *waypointRepository) ListByRides(ctx context.Context, rideIDs []string) ([]db.Waypoint, error) {
+	var out []db.Waypoint
+	if len(rideIDs) == 0 {
+		return out, nil
+	}
+	err := r.db.WithContext(ctx).
+		Where("ride_id IN ?", rideIDs).
+		Order("ride_id ASC, seq ASC").
+		Find(&out).Error
+	return out, err
+}
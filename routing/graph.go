@@ -0,0 +1,303 @@
+// Package routing finds shortest paths over a road graph loaded from a
+// local OpenStreetMap extract. No network service is involved.
+package routing
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+
+	"hope/geo"
+)
+
+var (
+	ErrNoRoad  = errors.New("invalid location: no road nearby")
+	ErrNoRoute = errors.New("invalid state: no route between the points")
+)
+
+// SnapMeters is how far a point may be from the nearest graph node.
+const SnapMeters = 1000.0
+
+// Point is a position in degrees.
+type Point struct {
+	Lat float64
+	Lon float64
+}
+
+// Route is a path between two points. Meters and Seconds cover the road
+// part only, not the walk from each point to the road.
+type Route struct {
+	Meters  float64
+	Seconds float64
+	Path    []Point
+}
+
+// Router finds routes. Graph is the real one, StraightLine stands in when
+// no map is loaded.
+type Router interface {
+	Route(from, to Point) (Route, error)
+}
+
+type edge struct {
+	to      int32
+	meters  float32
+	seconds float32
+}
+
+// grid cells are 0.01° (~1.1km north-south) squares
+const cellDegrees = 0.01
+
+type cell struct{ lat, lon int32 }
+
+func cellOf(lat, lon float64) cell {
+	return cell{int32(math.Floor(lat / cellDegrees)), int32(math.Floor(lon / cellDegrees))}
+}
+
+// Graph is an immutable directed road graph, safe for concurrent use.
+type Graph struct {
+	lat []float64
+	lon []float64
+	// edges leaving node i are edges[first[i]:first[i+1]]
+	first []int32
+	edges []edge
+	// fastest edge speed in m/s, keeps the A* estimate from overshooting
+	maxSpeed float64
+	cells    map[cell][]int32
+}
+
+// Nodes is the number of graph nodes.
+func (g *Graph) Nodes() int { return len(g.lat) }
+
+// Edges is the number of directed edges.
+func (g *Graph) Edges() int { return len(g.edges) }
+
+// nearest is the closest node to p within SnapMeters
+func (g *Graph) nearest(p Point) (int32, bool) {
+	rings := 1
+	if c := math.Cos(p.Lat * math.Pi / 180); c > 0.01 {
+		rings = int(math.Ceil(SnapMeters / (111320 * cellDegrees * c)))
+	}
+	center := cellOf(p.Lat, p.Lon)
+	best, bestDist := int32(-1), SnapMeters
+	for dy := -rings; dy <= rings; dy++ {
+		for dx := -rings; dx <= rings; dx++ {
+			for _, n := range g.cells[cell{center.lat + int32(dy), center.lon + int32(dx)}] {
+				if d := geo.Distance(p.Lat, p.Lon, g.lat[n], g.lon[n]); d <= bestDist {
+					best, bestDist = n, d
+				}
+			}
+		}
+	}
+	return best, best >= 0
+}
+
+type queued struct {
+	node int32
+	f    float64 // seconds so far plus the estimate to the target
+}
+
+type queue []queued
+
+func (q queue) Len() int            { return len(q) }
+func (q queue) Less(i, j int) bool  { return q[i].f < q[j].f }
+func (q queue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *queue) Push(x interface{}) { *q = append(*q, x.(queued)) }
+func (q *queue) Pop() interface{} {
+	old := *q
+	x := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return x
+}
+
+// Route is the fastest path from one point to another (A* on travel time).
+func (g *Graph) Route(from, to Point) (Route, error) {
+	src, ok := g.nearest(from)
+	if !ok {
+		return Route{}, ErrNoRoad
+	}
+	dst, ok := g.nearest(to)
+	if !ok {
+		return Route{}, ErrNoRoad
+	}
+	if src == dst {
+		return Route{Path: []Point{{g.lat[src], g.lon[src]}}}, nil
+	}
+
+	estimate := func(n int32) float64 {
+		return geo.Distance(g.lat[n], g.lon[n], g.lat[dst], g.lon[dst]) / g.maxSpeed
+	}
+	seconds := map[int32]float64{src: 0}
+	meters := map[int32]float64{src: 0}
+	prev := map[int32]int32{}
+	done := map[int32]bool{}
+	q := &queue{{node: src, f: estimate(src)}}
+	for q.Len() > 0 {
+		cur := heap.Pop(q).(queued).node
+		if done[cur] {
+			continue
+		}
+		if cur == dst {
+			break
+		}
+		done[cur] = true
+		for _, e := range g.edges[g.first[cur]:g.first[cur+1]] {
+			if done[e.to] {
+				continue
+			}
+			t := seconds[cur] + float64(e.seconds)
+			if old, seen := seconds[e.to]; seen && old <= t {
+				continue
+			}
+			seconds[e.to] = t
+			meters[e.to] = meters[cur] + float64(e.meters)
+			prev[e.to] = cur
+			heap.Push(q, queued{node: e.to, f: t + estimate(e.to)})
+		}
+	}
+	if _, ok := seconds[dst]; !ok {
+		return Route{}, ErrNoRoute
+	}
+
+	var path []Point
+	for n := dst; ; n = prev[n] {
+		path = append(path, Point{g.lat[n], g.lon[n]})
+		if n == src {
+			break
+		}
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return Route{Meters: meters[dst], Seconds: seconds[dst], Path: path}, nil
+}
+
+// StraightLine estimates routes as the great-circle distance stretched by
+// RoadFactor and driven at SpeedKMH.
+type StraightLine struct {
+	SpeedKMH   float64
+	RoadFactor float64
+}
+
+func (s StraightLine) Route(from, to Point) (Route, error) {
+	m := geo.Distance(from.Lat, from.Lon, to.Lat, to.Lon) * s.RoadFactor
+	r := Route{Meters: m, Path: []Point{from, to}}
+	if s.SpeedKMH > 0 {
+		r.Seconds = m / (s.SpeedKMH / 3.6)
+	}
+	return r, nil
+}
+
+// Fallback routes with Router and uses Else for the legs Router finds no
+// road or no route for.
+type Fallback struct {
+	Router Router
+	Else   Router
+}
+
+func (f Fallback) Route(from, to Point) (Route, error) {
+	r, err := f.Router.Route(from, to)
+	if errors.Is(err, ErrNoRoad) || errors.Is(err, ErrNoRoute) {
+		return f.Else.Route(from, to)
+	}
+	return r, err
+}
+
+// Detour is what collecting a rider at a pickup and leaving them at a
+// dropoff adds to a driver's planned stops.
+type Detour struct {
+	Direct       Route
+	WithRider    Route
+	ExtraMeters  float64
+	ExtraSeconds float64
+	// the rider is picked up after stop PickupAfter and dropped off after
+	// stop DropoffAfter (indexes into the stops passed in)
+	PickupAfter  int
+	DropoffAfter int
+}
+
+func join(legs ...Route) Route {
+	var out Route
+	for _, leg := range legs {
+		out.Meters += leg.Meters
+		out.Seconds += leg.Seconds
+		path := leg.Path
+		if len(out.Path) > 0 && len(path) > 0 {
+			path = path[1:]
+		}
+		out.Path = append(out.Path, path...)
+	}
+	return out
+}
+
+// PlanDetour finds the cheapest place (by travel time) to insert pickup
+// and then dropoff into a route visiting stops in order, without
+// reordering the stops. It needs at least an origin and a destination.
+func PlanDetour(r Router, stops []Point, pickup, dropoff Point) (Detour, error) {
+	if len(stops) < 2 {
+		return Detour{}, errors.New("invalid route: needs at least two stops")
+	}
+	n := len(stops) - 1 // legs
+	base := make([]Route, n)
+	toPickup := make([]Route, n)
+	fromPickup := make([]Route, n)
+	toDropoff := make([]Route, n)
+	fromDropoff := make([]Route, n)
+	var err error
+	for i := 0; i < n; i++ {
+		if base[i], err = r.Route(stops[i], stops[i+1]); err != nil {
+			return Detour{}, err
+		}
+		if toPickup[i], err = r.Route(stops[i], pickup); err != nil {
+			return Detour{}, err
+		}
+		if fromPickup[i], err = r.Route(pickup, stops[i+1]); err != nil {
+			return Detour{}, err
+		}
+		if toDropoff[i], err = r.Route(stops[i], dropoff); err != nil {
+			return Detour{}, err
+		}
+		if fromDropoff[i], err = r.Route(dropoff, stops[i+1]); err != nil {
+			return Detour{}, err
+		}
+	}
+	ride, err := r.Route(pickup, dropoff)
+	if err != nil {
+		return Detour{}, err
+	}
+
+	bestI, bestJ, best := 0, 0, math.Inf(1)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			var extra float64
+			if i == j {
+				extra = toPickup[i].Seconds + ride.Seconds + fromDropoff[i].Seconds - base[i].Seconds
+			} else {
+				extra = toPickup[i].Seconds + fromPickup[i].Seconds - base[i].Seconds +
+					toDropoff[j].Seconds + fromDropoff[j].Seconds - base[j].Seconds
+			}
+			if extra < best {
+				bestI, bestJ, best = i, j, extra
+			}
+		}
+	}
+
+	direct := join(base...)
+	legs := append([]Route{}, base[:bestI]...)
+	if bestI == bestJ {
+		legs = append(legs, toPickup[bestI], ride, fromDropoff[bestI])
+	} else {
+		legs = append(legs, toPickup[bestI], fromPickup[bestI])
+		legs = append(legs, base[bestI+1:bestJ]...)
+		legs = append(legs, toDropoff[bestJ], fromDropoff[bestJ])
+	}
+	legs = append(legs, base[bestJ+1:]...)
+	with := join(legs...)
+	return Detour{
+		Direct:       direct,
+		WithRider:    with,
+		ExtraMeters:  math.Max(0, with.Meters-direct.Meters),
+		ExtraSeconds: math.Max(0, with.Seconds-direct.Seconds),
+		PickupAfter:  bestI,
+		DropoffAfter: bestJ,
+	}, nil
+}
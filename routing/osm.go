@@ -0,0 +1,239 @@
+package routing
+
+import (
+	"compress/bzip2"
+	"compress/gzip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"hope/geo"
+)
+
+// speeds in km/h used when a way has no usable maxspeed tag. Highway types
+// missing here (footway, cycleway, track, ...) are not routable by car
+var defaultSpeeds = map[string]float64{
+	"motorway":       100,
+	"motorway_link":  60,
+	"trunk":          80,
+	"trunk_link":     50,
+	"primary":        60,
+	"primary_link":   40,
+	"secondary":      50,
+	"secondary_link": 35,
+	"tertiary":       40,
+	"tertiary_link":  30,
+	"unclassified":   30,
+	"residential":    25,
+	"living_street":  10,
+	"service":        15,
+	"road":           30,
+}
+
+// LoadOSM builds a car routing graph from an OpenStreetMap XML extract
+// (.osm, optionally .gz or .bz2 compressed). Nodes must come before the
+// ways that use them, which is how extracts are written.
+func LoadOSM(path string) (*Graph, error) {
+	if strings.HasSuffix(path, ".pbf") {
+		return nil, errors.New("routing: .osm.pbf is not supported, convert it first, e.g. osmium cat in.osm.pbf -o out.osm.bz2")
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	switch {
+	case strings.HasSuffix(path, ".gz"):
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		r = gz
+	case strings.HasSuffix(path, ".bz2"):
+		r = bzip2.NewReader(f)
+	}
+	g, err := ReadOSM(r)
+	if err != nil {
+		return nil, fmt.Errorf("routing: %s: %w", path, err)
+	}
+	return g, nil
+}
+
+type osmTag struct {
+	K string `xml:"k,attr"`
+	V string `xml:"v,attr"`
+}
+
+type osmNode struct {
+	ID  int64   `xml:"id,attr"`
+	Lat float64 `xml:"lat,attr"`
+	Lon float64 `xml:"lon,attr"`
+}
+
+type osmWay struct {
+	Refs []struct {
+		Ref int64 `xml:"ref,attr"`
+	} `xml:"nd"`
+	Tags []osmTag `xml:"tag"`
+}
+
+// graphBuilder collects edges keyed by osm node id until the graph is frozen
+type graphBuilder struct {
+	coords map[int64]Point
+	index  map[int64]int32
+	g      *Graph
+	out    [][]edge
+}
+
+// ReadOSM is LoadOSM on an uncompressed XML stream.
+func ReadOSM(r io.Reader) (*Graph, error) {
+	b := &graphBuilder{
+		coords: make(map[int64]Point),
+		index:  make(map[int64]int32),
+		g:      &Graph{cells: make(map[cell][]int32)},
+	}
+	dec := xml.NewDecoder(r)
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch start.Name.Local {
+		case "node":
+			var n osmNode
+			if err := dec.DecodeElement(&n, &start); err != nil {
+				return nil, err
+			}
+			b.coords[n.ID] = Point{n.Lat, n.Lon}
+		case "way":
+			var w osmWay
+			if err := dec.DecodeElement(&w, &start); err != nil {
+				return nil, err
+			}
+			b.addWay(w)
+		case "relation":
+			if err := dec.Skip(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(b.out) == 0 {
+		return nil, errors.New("no drivable roads in extract")
+	}
+	return b.freeze(), nil
+}
+
+func (b *graphBuilder) node(id int64) (int32, bool) {
+	if i, ok := b.index[id]; ok {
+		return i, true
+	}
+	p, ok := b.coords[id]
+	if !ok {
+		return 0, false
+	}
+	i := int32(len(b.g.lat))
+	b.index[id] = i
+	b.g.lat = append(b.g.lat, p.Lat)
+	b.g.lon = append(b.g.lon, p.Lon)
+	b.out = append(b.out, nil)
+	c := cellOf(p.Lat, p.Lon)
+	b.g.cells[c] = append(b.g.cells[c], i)
+	return i, true
+}
+
+func (b *graphBuilder) addWay(w osmWay) {
+	tags := make(map[string]string, len(w.Tags))
+	for _, t := range w.Tags {
+		tags[t.K] = t.V
+	}
+	kmh, ok := defaultSpeeds[tags["highway"]]
+	if !ok || tags["access"] == "no" || tags["access"] == "private" || tags["motor_vehicle"] == "no" || tags["motorcar"] == "no" {
+		return
+	}
+	if v, ok := parseMaxspeed(tags["maxspeed"]); ok {
+		kmh = v
+	}
+	forward, backward := true, true
+	switch tags["oneway"] {
+	case "yes", "true", "1":
+		backward = false
+	case "-1", "reverse":
+		forward = false
+	case "no", "false", "0":
+	default:
+		if tags["junction"] == "roundabout" || tags["highway"] == "motorway" {
+			backward = false
+		}
+	}
+	mps := kmh / 3.6
+	if mps > b.g.maxSpeed {
+		b.g.maxSpeed = mps
+	}
+
+	prev := int32(-1)
+	for _, nd := range w.Refs {
+		cur, ok := b.node(nd.Ref)
+		if !ok {
+			// outside the extract, the way is cut here
+			prev = -1
+			continue
+		}
+		if prev >= 0 && prev != cur {
+			m := geo.Distance(b.g.lat[prev], b.g.lon[prev], b.g.lat[cur], b.g.lon[cur])
+			e := edge{meters: float32(m), seconds: float32(m / mps)}
+			if forward {
+				e.to = cur
+				b.out[prev] = append(b.out[prev], e)
+			}
+			if backward {
+				e.to = prev
+				b.out[cur] = append(b.out[cur], e)
+			}
+		}
+		prev = cur
+	}
+}
+
+// freeze packs the adjacency lists into one edge slice
+func (b *graphBuilder) freeze() *Graph {
+	g := b.g
+	g.first = make([]int32, len(b.out)+1)
+	for i, es := range b.out {
+		g.first[i+1] = g.first[i] + int32(len(es))
+		g.edges = append(g.edges, es...)
+	}
+	return g
+}
+
+// parseMaxspeed understands "50", "50 km/h" and "30 mph"
+func parseMaxspeed(v string) (float64, bool) {
+	v = strings.TrimSpace(strings.ToLower(v))
+	if v == "" {
+		return 0, false
+	}
+	factor := 1.0
+	if strings.HasSuffix(v, "mph") {
+		factor = 1.609344
+		v = strings.TrimSpace(strings.TrimSuffix(v, "mph"))
+	}
+	v = strings.TrimSpace(strings.TrimSuffix(v, "km/h"))
+	n, err := strconv.ParseFloat(v, 64)
+	if err != nil || n <= 0 {
+		return 0, false
+	}
+	return n * factor, true
+}
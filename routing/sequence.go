@@ -0,0 +1,249 @@
+package routing
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+)
+
+var (
+	ErrNoSequence   = errors.New("invalid state: no order satisfies the visits")
+	errTooManyCalls = errors.New("invalid route: too many visits to sequence")
+	errBadVisit     = errors.New("invalid route: visit load or ordering out of range")
+)
+
+const (
+	// each second past a visit's Latest costs as much as this many seconds
+	// of driving, so windows are kept whenever the seats allow it
+	latePenalty = 10.0
+	// search nodes expanded before settling for the best order found
+	sequenceBudget = 200000
+	maxVisits      = 62
+)
+
+// Visit is somewhere a driver has to call at between origin and
+// destination: one of their own stops, or a rider's pickup or dropoff.
+type Visit struct {
+	Point Point
+	// the driver waits when arriving before Earliest, and arriving after
+	// Latest counts as late. Zero times leave that side open
+	Earliest time.Time
+	Latest   time.Time
+	// seats taken (pickups) or freed (dropoffs, negative) at the visit
+	Load int
+	// index of a visit that has to come first, -1 for none
+	After int
+}
+
+// Call is a visit in a Schedule.
+type Call struct {
+	// index into the visits, -1 for the destination
+	Visit  int
+	Arrive time.Time
+	// later than Arrive when the driver waits for Earliest
+	Depart      time.Time
+	LateSeconds float64
+	// the leg from the previous call
+	Meters  float64
+	Seconds float64
+	// seats taken when leaving
+	Load int
+}
+
+// Schedule is the order a driver calls at their visits, ending at the
+// destination.
+type Schedule struct {
+	Calls       []Call
+	Meters      float64
+	Seconds     float64 // driving only, waits excluded
+	LateSeconds float64
+	// false when the search budget ran out and Calls is the best order
+	// found rather than the best there is
+	Optimal bool
+}
+
+// Sequence orders visits to minimize driving time, leaving at depart from
+// origin and ending at destination. The car never holds more than
+// capacity seats and no visit comes before its After. Time windows are
+// soft: being late is allowed but costs latePenalty per second.
+//
+// The search is branch and bound trying nearest visits first, so the
+// first complete order is the nearest-neighbour one and the rest only
+// improve on it.
+func Sequence(r Router, depart time.Time, origin, destination Point, visits []Visit, capacity int) (Schedule, error) {
+	k := len(visits)
+	if k > maxVisits {
+		return Schedule{}, errTooManyCalls
+	}
+	for i, v := range visits {
+		if v.Load > capacity || -v.Load > capacity || v.After >= k || v.After == i || v.After < -1 {
+			return Schedule{}, errBadVisit
+		}
+	}
+
+	// points: origin, the visits, destination
+	pts := make([]Point, 0, k+2)
+	pts = append(pts, origin)
+	for _, v := range visits {
+		pts = append(pts, v.Point)
+	}
+	pts = append(pts, destination)
+	dest := k + 1
+	legs := make([][]Route, k+1)
+	for i := 0; i <= k; i++ {
+		legs[i] = make([]Route, k+2)
+		for j := 1; j <= dest; j++ {
+			if i == j {
+				continue
+			}
+			leg, err := r.Route(pts[i], pts[j])
+			if err != nil {
+				return Schedule{}, err
+			}
+			leg.Path = nil
+			legs[i][j] = leg
+		}
+	}
+	// cheapest way into each point, summed over what's left it bounds the
+	// driving still to come
+	minIn := make([]float64, k+2)
+	for j := 1; j <= dest; j++ {
+		minIn[j] = math.Inf(1)
+		for i := 0; i <= k; i++ {
+			if i != j {
+				minIn[j] = math.Min(minIn[j], legs[i][j].Seconds)
+			}
+		}
+	}
+	offset := func(t time.Time, open float64) float64 {
+		if t.IsZero() {
+			return open
+		}
+		return t.Sub(depart).Seconds()
+	}
+	earliest := make([]float64, k+1)
+	latest := make([]float64, k+1)
+	for i, v := range visits {
+		earliest[i+1] = offset(v.Earliest, math.Inf(-1))
+		latest[i+1] = offset(v.Latest, math.Inf(1))
+	}
+
+	s := sequencer{
+		visits:   visits,
+		legs:     legs,
+		minIn:    minIn,
+		earliest: earliest,
+		latest:   latest,
+		capacity: capacity,
+		dest:     dest,
+		done:     make([]bool, k+1),
+		order:    make([]int, 0, k),
+		best:     math.Inf(1),
+		budget:   sequenceBudget,
+	}
+	rest := minIn[dest]
+	for j := 1; j <= k; j++ {
+		rest += minIn[j]
+	}
+	s.search(0, 0, 0, 0, rest)
+	if s.bestOrder == nil {
+		return Schedule{}, ErrNoSequence
+	}
+	return s.schedule(depart), nil
+}
+
+type sequencer struct {
+	visits   []Visit
+	legs     [][]Route
+	minIn    []float64
+	earliest []float64
+	latest   []float64
+	capacity int
+	dest     int
+
+	done  []bool
+	order []int
+
+	best      float64
+	bestOrder []int
+	budget    int
+}
+
+// search extends the order from point at (0 is the origin), t seconds
+// after departure with load seats taken. cost is driving plus lateness so
+// far and rest the lower bound on driving still to come
+func (s *sequencer) search(at int, t, cost float64, load int, rest float64) {
+	if cost+rest >= s.best {
+		return
+	}
+	if s.budget <= 0 {
+		return
+	}
+	s.budget--
+	if len(s.order) == len(s.visits) {
+		total := cost + s.legs[at][s.dest].Seconds
+		if total < s.best {
+			s.best = total
+			s.bestOrder = append(s.bestOrder[:0], s.order...)
+		}
+		return
+	}
+
+	next := make([]int, 0, len(s.visits)-len(s.order))
+	for j := 1; j < s.dest; j++ {
+		v := s.visits[j-1]
+		if s.done[j] || (v.After >= 0 && !s.done[v.After+1]) {
+			continue
+		}
+		if l := load + v.Load; l > s.capacity || l < 0 {
+			continue
+		}
+		next = append(next, j)
+	}
+	sort.Slice(next, func(a, b int) bool {
+		return s.legs[at][next[a]].Seconds < s.legs[at][next[b]].Seconds
+	})
+	for _, j := range next {
+		drive := s.legs[at][j].Seconds
+		arrive := t + drive
+		late := math.Max(0, arrive-s.latest[j])
+		s.done[j] = true
+		s.order = append(s.order, j-1)
+		s.search(j, math.Max(arrive, s.earliest[j]), cost+drive+latePenalty*late, load+s.visits[j-1].Load, rest-s.minIn[j])
+		s.order = s.order[:len(s.order)-1]
+		s.done[j] = false
+	}
+}
+
+// schedule replays the best order with times and legs filled in
+func (s *sequencer) schedule(depart time.Time) Schedule {
+	out := Schedule{Optimal: s.budget > 0, Calls: make([]Call, 0, len(s.bestOrder)+1)}
+	at, t, load := 0, 0.0, 0
+	clock := func(sec float64) time.Time {
+		return depart.Add(time.Duration(sec * float64(time.Second)))
+	}
+	for _, i := range append(s.bestOrder, -1) {
+		j := s.dest
+		if i >= 0 {
+			j = i + 1
+		}
+		leg := s.legs[at][j]
+		arrive := t + leg.Seconds
+		c := Call{Visit: i, Arrive: clock(arrive), Depart: clock(arrive), Meters: leg.Meters, Seconds: leg.Seconds}
+		t = arrive
+		if i >= 0 {
+			c.LateSeconds = math.Max(0, arrive-s.latest[j])
+			t = math.Max(arrive, s.earliest[j])
+			c.Depart = clock(t)
+			load += s.visits[i].Load
+		}
+		c.Load = load
+		out.Calls = append(out.Calls, c)
+		out.Meters += leg.Meters
+		out.Seconds += leg.Seconds
+		out.LateSeconds += c.LateSeconds
+		at = j
+	}
+	return out
+}
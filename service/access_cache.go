@@ -0,0 +1,50 @@
+package service
+
+import (
+	"sync"
+	"time"
+)
+
+// accessCacheTTL bounds how stale a login decision can be on another
+// instance, on this instance admin changes flush the cache right away
+const accessCacheTTL = time.Minute
+
+type accessEntry struct {
+	value   string
+	expires time.Time
+}
+
+// AccessCache memoizes the lookups Login does on every call (domain -> org,
+// email -> invite). Empty values are cached too so unknown domains do not hit
+// the db every time. It is shared between auth and organization services
+type AccessCache struct {
+	mu      sync.RWMutex
+	entries map[string]accessEntry
+}
+
+func NewAccessCache() *AccessCache {
+	return &AccessCache{entries: make(map[string]accessEntry)}
+}
+
+func (c *AccessCache) get(key string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expires) {
+		return "", false
+	}
+	return e.value, true
+}
+
+func (c *AccessCache) set(key, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = accessEntry{value: value, expires: time.Now().Add(accessCacheTTL)}
+}
+
+// Flush drops everything, called after any allowlist or invite change
+func (c *AccessCache) Flush() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]accessEntry)
+}
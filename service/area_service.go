@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"hope/db"
+	"hope/geo"
+	"hope/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	errZoneNotFound         = errors.New("zone not found")
+	errMeetingPointNotFound = errors.New("meeting point not found")
+	errInvalidAppliesTo     = errors.New("invalid applies_to: use origin, destination or both")
+	errNoMeetingPointNearby = errors.New("no meeting point nearby")
+)
+
+const (
+	zoneOrigin      = "origin"
+	zoneDestination = "destination"
+	zoneBoth        = "both"
+
+	meetingPointGeohashLen = 9
+	// how far SnapToMeetingPoint looks when the caller gives no radius
+	defaultSnapMeters = 500.0
+	// ListNearby* searches around a meeting point use this cell size (~1.2km)
+	meetingPointSearchLen = 6
+)
+
+// areaRules checks rides against service zones and resolves meeting point
+// references, shared by the ride service and the area service itself
+type areaRules struct {
+	zonerepo  repository.ServiceZoneRepository
+	pointrepo repository.MeetingPointRepository
+}
+
+// checkRoute fails when orgID has zones and from/to fall outside all of the
+// ones that apply to them
+func (a areaRules) checkRoute(ctx context.Context, orgID, fromGeo, toGeo string) error {
+	zones, err := a.zonerepo.ListByOrg(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if len(zones) == 0 {
+		return nil
+	}
+	if err := checkInside(zones, zoneOrigin, "origin", fromGeo); err != nil {
+		return err
+	}
+	return checkInside(zones, zoneDestination, "destination", toGeo)
+}
+
+func checkInside(zones []db.ServiceZone, kind, label, hash string) error {
+	lat, lon, err := geo.Decode(hash)
+	if err != nil {
+		return fmt.Errorf("invalid %s geohash", label)
+	}
+	restricted := false
+	for _, z := range zones {
+		if z.AppliesTo != kind && z.AppliesTo != zoneBoth {
+			continue
+		}
+		restricted = true
+		if zoneContains(z, lat, lon) {
+			return nil
+		}
+	}
+	if !restricted {
+		return nil
+	}
+	return fmt.Errorf("invalid location: %s is outside the service area", label)
+}
+
+func zoneContains(z db.ServiceZone, lat, lon float64) bool {
+	if lat < z.MinLat || lat > z.MaxLat || lon < z.MinLon || lon > z.MaxLon {
+		return false
+	}
+	var polys []geo.Polygon
+	if err := json.Unmarshal([]byte(z.Polygons), &polys); err != nil {
+		return false
+	}
+	for _, p := range polys {
+		if p.Contains(lat, lon) {
+			return true
+		}
+	}
+	return false
+}
+
+// point loads a meeting point the caller's org scope can see
+func (a areaRules) point(ctx context.Context, scope orgScope, callerID, pointID string) (*db.MeetingPoint, error) {
+	p, err := a.pointrepo.FindByID(ctx, strings.TrimSpace(pointID))
+	if err != nil || p == nil {
+		return nil, errMeetingPointNotFound
+	}
+	if ok, err := scope.canSee(ctx, callerID, p.OrgID); err != nil || !ok {
+		return nil, errMeetingPointNotFound
+	}
+	return p, nil
+}
+
+type AreaService interface {
+	CreateZone(ctx context.Context, callerID, orgID, name, appliesTo string, geojson []byte) (*db.ServiceZone, error)
+	ListZones(ctx context.Context, callerID, orgID string) ([]db.ServiceZone, error)
+	DeleteZone(ctx context.Context, callerID, zoneID string) error
+
+	CreateMeetingPoint(ctx context.Context, callerID string, p *db.MeetingPoint) error
+	ListMeetingPoints(ctx context.Context, callerID, geohashPrefix string, limit int) ([]db.MeetingPoint, error)
+	DeleteMeetingPoint(ctx context.Context, callerID, pointID string) error
+	// SnapToMeetingPoint is the closest visible meeting point within maxMeters
+	SnapToMeetingPoint(ctx context.Context, callerID string, lat, lon, maxMeters float64) (*db.MeetingPoint, float64, error)
+}
+
+type areaService struct {
+	orgrepo repository.OrganizationRepository
+	scope   orgScope
+	area    areaRules
+}
+
+func NewAreaService(zonerepo repository.ServiceZoneRepository, pointrepo repository.MeetingPointRepository, orgrepo repository.OrganizationRepository, userrepo repository.UserRepository) AreaService {
+	return &areaService{
+		orgrepo: orgrepo,
+		scope:   orgScope{userrepo: userrepo, orgrepo: orgrepo},
+		area:    areaRules{zonerepo: zonerepo, pointrepo: pointrepo},
+	}
+}
+
+func (s areaService) CreateZone(ctx context.Context, callerID, orgID, name, appliesTo string, geojson []byte) (*db.ServiceZone, error) {
+	if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+		return nil, err
+	}
+	orgID = strings.TrimSpace(orgID)
+	name = strings.TrimSpace(name)
+	appliesTo = strings.ToLower(strings.TrimSpace(appliesTo))
+	if appliesTo == "" {
+		appliesTo = zoneBoth
+	}
+	if orgID == "" || name == "" || len(geojson) == 0 {
+		return nil, errMissingFields
+	}
+	if appliesTo != zoneOrigin && appliesTo != zoneDestination && appliesTo != zoneBoth {
+		return nil, errInvalidAppliesTo
+	}
+	org, err := s.orgrepo.FindByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, errOrgNotFound
+	}
+
+	polys, err := geo.ParseGeoJSON(geojson)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := json.Marshal(polys)
+	if err != nil {
+		return nil, err
+	}
+	z := &db.ServiceZone{
+		ID:        uuid.New().String(),
+		OrgID:     orgID,
+		Name:      name,
+		AppliesTo: appliesTo,
+		Polygons:  string(raw),
+		MinLat:    math.Inf(1),
+		MaxLat:    math.Inf(-1),
+		MinLon:    math.Inf(1),
+		MaxLon:    math.Inf(-1),
+		CreatedBy: callerID,
+		CreatedAt: time.Now().UTC(),
+	}
+	for _, p := range polys {
+		minLat, maxLat, minLon, maxLon := p.Bounds()
+		z.MinLat, z.MaxLat = math.Min(z.MinLat, minLat), math.Max(z.MaxLat, maxLat)
+		z.MinLon, z.MaxLon = math.Min(z.MinLon, minLon), math.Max(z.MaxLon, maxLon)
+	}
+	if err := s.area.zonerepo.Create(ctx, z); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// ListZones defaults to the caller's own org
+func (s areaService) ListZones(ctx context.Context, callerID, orgID string) ([]db.ServiceZone, error) {
+	orgID = strings.TrimSpace(orgID)
+	if orgID == "" {
+		u, err := s.scope.user(ctx, callerID)
+		if err != nil {
+			return nil, err
+		}
+		orgID = u.OrgID
+	}
+	if ok, err := s.scope.canSee(ctx, callerID, orgID); err != nil || !ok {
+		return nil, errOrgNotFound
+	}
+	return s.area.zonerepo.ListByOrg(ctx, orgID)
+}
+
+func (s areaService) DeleteZone(ctx context.Context, callerID, zoneID string) error {
+	if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+		return err
+	}
+	z, err := s.area.zonerepo.FindByID(ctx, strings.TrimSpace(zoneID))
+	if err != nil {
+		return err
+	}
+	if z == nil {
+		return errZoneNotFound
+	}
+	return s.area.zonerepo.Delete(ctx, z.ID)
+}
+
+func (s areaService) CreateMeetingPoint(ctx context.Context, callerID string, p *db.MeetingPoint) error {
+	if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+		return err
+	}
+	if p == nil {
+		return errMissingFields
+	}
+	p.OrgID = strings.TrimSpace(p.OrgID)
+	p.Name = strings.TrimSpace(p.Name)
+	p.Description = strings.TrimSpace(p.Description)
+	if p.OrgID == "" || p.Name == "" {
+		return errMissingFields
+	}
+	if p.Latitude < -90 || p.Latitude > 90 || p.Longitude < -180 || p.Longitude > 180 {
+		return errInvalidLatLon
+	}
+	org, err := s.orgrepo.FindByID(ctx, p.OrgID)
+	if err != nil {
+		return err
+	}
+	if org == nil {
+		return errOrgNotFound
+	}
+
+	p.ID = uuid.New().String()
+	p.Geohash = geo.Encode(p.Latitude, p.Longitude, meetingPointGeohashLen)
+	p.CreatedBy = callerID
+	p.CreatedAt = time.Now().UTC()
+	return s.area.pointrepo.Create(ctx, p)
+}
+
+func (s areaService) ListMeetingPoints(ctx context.Context, callerID, geohashPrefix string, limit int) ([]db.MeetingPoint, error) {
+	orgIDs, err := s.scope.visibleOrgIDs(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	return s.area.pointrepo.ListNearby(ctx, orgIDs, strings.TrimSpace(geohashPrefix), limit)
+}
+
+func (s areaService) DeleteMeetingPoint(ctx context.Context, callerID, pointID string) error {
+	if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+		return err
+	}
+	p, err := s.area.pointrepo.FindByID(ctx, strings.TrimSpace(pointID))
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		return errMeetingPointNotFound
+	}
+	return s.area.pointrepo.Delete(ctx, p.ID)
+}
+
+func (s areaService) SnapToMeetingPoint(ctx context.Context, callerID string, lat, lon, maxMeters float64) (*db.MeetingPoint, float64, error) {
+	if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+		return nil, 0, errInvalidLatLon
+	}
+	if maxMeters <= 0 {
+		maxMeters = defaultSnapMeters
+	}
+	orgIDs, err := s.scope.visibleOrgIDs(ctx, callerID)
+	if err != nil {
+		return nil, 0, err
+	}
+	// catalogs are a handful of points per campus, a full scan is fine
+	points, err := s.area.pointrepo.ListNearby(ctx, orgIDs, "", 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	var best *db.MeetingPoint
+	bestDist := maxMeters
+	for i := range points {
+		d := geo.Distance(lat, lon, points[i].Latitude, points[i].Longitude)
+		if d <= bestDist {
+			best, bestDist = &points[i], d
+		}
+	}
+	if best == nil {
+		return nil, 0, errNoMeetingPointNearby
+	}
+	return best, bestDist, nil
+}
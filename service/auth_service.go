@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"hope/config"
 	"hope/db"
 	"hope/repository"
 
@@ -28,13 +29,18 @@ type GoogleTokenInfo struct {
 }
 
 type AuthService interface {
-	// Returns: jwt, user, error
-	Login(ctx context.Context, idToken string) (string, *db.User, error)
+	// Returns: jwt, user, error. inviteCode is optional, it admits users whose
+	// domain is not allowlisted
+	Login(ctx context.Context, idToken, inviteCode string) (string, *db.User, error)
 }
 
 type authService struct {
 	userrepo       repository.UserRepository
+	orgrepo        repository.OrganizationRepository
+	inviterepo     repository.InviteRepository
+	cache          *AccessCache
 	allowedDomains map[string]struct{}
+	adminEmails    config.AdminEmails
 	jwtSecret      []byte
 	googleClientID string
 }
@@ -44,22 +50,123 @@ var (
 	errInvalidAudience    = errors.New("invalid audience")
 	errEmailNotVerified   = errors.New("email not verified")
 	errUnauthorizedDomain = errors.New("unauthorized email domain")
+	errInviteInvalid      = errors.New("invite invalid or expired")
+	errInviteWrongEmail   = errors.New("invite was issued for a different email")
 )
 
+// allowedDomains (ALLOWED_DOMAINS) only bootstraps orgs now: the first login
+// from such a domain creates an org owning it, after that the db is the source
 func NewAuthService(
 	userrepo repository.UserRepository,
+	orgrepo repository.OrganizationRepository,
+	inviterepo repository.InviteRepository,
+	cache *AccessCache,
 	allowedDomains map[string]struct{},
+	adminEmails config.AdminEmails,
 	jwtSecret []byte,
 	googleClientID string,
 ) AuthService {
 	return &authService{
 		userrepo:       userrepo,
+		orgrepo:        orgrepo,
+		inviterepo:     inviterepo,
+		cache:          cache,
 		allowedDomains: allowedDomains,
+		adminEmails:    adminEmails,
 		jwtSecret:      jwtSecret,
 		googleClientID: googleClientID,
 	}
 }
 
+// domainOrgID returns the org owning domain or "" when the domain is not
+// allowlisted, legacy ALLOWED_DOMAINS entries get an org on first use
+func (s *authService) domainOrgID(ctx context.Context, domain string) (string, error) {
+	key := "domain:" + domain
+	if id, ok := s.cache.get(key); ok {
+		return id, nil
+	}
+
+	org, err := s.orgrepo.FindByDomain(ctx, domain)
+	if err != nil {
+		return "", err
+	}
+	if org == nil {
+		if _, ok := s.allowedDomains[domain]; !ok {
+			s.cache.set(key, "")
+			return "", nil
+		}
+		org = &db.Organization{
+			ID:        uuid.New().String(),
+			Name:      domain,
+			CreatedAt: time.Now().UTC(),
+		}
+		if err := s.orgrepo.Create(ctx, org); err != nil {
+			return "", err
+		}
+		if err := s.orgrepo.AddDomain(ctx, &db.OrganizationDomain{Domain: domain, OrgID: org.ID}); err != nil {
+			return "", err
+		}
+	}
+	s.cache.set(key, org.ID)
+	return org.ID, nil
+}
+
+// findInvite picks the invite to redeem: an explicit code wins, otherwise an
+// invite addressed to the email is used automatically
+func (s *authService) findInvite(ctx context.Context, email, code string) (*db.Invite, error) {
+	now := time.Now().UTC()
+	if code = strings.TrimSpace(code); code != "" {
+		inv, err := s.inviterepo.FindByCode(ctx, code)
+		if err != nil {
+			return nil, err
+		}
+		if inv == nil || !inv.Usable(now) {
+			return nil, errInviteInvalid
+		}
+		if inv.Email != "" && inv.Email != email {
+			return nil, errInviteWrongEmail
+		}
+		return inv, nil
+	}
+
+	key := "invite:" + email
+	if id, ok := s.cache.get(key); ok {
+		if id == "" {
+			return nil, nil
+		}
+		inv, err := s.inviterepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if inv != nil && inv.Usable(now) {
+			return inv, nil
+		}
+	}
+	inv, err := s.inviterepo.FindUsableByEmail(ctx, email, now)
+	if err != nil {
+		return nil, err
+	}
+	if inv == nil {
+		s.cache.set(key, "")
+		return nil, nil
+	}
+	s.cache.set(key, inv.ID)
+	return inv, nil
+}
+
+// admittedByInvite is true for users who came in through an invite that has
+// not been revoked since, they keep access without sending the code again
+func (s *authService) admittedByInvite(ctx context.Context, user *db.User) (bool, error) {
+	if user == nil || user.InviteID == "" || user.OrgID == "" {
+		return false, nil
+	}
+	inv, err := s.inviterepo.FindByID(ctx, user.InviteID)
+	if err != nil {
+		return false, err
+	}
+	return inv != nil && inv.RevokedAt == nil, nil
+}
+
 func (s *authService) verifyGoogleIDToken(idToken string) (*GoogleTokenInfo, error) {
 	if strings.TrimSpace(idToken) == "" {
 		return nil, errInvalidIDToken
@@ -87,6 +194,7 @@ func (s *authService) issueJWT(user *db.User) (string, error) {
 		"sub":   user.ID,
 		"email": user.Email,
 		"name":  user.Name,
+		"org":   user.OrgID,
 		"iat":   time.Now().Unix(),
 		"exp":   time.Now().Add(24 * time.Hour).Unix(),
 	}
@@ -94,7 +202,7 @@ func (s *authService) issueJWT(user *db.User) (string, error) {
 	return token.SignedString(s.jwtSecret)
 }
 
-func (s authService) Login(ctx context.Context, idToken string) (string, *db.User, error) {
+func (s authService) Login(ctx context.Context, idToken, inviteCode string) (string, *db.User, error) {
 	tokeninfo, err := s.verifyGoogleIDToken(idToken)
 	if err != nil {
 		return "", nil, err
@@ -107,21 +215,47 @@ func (s authService) Login(ctx context.Context, idToken string) (string, *db.Use
 	}
 
 	email := strings.ToLower(tokeninfo.Email)
-	allowed := false
-	for domain := range s.allowedDomains {
-		if strings.HasSuffix(email, "@"+domain) {
-			allowed = true
-			break
-		}
-	}
-	if !allowed {
+	at := strings.LastIndex(email, "@")
+	if at < 0 || at == len(email)-1 {
 		return "", nil, errUnauthorizedDomain
 	}
+	_, isAdmin := s.adminEmails[email]
 
 	user, err := s.userrepo.FindByEmail(ctx, email)
 	if err != nil {
 		return "", nil, err
 	}
+
+	// access is granted by, in order: an allowlisted domain, an invite redeemed
+	// on an earlier login, or an invite redeemed now
+	orgID, err := s.domainOrgID(ctx, email[at+1:])
+	if err != nil {
+		return "", nil, err
+	}
+	inviteID := ""
+	if orgID == "" {
+		ok, err := s.admittedByInvite(ctx, user)
+		if err != nil {
+			return "", nil, err
+		}
+		if ok {
+			orgID = user.OrgID
+		} else {
+			inv, err := s.findInvite(ctx, email, inviteCode)
+			if err != nil {
+				return "", nil, err
+			}
+			if inv == nil {
+				return "", nil, errUnauthorizedDomain
+			}
+			if err := s.inviterepo.Redeem(ctx, inv.ID); err != nil {
+				return "", nil, errInviteInvalid
+			}
+			s.cache.Flush()
+			orgID, inviteID = inv.OrgID, inv.ID
+		}
+	}
+
 	if user == nil {
 		user = &db.User{
 			ID:       uuid.New().String(),
@@ -129,10 +263,32 @@ func (s authService) Login(ctx context.Context, idToken string) (string, *db.Use
 			Name:     tokeninfo.Name,
 			PhotoURL: tokeninfo.Picture,
 			LastSeen: time.Now(),
+			OrgID:    orgID,
+			InviteID: inviteID,
+			Role:     "user",
+		}
+		if isAdmin {
+			user.Role = "admin"
 		}
 		if err := s.userrepo.Create(ctx, user); err != nil {
 			return "", nil, err
 		}
+	} else if user.OrgID == "" || inviteID != "" || (isAdmin && user.Role != "admin") {
+		// users from before orgs existed get assigned on their next login,
+		// an admin may have moved someone on purpose so we never overwrite
+		if user.OrgID == "" {
+			user.OrgID = orgID
+		}
+		if inviteID != "" {
+			user.OrgID = orgID
+			user.InviteID = inviteID
+		}
+		if isAdmin {
+			user.Role = "admin"
+		}
+		if err := s.userrepo.Update(ctx, user); err != nil {
+			return "", nil, err
+		}
 	}
 
 	jwtStr, err := s.issueJWT(user)
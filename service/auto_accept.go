@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"hope/db"
+)
+
+var (
+	errInvalidAutoAccept = errors.New("invalid auto-accept rule: must be everyone, favorites or trusted")
+	errInvalidMinRating  = errors.New("invalid min rating: must be between 0 and 5")
+)
+
+// auto-accept rules an offer can have, none leaves every join request to
+// the driver
+const (
+	acceptEveryone  = "everyone"
+	acceptFavorites = "favorites"
+	// riders of the driver's org meeting the offer's rating and
+	// reliability minimums
+	acceptTrusted = "trusted"
+)
+
+// checkAutoAccept validates offer's rule and clears the minimums of rules
+// that don't use them
+func checkAutoAccept(offer *db.RideOffer) error {
+	offer.AutoAccept = strings.ToLower(strings.TrimSpace(offer.AutoAccept))
+	switch offer.AutoAccept {
+	case "", acceptEveryone, acceptFavorites:
+		offer.AutoMinRating, offer.AutoMinReliability = 0, 0
+		return nil
+	case acceptTrusted:
+	default:
+		return errInvalidAutoAccept
+	}
+	if offer.AutoMinRating < 0 || offer.AutoMinRating > 5 {
+		return errInvalidMinRating
+	}
+	return checkMinReliability(offer.AutoMinReliability)
+}
+
+func (s rideService) SetAutoAccept(ctx context.Context, callerID string, offer *db.RideOffer) (*db.RideOffer, error) {
+	if offer == nil {
+		return nil, errOfferNotFound
+	}
+	current, err := s.rideofferepo.FindByID(ctx, strings.TrimSpace(offer.ID))
+	if err != nil || current == nil || current.ID == "" {
+		return nil, errOfferNotFound
+	}
+	if current.DriverID != strings.TrimSpace(callerID) {
+		return nil, errForbidden
+	}
+	if current.Status != "active" && current.Status != "matched" {
+		return nil, errRideClosed
+	}
+	if err := checkAutoAccept(offer); err != nil {
+		return nil, err
+	}
+	current.AutoAccept = offer.AutoAccept
+	current.AutoMinRating = offer.AutoMinRating
+	current.AutoMinReliability = offer.AutoMinReliability
+	if err := s.rideofferepo.Update(ctx, current); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+// autoAccept decides whether offer's rule lets riderID in without asking
+// the driver, and says why or why not
+func (s matchService) autoAccept(ctx context.Context, offer *db.RideOffer, riderID string) (bool, string, error) {
+	switch offer.AutoAccept {
+	case acceptEveryone:
+		return true, "offer accepts everyone", nil
+	case acceptFavorites:
+		fav, err := s.favrepo.Exists(ctx, offer.DriverID, riderID)
+		if err != nil {
+			return false, "", err
+		}
+		if !fav {
+			return false, "rider is not one of the driver's favorites", nil
+		}
+		return true, "rider is one of the driver's favorites", nil
+	case acceptTrusted:
+		return s.trusted(ctx, offer, riderID)
+	}
+	return false, "", nil
+}
+
+func (s matchService) trusted(ctx context.Context, offer *db.RideOffer, riderID string) (bool, string, error) {
+	rider, err := s.scope.user(ctx, riderID)
+	if err != nil {
+		return false, "", err
+	}
+	if rider.OrgID != offer.OrgID {
+		return false, "rider is not in the driver's org", nil
+	}
+	var met []string
+	if min := offer.AutoMinRating; min > 0 {
+		ratings, err := s.reviewrepo.RatingsFor(ctx, []string{riderID})
+		if err != nil {
+			return false, "", err
+		}
+		r, ok := ratings[riderID]
+		if !ok {
+			return false, "rider has no ratings yet", nil
+		}
+		if r.Average < min {
+			return false, fmt.Sprintf("rating %.1f is below %.1f", r.Average, min), nil
+		}
+		met = append(met, fmt.Sprintf("rating %.1f", r.Average))
+	}
+	if min := offer.AutoMinReliability; min > 0 {
+		scores, err := s.reliability.of(ctx, []string{riderID})
+		if err != nil {
+			return false, "", err
+		}
+		score := scores[riderID].Score
+		if score < min {
+			return false, fmt.Sprintf("reliability %.1f is below %.1f", score, min), nil
+		}
+		met = append(met, fmt.Sprintf("reliability %.1f", score))
+	}
+	if len(met) == 0 {
+		return true, "rider is in the driver's org", nil
+	}
+	return true, "rider is in the driver's org with " + strings.Join(met, " and "), nil
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"hope/repository"
+)
+
+var errBlocked = errors.New("forbidden: user is blocked")
+
+// blockList answers block/mute questions for the services that have to
+// enforce them, like orgScope it keeps the rule in one place
+type blockList struct {
+	blockrepo repository.UserBlockRepository
+}
+
+// between is true when either user blocked the other
+func (b blockList) between(ctx context.Context, a, c string) (bool, error) {
+	if a == "" || c == "" || a == c {
+		return false, nil
+	}
+	return b.blockrepo.ExistsBetween(ctx, a, c, "block")
+}
+
+// hiddenFrom is every user userID must not see: the ones it blocked and the
+// ones that blocked it
+func (b blockList) hiddenFrom(ctx context.Context, userID string) (map[string]struct{}, error) {
+	rows, err := b.blockrepo.ListInvolving(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]struct{}, len(rows))
+	for _, r := range rows {
+		if r.Kind != "block" {
+			continue
+		}
+		if r.BlockerID == userID {
+			out[r.BlockedID] = struct{}{}
+		} else {
+			out[r.BlockerID] = struct{}{}
+		}
+	}
+	return out, nil
+}
+
+// silencedFor extends hiddenFrom with the users userID muted, used for chat
+func (b blockList) silencedFor(ctx context.Context, userID string) (map[string]struct{}, error) {
+	rows, err := b.blockrepo.ListInvolving(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]struct{}, len(rows))
+	for _, r := range rows {
+		switch {
+		case r.BlockerID == userID:
+			out[r.BlockedID] = struct{}{}
+		case r.Kind == "block":
+			out[r.BlockerID] = struct{}{}
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"hope/config"
+	"hope/db"
+	"hope/repository"
+	"hope/routing"
+)
+
+var errDoubleBooked = errors.New("invalid state: overlaps")
+
+// no trip runs longer than this, nor lets its departure move further, so
+// bookings planned to depart earlier or later can't reach into a new one
+const bookingLookback = 24 * time.Hour
+
+// booking is a stretch of time a user is committed to: an offer they
+// drive, a request they posted or a ride they were accepted on
+type booking struct {
+	kind       string
+	id         string
+	start, end time.Time
+}
+
+func (b booking) String() string {
+	return fmt.Sprintf("your %s %s departing %s", b.kind, b.id, b.start.UTC().Format(time.RFC3339))
+}
+
+// schedule finds the bookings a new one would overlap. Join requests
+// still waiting on a driver don't book anything, a user may ask several
+// rides at once and keep whichever accepts first
+type schedule struct {
+	offerrepo    repository.RideOfferRepository
+	requestrepo  repository.RideRequestRepository
+	matchrepo    repository.MatchRepository
+	waypointrepo repository.WaypointRepository
+	router       routing.Router
+	cfg          config.Booking
+	times        *legTimes
+}
+
+// maxLegTimes bounds the leg cache, it starts over once this many legs
+// were routed
+const maxLegTimes = 100000
+
+// legTimes remembers how long the drive between two geohashes takes, the
+// road graph doesn't change while the server runs. Searches by departure
+// window ask it for every offer they look at
+type legTimes struct {
+	mu      sync.RWMutex
+	seconds map[[2]string]float64
+}
+
+func newLegTimes() *legTimes {
+	return &legTimes{seconds: make(map[[2]string]float64)}
+}
+
+// leg is the drive from one geohash to another in seconds, -1 when it
+// can't be routed
+func (s schedule) leg(fromGeo, toGeo string) float64 {
+	key := [2]string{fromGeo, toGeo}
+	s.times.mu.RLock()
+	seconds, ok := s.times.seconds[key]
+	s.times.mu.RUnlock()
+	if ok {
+		return seconds
+	}
+	seconds = -1
+	from, err := geoPoint("stop", fromGeo)
+	if err == nil {
+		var to routing.Point
+		if to, err = geoPoint("stop", toGeo); err == nil {
+			var r routing.Route
+			if r, err = s.router.Route(from, to); err == nil {
+				seconds = r.Seconds
+			}
+		}
+	}
+	s.times.mu.Lock()
+	if len(s.times.seconds) >= maxLegTimes {
+		s.times.seconds = make(map[[2]string]float64)
+	}
+	s.times.seconds[key] = seconds
+	s.times.mu.Unlock()
+	return seconds
+}
+
+// drive is how long driving through stops takes, the default duration
+// when any leg can't be routed
+func (s schedule) drive(stops []db.Waypoint) time.Duration {
+	var seconds float64
+	for i := 1; i < len(stops); i++ {
+		leg := s.leg(stops[i-1].Geohash, stops[i].Geohash)
+		if leg < 0 {
+			return s.cfg.DefaultDuration
+		}
+		seconds += leg
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// offerBooking is the time offer may keep its driver busy, from its
+// earliest departure to arriving after its latest, stretched to its latest
+// planned stop when the driver planned more time than the roads take
+func (s schedule) offerBooking(offer *db.RideOffer, stops []db.Waypoint) booking {
+	w := tripWindow(offer.Time, offer.EarliestAt, offer.LatestAt, nil, 0)
+	end := w.to.Add(s.drive(stops))
+	for _, st := range stops {
+		if st.PlannedAt != nil && st.PlannedAt.After(end) {
+			end = *st.PlannedAt
+		}
+	}
+	return booking{kind: "offer", id: offer.ID, start: w.from, end: end}
+}
+
+func (s schedule) requestBooking(req *db.RideRequest) booking {
+	w := tripWindow(req.Time, req.EarliestAt, req.LatestAt, nil, 0)
+	d := s.drive([]db.Waypoint{{Geohash: req.FromGeo}, {Geohash: req.ToGeo}})
+	return booking{kind: "request", id: req.ID, start: w.from, end: w.to.Add(d)}
+}
+
+// rideBooking is offerBooking with the offer's stops loaded
+func (s schedule) rideBooking(ctx context.Context, offer *db.RideOffer) (booking, error) {
+	stops, err := routeStops(ctx, s.waypointrepo, offer)
+	if err != nil {
+		return booking{}, err
+	}
+	return s.offerBooking(offer, stops), nil
+}
+
+// overlap tells whether a and b leave less than the buffer between them
+func (s schedule) overlap(a, b booking) bool {
+	return a.start.Before(b.end.Add(s.cfg.Buffer)) && b.start.Before(a.end.Add(s.cfg.Buffer))
+}
+
+// conflicts is userID's bookings that b overlaps, earliest first: the
+// offers they drive, the rides they were accepted on and, withRequests,
+// their active requests. The row b stands for doesn't count
+func (s schedule) conflicts(ctx context.Context, userID string, b booking, withRequests bool) ([]booking, error) {
+	from, to := b.start.Add(-bookingLookback), b.end.Add(s.cfg.Buffer+bookingLookback)
+	var have []booking
+	offers, err := s.offerrepo.ListDepartingBetween(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	for i := range offers {
+		ob, err := s.rideBooking(ctx, &offers[i])
+		if err != nil {
+			return nil, err
+		}
+		have = append(have, ob)
+	}
+	matches, err := s.matchrepo.ListForRiderDepartingBetween(ctx, userID, []string{"accepted"}, from, to)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range matches {
+		if m.Ride == nil {
+			continue
+		}
+		ob, err := s.rideBooking(ctx, m.Ride)
+		if err != nil {
+			return nil, err
+		}
+		have = append(have, booking{kind: "match", id: m.ID, start: ob.start, end: ob.end})
+	}
+	if withRequests {
+		reqs, err := s.requestrepo.ListDepartingBetween(ctx, userID, from, to)
+		if err != nil {
+			return nil, err
+		}
+		for i := range reqs {
+			have = append(have, s.requestBooking(&reqs[i]))
+		}
+	}
+
+	var out []booking
+	for _, h := range have {
+		if h.id != b.id && s.overlap(h, b) {
+			out = append(out, h)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].start.Before(out[j].start) })
+	return out, nil
+}
+
+// check fails with every booking of userID that b overlaps
+func (s schedule) check(ctx context.Context, userID string, b booking, withRequests bool) error {
+	clash, err := s.conflicts(ctx, userID, b, withRequests)
+	if err != nil || len(clash) == 0 {
+		return err
+	}
+	names := make([]string, 0, len(clash))
+	for _, c := range clash {
+		names = append(names, c.String())
+	}
+	return fmt.Errorf("%w %s", errDoubleBooked, strings.Join(names, ", "))
+}
+
+// withdrawOverlapping takes back the rider's other join requests on rides
+// overlapping the one m was just accepted on. Seats held for them on
+// promotion from a waitlist go to the next in line
+func (s matchService) withdrawOverlapping(ctx context.Context, m *db.Match, offer *db.RideOffer) error {
+	b, err := s.sched.rideBooking(ctx, offer)
+	if err != nil {
+		return err
+	}
+	pending, err := s.matchrepo.ListForRiderDepartingBetween(ctx, m.RiderID, []string{"requested", "waitlisted", "promoted"}, b.start.Add(-bookingLookback), b.end.Add(s.sched.cfg.Buffer+bookingLookback))
+	if err != nil {
+		return err
+	}
+	for i := range pending {
+		p := &pending[i]
+		if p.ID == m.ID || p.Ride == nil {
+			continue
+		}
+		pb, err := s.sched.rideBooking(ctx, p.Ride)
+		if err != nil {
+			return err
+		}
+		if !s.sched.overlap(b, pb) {
+			continue
+		}
+		c := repository.Change{Reason: "accepted on another ride at the same time"}
+		if err := s.matchrepo.Cancel(ctx, p.ID, p.Status, "withdrawn", c, false); err != nil {
+			return err
+		}
+		if err := s.release(ctx, p); err != nil {
+			return err
+		}
+		if err := s.dropPartner(ctx, p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
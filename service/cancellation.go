@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"hope/db"
+	"hope/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	errReasonTooLong = errors.New("invalid reason: at most 500 characters")
+	errRideClosed    = errors.New("invalid state: ride is no longer open")
+	errNotDeparted   = errors.New("invalid state: the ride has not left yet")
+)
+
+const maxReasonLen = 500
+
+func cleanReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if len(reason) > maxReasonLen {
+		return "", errReasonTooLong
+	}
+	return reason, nil
+}
+
+func (s matchService) WithdrawRequest(ctx context.Context, callerID, matchID, reason string) error {
+	callerID = strings.TrimSpace(callerID)
+	reason, err := cleanReason(reason)
+	if err != nil {
+		return err
+	}
+	m, err := s.matchrepo.FindByID(ctx, strings.TrimSpace(matchID))
+	if err != nil || m == nil || m.ID == "" {
+		return errMatchNotFound
+	}
+	if m.RiderID != callerID {
+		return errForbidden
+	}
+	if m.Status != "requested" && m.Status != "waitlisted" && m.Status != "promoted" {
+		return errors.New("invalid state transition")
+	}
+	if err := s.matchrepo.Cancel(ctx, m.ID, m.Status, "withdrawn", repository.Change{ActorID: callerID, Reason: reason}, false); err != nil {
+		return err
+	}
+	if err := s.release(ctx, m); err != nil {
+		return err
+	}
+	return s.dropPartner(ctx, m)
+}
+
+func (s matchService) CancelMatch(ctx context.Context, callerID, matchID, reason string) error {
+	callerID = strings.TrimSpace(callerID)
+	reason, err := cleanReason(reason)
+	if err != nil {
+		return err
+	}
+	m, err := s.matchrepo.FindByID(ctx, strings.TrimSpace(matchID))
+	if err != nil || m == nil || m.ID == "" {
+		return errMatchNotFound
+	}
+	if m.RiderID != callerID && m.DriverID != callerID {
+		return errForbidden
+	}
+	if m.Status != "accepted" {
+		return errors.New("invalid state transition")
+	}
+	offer, err := s.rideofferepo.FindByID(ctx, m.RideID)
+	if err != nil || offer == nil || offer.ID == "" {
+		return errOfferNotFound
+	}
+	now := time.Now().UTC()
+	c := repository.Change{ActorID: callerID, Reason: reason, At: now}
+	if err := s.matchrepo.Cancel(ctx, m.ID, m.Status, "cancelled", c, s.late(offer, m, now)); err != nil {
+		return err
+	}
+
+	what := "cancelled their seat"
+	if callerID == m.DriverID {
+		rider := "a rider"
+		if u, err := s.scope.user(ctx, m.RiderID); err == nil && u.Name != "" {
+			rider = u.Name
+		}
+		what = "cancelled the seat of " + rider
+	}
+	s.notify(ctx, m.RideID, callerID, what, reason)
+	if err := s.endTrip(ctx, m); err != nil {
+		return err
+	}
+	s.planner.refresh(ctx, offer)
+	s.waitlist.refill(ctx, offer)
+	return s.dropPartner(ctx, m)
+}
+
+func (s matchService) CancelRide(ctx context.Context, callerID, offerID, reason string) ([]db.Match, error) {
+	callerID = strings.TrimSpace(callerID)
+	reason, err := cleanReason(reason)
+	if err != nil {
+		return nil, err
+	}
+	offer, err := s.rideofferepo.FindByID(ctx, strings.TrimSpace(offerID))
+	if err != nil || offer == nil || offer.ID == "" {
+		return nil, errOfferNotFound
+	}
+	if offer.DriverID != callerID {
+		return nil, errForbidden
+	}
+	if offer.Status != "active" && offer.Status != "matched" {
+		return nil, errRideClosed
+	}
+
+	now := time.Now().UTC()
+	matches, err := s.matchrepo.FindByRideID(ctx, offer.ID)
+	if err != nil {
+		return nil, err
+	}
+	var cancelled []db.Match
+	for _, m := range matches {
+		switch m.Status {
+		case "requested", "accepted", "waitlisted", "promoted":
+		default:
+			continue
+		}
+		// only riders the driver had said yes to count against them
+		m.LateCancel = m.Status == "accepted" && s.late(offer, &m, now)
+		cancelled = append(cancelled, m)
+	}
+	offer.Status = "cancelled"
+	offer.CancelledBy = callerID
+	offer.CancelledAt = &now
+	offer.CancelReason = reason
+	// the offer and its matches close together or not at all
+	if err := s.rideofferepo.Cancel(ctx, offer, cancelled, repository.Change{ActorID: callerID, Reason: reason, At: now}); err != nil {
+		return nil, err
+	}
+	for i := range cancelled {
+		m := &cancelled[i]
+		m.Status, m.CancelledBy, m.CancelledAt, m.CancelReason = "cancelled", callerID, &now, reason
+	}
+	s.notify(ctx, offer.ID, callerID, "cancelled this ride", reason)
+	s.trips.EndRide(offer.ID)
+	s.planner.refresh(ctx, offer)
+	for i := range cancelled {
+		if err := s.dropPartner(ctx, &cancelled[i]); err != nil {
+			return nil, err
+		}
+	}
+	return cancelled, nil
+}
+
+func (s matchService) ReportNoShow(ctx context.Context, callerID, matchID string) (*db.Match, error) {
+	callerID = strings.TrimSpace(callerID)
+	m, err := s.matchrepo.FindByID(ctx, strings.TrimSpace(matchID))
+	if err != nil || m == nil || m.ID == "" {
+		return nil, errMatchNotFound
+	}
+	var absent string
+	switch callerID {
+	case m.RiderID:
+		absent = m.DriverID
+	case m.DriverID:
+		absent = m.RiderID
+	default:
+		return nil, errForbidden
+	}
+	if m.Status != "accepted" {
+		return nil, errors.New("invalid state transition")
+	}
+	// the PIN check-in proves both of them turned up
+	if m.StartedAt != nil {
+		return nil, errAlreadyCheckedIn
+	}
+	offer, err := s.rideofferepo.FindByID(ctx, m.RideID)
+	if err != nil || offer == nil || offer.ID == "" {
+		return nil, errOfferNotFound
+	}
+	now := time.Now().UTC()
+	if now.Before(pickupTime(offer, m)) {
+		return nil, errNotDeparted
+	}
+	if err := s.matchrepo.ReportNoShow(ctx, m.ID, m.Status, absent, repository.Change{ActorID: callerID, At: now}); err != nil {
+		return nil, err
+	}
+	m.Status, m.NoShowUserID, m.NoShowReportedAt = "no_show", absent, &now
+
+	who := "the driver"
+	if absent == m.RiderID {
+		who = "a rider"
+		if u, err := s.scope.user(ctx, absent); err == nil && u.Name != "" {
+			who = u.Name
+		}
+	}
+	s.notify(ctx, m.RideID, callerID, "reported that "+who+" did not show up", "")
+	if err := s.endTrip(ctx, m); err != nil {
+		return nil, err
+	}
+	s.planner.refresh(ctx, offer)
+	return m, nil
+}
+
+// late tells whether backing out of m at now falls inside the policy's
+// grace period before the rider's pickup, departed rides included
+func (s matchService) late(offer *db.RideOffer, m *db.Match, now time.Time) bool {
+	return pickupTime(offer, m).Sub(now) < s.policy.Grace
+}
+
+// pickupTime is when the rider is picked up, the offer's departure for
+// matches accepted before pickup times were agreed on
+func pickupTime(offer *db.RideOffer, m *db.Match) time.Time {
+	if m.PickupAt != nil {
+		return *m.PickupAt
+	}
+	return offer.Time
+}
+
+// notify posts a system message about what actorID did to the ride chat.
+// A lost chat line shouldn't turn a cancellation or no-show into an error
+// the caller would retry, so a failed post is logged
+func (s matchService) notify(ctx context.Context, rideID, actorID, what, reason string) {
+	name := "Someone"
+	if u, err := s.scope.user(ctx, actorID); err == nil && u.Name != "" {
+		name = u.Name
+	}
+	content := fmt.Sprintf("%s %s.", name, what)
+	if reason != "" {
+		content = fmt.Sprintf("%s %s: %s", name, what, reason)
+	}
+	msg := &db.ChatMessage{
+		ID:        uuid.New().String(),
+		RideID:    rideID,
+		SenderID:  actorID,
+		Content:   content,
+		Timestamp: time.Now().UTC(),
+		System:    true,
+	}
+	if err := s.chatrepo.Create(ctx, msg); err != nil {
+		log.Printf("ride %s: system message not posted: %v", rideID, err)
+	}
+}
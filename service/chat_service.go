@@ -17,19 +17,84 @@ var (
 
 type ChatService interface {
 	SendMessage(ctx context.Context, msg *db.ChatMessage) error
-	ListMessagesByRide(ctx context.Context, rideID string, limit int, before time.Time) ([]db.ChatMessage, error)
-	ListMessagesBySender(ctx context.Context, senderID string, limit int, before time.Time) ([]db.ChatMessage, error)
-	ListChatsForUser(ctx context.Context, userID string, limit int, before time.Time) ([]db.ChatMessage, error)
+	ListMessagesByRide(ctx context.Context, callerID, rideID string, limit int, before time.Time) ([]db.ChatMessage, error)
+	ListMessagesBySender(ctx context.Context, callerID, senderID string, limit int, before time.Time) ([]db.ChatMessage, error)
+	ListChatsForUser(ctx context.Context, callerID, userID string, limit int, before time.Time) ([]db.ChatMessage, error)
 	DeleteMessage(ctx context.Context, id string) error
 }
 
 type chatService struct {
-	chatrepo  repository.ChatMessageRepository
-	matchrepo repository.MatchRepository
+	chatrepo     repository.ChatMessageRepository
+	matchrepo    repository.MatchRepository
+	rideofferepo repository.RideOfferRepository
+	scope        orgScope
+	blocks       blockList
 }
 
-func NewChatService(chatrepo repository.ChatMessageRepository, matchrepo repository.MatchRepository) ChatService {
-	return &chatService{chatrepo: chatrepo, matchrepo: matchrepo}
+func NewChatService(chatrepo repository.ChatMessageRepository, matchrepo repository.MatchRepository, rideofferepo repository.RideOfferRepository, userrepo repository.UserRepository, orgrepo repository.OrganizationRepository, blockrepo repository.UserBlockRepository) ChatService {
+	return &chatService{
+		chatrepo:     chatrepo,
+		matchrepo:    matchrepo,
+		rideofferepo: rideofferepo,
+		scope:        orgScope{userrepo: userrepo, orgrepo: orgrepo},
+		blocks:       blockList{blockrepo: blockrepo},
+	}
+}
+
+// withoutSilenced drops messages from users the caller blocked or muted, or
+// who blocked the caller. System messages about the ride are always kept
+func (s chatService) withoutSilenced(ctx context.Context, callerID string, msgs []db.ChatMessage) ([]db.ChatMessage, error) {
+	silenced, err := s.blocks.silencedFor(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(silenced) == 0 {
+		return msgs, nil
+	}
+	out := msgs[:0]
+	for _, m := range msgs {
+		if _, ok := silenced[m.SenderID]; !ok || m.System {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+// rideVisible checks the ride belongs to an org the caller can see or the
+// caller takes part in it, a user moved to another org keeps their chats
+func (s chatService) rideVisible(ctx context.Context, callerID, rideID string) error {
+	offer, err := s.rideofferepo.FindByID(ctx, rideID)
+	if err != nil || offer == nil || offer.ID == "" {
+		return errOfferNotFound
+	}
+	if offer.DriverID == callerID {
+		return nil
+	}
+	if ok, err := s.scope.canSee(ctx, callerID, offer.OrgID); err == nil && ok {
+		return nil
+	}
+	matches, err := s.matchrepo.FindByRideID(ctx, rideID)
+	if err != nil {
+		return errOfferNotFound
+	}
+	for _, m := range matches {
+		if m.RiderID == callerID {
+			return nil
+		}
+	}
+	return errOfferNotFound
+}
+
+// userVisible checks the user belongs to an org the caller can see
+func (s chatService) userVisible(ctx context.Context, callerID, userID string) error {
+	u, err := s.scope.user(ctx, userID)
+	if err != nil {
+		return errUserNotFound
+	}
+	if ok, err := s.scope.canSee(ctx, callerID, u.OrgID); err != nil || !ok {
+		return errUserNotFound
+	}
+	return nil
 }
 
 func (s chatService) SendMessage(ctx context.Context, msg *db.ChatMessage) error {
@@ -49,51 +114,84 @@ func (s chatService) SendMessage(ctx context.Context, msg *db.ChatMessage) error
 		return err
 	}
 	allowed := false
+	others := make(map[string]struct{})
 	for _, m := range matches {
-		if (m.RiderID == msg.SenderID || m.DriverID == msg.SenderID) &&
-			(m.Status == "accepted" || m.Status == "completed") {
+		if m.Status != "accepted" && m.Status != "completed" {
+			continue
+		}
+		if m.RiderID == msg.SenderID || m.DriverID == msg.SenderID {
 			allowed = true
-			break
 		}
+		others[m.RiderID] = struct{}{}
+		others[m.DriverID] = struct{}{}
 	}
 	if !allowed {
 		return errChatNotAllowed
 	}
+	// the sender can't post while a block stands between them and anyone
+	// else on the ride, in either direction
+	delete(others, msg.SenderID)
+	for id := range others {
+		if blocked, err := s.blocks.between(ctx, msg.SenderID, id); err != nil || blocked {
+			return errBlocked
+		}
+	}
 	msg.Timestamp = time.Now().UTC()
 	return s.chatrepo.Create(ctx, msg)
 }
 
-func (s chatService) ListMessagesByRide(ctx context.Context, rideID string, limit int, before time.Time) ([]db.ChatMessage, error) {
+func (s chatService) ListMessagesByRide(ctx context.Context, callerID, rideID string, limit int, before time.Time) ([]db.ChatMessage, error) {
 	rideID = strings.TrimSpace(rideID)
+	if err := s.rideVisible(ctx, callerID, rideID); err != nil {
+		return nil, err
+	}
 	if before.IsZero() {
 		before = time.Now().UTC()
 	}
 	if limit <= 0 {
 		limit = 50
 	}
-	return s.chatrepo.ListByRide(ctx, rideID, limit, before)
+	msgs, err := s.chatrepo.ListByRide(ctx, rideID, limit, before)
+	if err != nil {
+		return nil, err
+	}
+	return s.withoutSilenced(ctx, callerID, msgs)
 }
 
-func (s chatService) ListMessagesBySender(ctx context.Context, senderID string, limit int, before time.Time) ([]db.ChatMessage, error) {
+func (s chatService) ListMessagesBySender(ctx context.Context, callerID, senderID string, limit int, before time.Time) ([]db.ChatMessage, error) {
 	senderID = strings.TrimSpace(senderID)
+	if err := s.userVisible(ctx, callerID, senderID); err != nil {
+		return nil, err
+	}
 	if before.IsZero() {
 		before = time.Now().UTC()
 	}
 	if limit <= 0 {
 		limit = 50
 	}
-	return s.chatrepo.ListBySender(ctx, senderID, limit, before)
+	msgs, err := s.chatrepo.ListBySender(ctx, senderID, limit, before)
+	if err != nil {
+		return nil, err
+	}
+	return s.withoutSilenced(ctx, callerID, msgs)
 }
 
-func (s chatService) ListChatsForUser(ctx context.Context, userID string, limit int, before time.Time) ([]db.ChatMessage, error) {
+func (s chatService) ListChatsForUser(ctx context.Context, callerID, userID string, limit int, before time.Time) ([]db.ChatMessage, error) {
 	userID = strings.TrimSpace(userID)
+	if err := s.userVisible(ctx, callerID, userID); err != nil {
+		return nil, err
+	}
 	if before.IsZero() {
 		before = time.Now().UTC()
 	}
 	if limit <= 0 {
 		limit = 50
 	}
-	return s.chatrepo.ListChatsForUser(ctx, userID, limit, before)
+	msgs, err := s.chatrepo.ListChatsForUser(ctx, userID, limit, before)
+	if err != nil {
+		return nil, err
+	}
+	return s.withoutSilenced(ctx, callerID, msgs)
 }
 
 func (s chatService) DeleteMessage(ctx context.Context, id string) error {
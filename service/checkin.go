@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"hope/db"
+)
+
+var (
+	errPinNone          = errors.New("invalid pin: none issued, ask the rider to show theirs")
+	errPinExpired       = errors.New("invalid pin: expired, ask the rider for a new one")
+	errPinLocked        = errors.New("invalid pin: too many attempts, ask the rider for a new one")
+	errPinWrong         = errors.New("invalid pin")
+	errAlreadyCheckedIn = errors.New("invalid state: rider already checked in")
+	errNotCheckedIn     = errors.New("invalid state: rider not checked in")
+)
+
+const (
+	pinDigits = 6
+	// wrong guesses a PIN survives before the rider has to issue a new one
+	maxPinAttempts = 5
+)
+
+func (s matchService) IssuePickupPin(ctx context.Context, callerID, matchID string) (string, time.Time, error) {
+	callerID = strings.TrimSpace(callerID)
+	m, err := s.matchrepo.FindByID(ctx, strings.TrimSpace(matchID))
+	if err != nil || m == nil || m.ID == "" {
+		return "", time.Time{}, errMatchNotFound
+	}
+	if m.RiderID != callerID {
+		return "", time.Time{}, errForbidden
+	}
+	if m.Status != "accepted" {
+		return "", time.Time{}, errTripNotActive
+	}
+	if m.StartedAt != nil {
+		return "", time.Time{}, errAlreadyCheckedIn
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	pin := fmt.Sprintf("%0*d", pinDigits, n.Int64())
+	expires := time.Now().UTC().Add(s.checkin.PinTTL)
+	if err := s.matchrepo.SetPin(ctx, m.ID, hashPin(m.ID, pin), expires); err != nil {
+		return "", time.Time{}, err
+	}
+	return pin, expires, nil
+}
+
+func (s matchService) CheckInRider(ctx context.Context, callerID, matchID, pin string) (*db.Match, error) {
+	callerID = strings.TrimSpace(callerID)
+	m, err := s.matchrepo.FindByID(ctx, strings.TrimSpace(matchID))
+	if err != nil || m == nil || m.ID == "" {
+		return nil, errMatchNotFound
+	}
+	if m.DriverID != callerID {
+		return nil, errForbidden
+	}
+	if m.Status != "accepted" {
+		return nil, errTripNotActive
+	}
+	if m.StartedAt != nil {
+		return nil, errAlreadyCheckedIn
+	}
+	now := time.Now().UTC()
+	switch {
+	case m.PinHash == "" || m.PinExpires == nil:
+		return nil, errPinNone
+	case m.PinAttempts >= maxPinAttempts:
+		return nil, errPinLocked
+	case now.After(*m.PinExpires):
+		return nil, errPinExpired
+	}
+	given := hashPin(m.ID, strings.TrimSpace(pin))
+	if subtle.ConstantTimeCompare([]byte(given), []byte(m.PinHash)) != 1 {
+		if err := s.matchrepo.PinMissed(ctx, m.ID); err != nil {
+			return nil, err
+		}
+		return nil, errPinWrong
+	}
+
+	if err := s.matchrepo.CheckIn(ctx, m.ID, now); err != nil {
+		return nil, err
+	}
+	m.StartedAt, m.PinHash, m.PinExpires = &now, "", nil
+	return m, nil
+}
+
+// hashPin keeps PINs out of the database, salted with the match so the
+// same PIN on two matches doesn't look alike
+func hashPin(matchID, pin string) string {
+	sum := sha256.Sum256([]byte(matchID + ":" + pin))
+	return hex.EncodeToString(sum[:])
+}
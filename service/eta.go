@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"hope/config"
+	"hope/db"
+	"hope/geo"
+	"hope/repository"
+)
+
+var (
+	errDriverLocationUnknown = errors.New("invalid state: driver location unknown")
+	errPickupUnknown         = errors.New("invalid state: pickup point unknown")
+)
+
+// within this distance the driver counts as arrived
+const arrivedMeters = 50.0
+
+// PickupETA is how long the driver needs to reach the rider's pickup point.
+type PickupETA struct {
+	Seconds        int64
+	DistanceMeters float64 // road distance estimate, not straight line
+	SpeedKMH       float64
+	// Observed is true when the speed came from the driver's own recent
+	// track rather than the configured default
+	Observed   bool
+	LocationAt time.Time // when the driver position used was taken
+}
+
+// etaEstimator turns a driver position plus the speed model into an ETA
+type etaEstimator struct {
+	pointrepo repository.TripPointRepository
+	model     config.SpeedModel
+}
+
+// speedKMH is the driver's average speed over the model window, clamped to
+// the model bounds. ok is false when there isn't enough history
+func (e etaEstimator) speedKMH(ctx context.Context, driverID string) (kmh float64, ok bool) {
+	points, err := e.pointrepo.ListRecentByUser(ctx, driverID, time.Now().UTC().Add(-e.model.Window))
+	if err != nil || len(points) < 2 {
+		return e.model.DefaultKMH, false
+	}
+	var meters float64
+	for i := 1; i < len(points); i++ {
+		meters += geo.Distance(points[i-1].Latitude, points[i-1].Longitude, points[i].Latitude, points[i].Longitude)
+	}
+	secs := points[len(points)-1].RecordedAt.Sub(points[0].RecordedAt).Seconds()
+	if secs < 30 {
+		return e.model.DefaultKMH, false
+	}
+	kmh = meters / secs * 3.6
+	return math.Max(e.model.MinKMH, math.Min(e.model.MaxKMH, kmh)), true
+}
+
+func (e etaEstimator) estimate(fromLat, fromLon, toLat, toLon, kmh float64, observed bool, at time.Time) PickupETA {
+	straight := geo.Distance(fromLat, fromLon, toLat, toLon)
+	out := PickupETA{SpeedKMH: kmh, Observed: observed, LocationAt: at}
+	if straight <= arrivedMeters {
+		return out
+	}
+	out.DistanceMeters = straight * e.model.RoadFactor
+	if kmh > 0 {
+		out.Seconds = int64(math.Ceil(out.DistanceMeters / (kmh / 3.6)))
+	}
+	return out
+}
+
+// pickupPoint is where the rider of m is collected, the offer origin for
+// matches made before pickups were recorded
+func pickupPoint(ctx context.Context, offerrepo repository.RideOfferRepository, m *db.Match) (lat, lon float64, err error) {
+	hash := m.PickupGeo
+	if hash == "" {
+		offer, err := offerrepo.FindByID(ctx, m.RideID)
+		if err != nil || offer == nil {
+			return 0, 0, errPickupUnknown
+		}
+		hash = offer.FromGeo
+	}
+	lat, lon, err = geo.Decode(hash)
+	if err != nil {
+		return 0, 0, errPickupUnknown
+	}
+	return lat, lon, nil
+}
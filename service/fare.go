@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"hope/config"
+	"hope/db"
+	"hope/repository"
+	"hope/routing"
+)
+
+var (
+	errInvalidFare       = errors.New("invalid fare: can't be negative")
+	errFareOutside       = errors.New("invalid fare: outside the org's fare policy")
+	errInvalidFarePolicy = errors.New("invalid fare policy: ratios can't be negative, max_ratio has to be positive and at least min_ratio, and max_counters can't be negative")
+	errNoCounters        = errors.New("invalid state: the fare can't be countered any more")
+	errNotNegotiating    = errors.New("invalid state: the fare can only change before the match is accepted")
+	errFareAwaitsRider   = errors.New("invalid state: the rider hasn't agreed to your fare yet")
+	errFareAwaitsDriver  = errors.New("invalid state: the driver hasn't agreed to the fare yet")
+	errNoCounterToAccept = errors.New("invalid state: the driver hasn't countered the fare")
+)
+
+// farePolicies looks up the fare policy of an org and checks fares
+// against it
+type farePolicies struct {
+	orgrepo repository.OrganizationRepository
+	router  routing.Router
+	model   config.FareModel
+	cfg     config.FarePolicy
+}
+
+// of is orgID's fare policy, the configured default when it set none
+func (f farePolicies) of(ctx context.Context, orgID string) (*db.OrgFarePolicy, error) {
+	p, err := f.orgrepo.FindFarePolicy(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		p = &db.OrgFarePolicy{
+			OrgID:       orgID,
+			MinRatio:    f.cfg.MinRatio,
+			MaxRatio:    f.cfg.MaxRatio,
+			MaxCounters: f.cfg.MaxCounters,
+		}
+	}
+	return p, nil
+}
+
+// listed is the fare proposals on a trip from fromGeo to toGeo are held
+// against: listed when the driver set one, else the fare model's estimate
+func (f farePolicies) listed(listed float64, fromGeo, toGeo string) (float64, error) {
+	if listed > 0 {
+		return listed, nil
+	}
+	from, err := geoPoint("from", fromGeo)
+	if err != nil {
+		return 0, err
+	}
+	to, err := geoPoint("to", toGeo)
+	if err != nil {
+		return 0, err
+	}
+	r, err := f.router.Route(from, to)
+	if err != nil {
+		return 0, err
+	}
+	return estimateFare(f.model, r).Fare, nil
+}
+
+// check fails unless fare lies within what orgID's policy allows around
+// the listed fare
+func (f farePolicies) check(ctx context.Context, orgID string, listed, fare float64) error {
+	if fare < 0 {
+		return errInvalidFare
+	}
+	p, err := f.of(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	lo, hi := roundFare(listed*p.MinRatio), roundFare(listed*p.MaxRatio)
+	if fare < lo || fare > hi {
+		return fmt.Errorf("%w: propose %.2f to %.2f", errFareOutside, lo, hi)
+	}
+	return nil
+}
+
+func roundFare(f float64) float64 {
+	return math.Round(f*100) / 100
+}
+
+// driverAgreed tells whether the driver took m's fare: the listed one,
+// their own counter, or a rider's proposal of at least the listed fare.
+// Only then can the rule or the waitlist let the rider in without them
+func driverAgreed(offer *db.RideOffer, m db.Match) bool {
+	return m.FareBy != m.RiderID || m.Fare >= offer.Fare
+}
+
+// openFare puts the fare m starts out with on the table: the rider's
+// proposal when they made one, held against the offer org's policy, else
+// the offer's listed fare
+func (s matchService) openFare(ctx context.Context, offer *db.RideOffer, m *db.Match) error {
+	m.FareCounters = 0
+	if m.Fare < 0 {
+		return errInvalidFare
+	}
+	if m.Fare == 0 || m.Fare == offer.Fare {
+		m.Fare, m.FareBy = offer.Fare, ""
+		return nil
+	}
+	listed, err := s.fares.listed(offer.Fare, m.PickupGeo, m.DropoffGeo)
+	if err != nil {
+		return err
+	}
+	if err := s.fares.check(ctx, offer.OrgID, listed, m.Fare); err != nil {
+		return err
+	}
+	m.FareBy = m.RiderID
+	return nil
+}
+
+func (s matchService) CounterFare(ctx context.Context, callerID, matchID string, fare float64, note string) (*db.Match, error) {
+	callerID = strings.TrimSpace(callerID)
+	note, err := cleanReason(note)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.matchrepo.FindByID(ctx, strings.TrimSpace(matchID))
+	if err != nil || m == nil || m.ID == "" {
+		return nil, errMatchNotFound
+	}
+	if m.RiderID != callerID && m.DriverID != callerID {
+		return nil, errForbidden
+	}
+	if m.Status != "requested" && m.Status != "waitlisted" && m.Status != "promoted" {
+		return nil, errNotNegotiating
+	}
+	offer, err := s.rideofferepo.FindByID(ctx, m.RideID)
+	if err != nil || offer == nil || offer.ID == "" {
+		return nil, errOfferNotFound
+	}
+	policy, err := s.fares.of(ctx, offer.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	if m.FareCounters >= policy.MaxCounters {
+		return nil, errNoCounters
+	}
+	listed, err := s.fares.listed(offer.Fare, m.PickupGeo, m.DropoffGeo)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.fares.check(ctx, offer.OrgID, listed, fare); err != nil {
+		return nil, err
+	}
+
+	p := &db.FareProposal{MatchID: m.ID, ActorID: callerID, Fare: fare, Note: note, CreatedAt: time.Now().UTC()}
+	if err := s.matchrepo.CounterFare(ctx, p); err != nil {
+		return nil, err
+	}
+	m.Fare, m.FareBy = fare, callerID
+	m.FareCounters++
+	return m, nil
+}
+
+func (s matchService) AcceptFare(ctx context.Context, callerID, matchID string) (*db.Match, error) {
+	callerID = strings.TrimSpace(callerID)
+	m, err := s.matchrepo.FindByID(ctx, strings.TrimSpace(matchID))
+	if err != nil || m == nil || m.ID == "" {
+		return nil, errMatchNotFound
+	}
+	if m.RiderID != callerID {
+		return nil, errForbidden
+	}
+	if m.FareBy != m.DriverID {
+		return nil, errNoCounterToAccept
+	}
+	switch m.Status {
+	case "requested":
+	case "promoted":
+		return s.ConfirmWaitlistSeat(ctx, callerID, m.ID)
+	case "waitlisted":
+		return nil, errNotPromoted
+	default:
+		return nil, errNotNegotiating
+	}
+	if blocked, err := s.blocks.between(ctx, m.DriverID, m.RiderID); err != nil || blocked {
+		return nil, errBlocked
+	}
+	// the driver's counter is their offer to take the rider at that fare
+	c := repository.Change{ActorID: callerID, Reason: fmt.Sprintf("agreed to the driver's fare of %.2f", m.Fare)}
+	if err := s.acceptJoin(ctx, m, nil, c); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (s matchService) GetFareHistory(ctx context.Context, callerID, matchID string) ([]db.FareProposal, error) {
+	callerID = strings.TrimSpace(callerID)
+	m, err := s.matchrepo.FindByID(ctx, strings.TrimSpace(matchID))
+	if err != nil || m == nil || m.ID == "" {
+		return nil, errMatchNotFound
+	}
+	if m.RiderID != callerID && m.DriverID != callerID && !s.scope.audits(ctx, callerID, m.OrgID) {
+		return nil, errMatchNotFound
+	}
+	return s.matchrepo.ListFareProposals(ctx, m.ID)
+}
+
+func (s organizationService) GetFarePolicy(ctx context.Context, callerID, orgID string) (*db.OrgFarePolicy, error) {
+	u, err := s.scope.user(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	orgID = strings.TrimSpace(orgID)
+	if orgID == "" {
+		orgID = u.OrgID
+	}
+	if orgID == "" {
+		return nil, errUserHasNoOrg
+	}
+	if orgID != u.OrgID {
+		if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+			return nil, err
+		}
+	}
+	return s.fares.of(ctx, orgID)
+}
+
+func (s organizationService) SetFarePolicy(ctx context.Context, callerID string, policy *db.OrgFarePolicy) error {
+	if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+		return err
+	}
+	if policy == nil {
+		return errMissingFields
+	}
+	if policy.MinRatio < 0 || policy.MaxRatio <= 0 || policy.MinRatio > policy.MaxRatio || policy.MaxCounters < 0 {
+		return errInvalidFarePolicy
+	}
+	org, err := s.orgrepo.FindByID(ctx, strings.TrimSpace(policy.OrgID))
+	if err != nil {
+		return err
+	}
+	if org == nil || org.ID == "" {
+		return errOrgNotFound
+	}
+	policy.OrgID = org.ID
+	policy.UpdatedBy = strings.TrimSpace(callerID)
+	policy.UpdatedAt = time.Now().UTC()
+	return s.orgrepo.SaveFarePolicy(ctx, policy)
+}
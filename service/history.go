@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"hope/db"
+)
+
+func (s matchService) GetMatchHistory(ctx context.Context, callerID, matchID string) ([]db.MatchEvent, error) {
+	callerID = strings.TrimSpace(callerID)
+	m, err := s.matchrepo.FindByID(ctx, strings.TrimSpace(matchID))
+	if err != nil || m == nil || m.ID == "" {
+		return nil, errMatchNotFound
+	}
+	if m.RiderID != callerID && m.DriverID != callerID && !s.scope.audits(ctx, callerID, m.OrgID) {
+		return nil, errMatchNotFound
+	}
+	return s.matchrepo.ListEvents(ctx, m.ID)
+}
+
+// audits tells whether userID is an admin who can see orgID, and so may
+// read the history of its rows
+func (s orgScope) audits(ctx context.Context, userID, orgID string) bool {
+	if err := s.requireAdmin(ctx, userID); err != nil {
+		return false
+	}
+	ok, err := s.canSee(ctx, userID, orgID)
+	return err == nil && ok
+}
+
+func (s rideService) GetOfferHistory(ctx context.Context, callerID, offerID string) ([]db.OfferEvent, error) {
+	callerID = strings.TrimSpace(callerID)
+	offerID = strings.TrimSpace(offerID)
+	events, err := s.rideofferepo.ListEvents(ctx, offerID)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.rideofferepo.FindByID(ctx, offerID)
+	if err != nil {
+		return nil, err
+	}
+	if o == nil {
+		// a deleted offer's history stays with its driver, who created it
+		if len(events) == 0 || events[0].ActorID != callerID {
+			return nil, errOfferNotFound
+		}
+		return events, nil
+	}
+	if o.DriverID != callerID && !s.scope.audits(ctx, callerID, o.OrgID) {
+		return nil, errOfferNotFound
+	}
+	return events, nil
+}
+
+func (s rideService) GetRequestHistory(ctx context.Context, callerID, requestID string) ([]db.RequestEvent, error) {
+	callerID = strings.TrimSpace(callerID)
+	requestID = strings.TrimSpace(requestID)
+	events, err := s.riderequestrepo.ListEvents(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.riderequestrepo.FindByID(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		if len(events) == 0 || events[0].ActorID != callerID {
+			return nil, errRequestNotFound
+		}
+		return events, nil
+	}
+	if r.UserID != callerID && !s.scope.audits(ctx, callerID, r.OrgID) {
+		return nil, errRequestNotFound
+	}
+	return events, nil
+}
@@ -5,28 +5,77 @@ import (
 	"errors"
 	"hope/db"
 	"hope/repository"
+	"math"
 	"strings"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 var (
-	errInvalidLatLon    = errors.New("invalid latitude or longitude")
-	errLocationNotFound = errors.New("location not found")
+	errInvalidLatLon     = errors.New("invalid latitude or longitude")
+	errLocationNotFound  = errors.New("location not found")
+	errInvalidVisibility = errors.New("visibility must be nobody, matched, org or everyone")
+)
+
+const (
+	visibilityNobody   = "nobody"
+	visibilityMatched  = "matched"
+	visibilityOrg      = "org"
+	visibilityEveryone = "everyone"
+
+	defaultVisibility = visibilityOrg
+
+	// non-matched viewers get a ~5km geohash cell and coordinates rounded
+	// to 0.01° (~1km) instead of the real point
+	coarseGeohashLen = 5
+	coarseDegrees    = 0.01
+
+	// a match counts as active, and location is shared with the other side,
+	// from shareLead before the ride's departure until shareTail after it
+	shareLead = 30 * time.Minute
+	shareTail = 3 * time.Hour
 )
 
 type LocationService interface {
 	UpsertLocation(ctx context.Context, loc *db.UserLocation) error
-	GetLocationByUser(ctx context.Context, userID string) (*db.UserLocation, error)
-	ListNearby(ctx context.Context, geohashPrefix string, limit int) ([]db.UserLocation, error)
+	GetLocationByUser(ctx context.Context, callerID, userID string) (*db.UserLocation, error)
+	ListNearby(ctx context.Context, callerID, geohashPrefix string, limit int) ([]db.UserLocation, error)
+	// ListWithinRadius is ListNearby around a point, closest first
+	ListWithinRadius(ctx context.Context, callerID string, lat, lon, meters float64, limit int) ([]db.UserLocation, error)
 	DeleteLocation(ctx context.Context, userID string) error
+
+	GetSettings(ctx context.Context, userID string) (*db.LocationSetting, error)
+	UpdateSettings(ctx context.Context, userID, visibility string) (*db.LocationSetting, error)
+	ListViews(ctx context.Context, ownerID string, limit int, before time.Time) ([]db.LocationView, error)
 }
 
 type locationService struct {
 	locationrepo repository.UserLocationRepository
+	settingrepo  repository.LocationSettingRepository
+	viewrepo     repository.LocationViewRepository
+	matchrepo    repository.MatchRepository
+	scope        orgScope
+	blocks       blockList
 }
 
-func NewLocationService(locationrepo repository.UserLocationRepository) LocationService {
-	return &locationService{locationrepo: locationrepo}
+func NewLocationService(
+	locationrepo repository.UserLocationRepository,
+	settingrepo repository.LocationSettingRepository,
+	viewrepo repository.LocationViewRepository,
+	matchrepo repository.MatchRepository,
+	userrepo repository.UserRepository,
+	orgrepo repository.OrganizationRepository,
+	blockrepo repository.UserBlockRepository,
+) LocationService {
+	return &locationService{
+		locationrepo: locationrepo,
+		settingrepo:  settingrepo,
+		viewrepo:     viewrepo,
+		matchrepo:    matchrepo,
+		scope:        orgScope{userrepo: userrepo, orgrepo: orgrepo},
+		blocks:       blockList{blockrepo: blockrepo},
+	}
 }
 
 func (s locationService) UpsertLocation(ctx context.Context, loc *db.UserLocation) error {
@@ -42,23 +91,245 @@ func (s locationService) UpsertLocation(ctx context.Context, loc *db.UserLocatio
 	}
 	loc.UpdatedAt = time.Now().UTC()
 
+	// the owner's org is denormalized onto the row so nearby queries stay a single scan
+	u, err := s.scope.user(ctx, loc.UserID)
+	if err != nil {
+		return err
+	}
+	loc.OrgID = u.OrgID
+
 	return s.locationrepo.Upsert(ctx, loc)
 }
 
-func (s locationService) GetLocationByUser(ctx context.Context, userID string) (*db.UserLocation, error) {
+func (s locationService) GetLocationByUser(ctx context.Context, callerID, userID string) (*db.UserLocation, error) {
 	userID = strings.TrimSpace(userID)
 
 	loc, err := s.locationrepo.GetByUserID(ctx, userID)
 	if err != nil || loc == nil {
 		return nil, errLocationNotFound
 	}
-	return loc, nil
+	if ok, err := s.scope.canSee(ctx, callerID, loc.OrgID); err != nil || !ok {
+		return nil, errLocationNotFound
+	}
+	if blocked, err := s.blocks.between(ctx, callerID, loc.UserID); err != nil || blocked {
+		return nil, errLocationNotFound
+	}
+
+	v, err := s.newViewer(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := s.settingrepo.FindByUserIDs(ctx, []string{loc.UserID})
+	if err != nil {
+		return nil, err
+	}
+	out, ok := v.sees(*loc, settings)
+	if !ok {
+		return nil, errLocationNotFound
+	}
+	s.audit(ctx, callerID, []db.UserLocation{out})
+	return &out, nil
 }
 
-func (s locationService) ListNearby(ctx context.Context, geohashPrefix string, limit int) ([]db.UserLocation, error) {
-	return s.locationrepo.ListNearby(ctx, strings.TrimSpace(geohashPrefix), limit)
+func (s locationService) ListNearby(ctx context.Context, callerID, geohashPrefix string, limit int) ([]db.UserLocation, error) {
+	geohashPrefix = strings.TrimSpace(geohashPrefix)
+	orgIDs, err := s.scope.visibleOrgIDs(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	locs, err := s.locationrepo.ListNearby(ctx, orgIDs, geohashPrefix, limit)
+	if err != nil {
+		return nil, err
+	}
+	// a prefix finer than the coarse cell would give the exact spot
+	// away just by matching, so fuzzed users drop out of such searches
+	return s.shown(ctx, callerID, locs, len(geohashPrefix) <= coarseGeohashLen)
+}
+
+func (s locationService) ListWithinRadius(ctx context.Context, callerID string, lat, lon, meters float64, limit int) ([]db.UserLocation, error) {
+	if err := checkCircle(lat, lon, meters); err != nil {
+		return nil, err
+	}
+	orgIDs, err := s.scope.visibleOrgIDs(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	locs, err := s.locationrepo.ListWithinRadius(ctx, orgIDs, lat, lon, meters, limit)
+	if err != nil {
+		return nil, err
+	}
+	// whether someone is inside the circle depends on their exact point,
+	// so moving the centre around would corner a fuzzed user. They are
+	// only found by prefix
+	return s.shown(ctx, callerID, locs, false)
+}
+
+// shown applies blocks and visibility settings to search results and
+// audits what was returned. coarseOK says whether the search was wide
+// enough to include users only visible as a coarse point
+func (s locationService) shown(ctx context.Context, callerID string, locs []db.UserLocation, coarseOK bool) ([]db.UserLocation, error) {
+	hidden, err := s.blocks.hiddenFrom(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.newViewer(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	ownerIDs := make([]string, 0, len(locs))
+	for _, l := range locs {
+		ownerIDs = append(ownerIDs, l.UserID)
+	}
+	settings, err := s.settingrepo.FindByUserIDs(ctx, ownerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]db.UserLocation, 0, len(locs))
+	for _, l := range locs {
+		if _, ok := hidden[l.UserID]; ok {
+			continue
+		}
+		shown, ok := v.sees(l, settings)
+		if !ok {
+			continue
+		}
+		if shown.Coarse && !coarseOK {
+			continue
+		}
+		out = append(out, shown)
+	}
+	s.audit(ctx, callerID, out)
+	return out, nil
 }
 
 func (s locationService) DeleteLocation(ctx context.Context, userID string) error {
 	return s.locationrepo.Delete(ctx, strings.TrimSpace(userID))
 }
+
+func (s locationService) GetSettings(ctx context.Context, userID string) (*db.LocationSetting, error) {
+	userID = strings.TrimSpace(userID)
+	st, err := s.settingrepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if st == nil {
+		st = &db.LocationSetting{UserID: userID, Visibility: defaultVisibility}
+	}
+	return st, nil
+}
+
+func (s locationService) UpdateSettings(ctx context.Context, userID, visibility string) (*db.LocationSetting, error) {
+	userID = strings.TrimSpace(userID)
+	visibility = strings.ToLower(strings.TrimSpace(visibility))
+	switch visibility {
+	case visibilityNobody, visibilityMatched, visibilityOrg, visibilityEveryone:
+	default:
+		return nil, errInvalidVisibility
+	}
+	st := &db.LocationSetting{
+		UserID:     userID,
+		Visibility: visibility,
+		UpdatedAt:  time.Now().UTC(),
+	}
+	if err := s.settingrepo.Upsert(ctx, st); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+func (s locationService) ListViews(ctx context.Context, ownerID string, limit int, before time.Time) ([]db.LocationView, error) {
+	return s.viewrepo.ListByOwner(ctx, strings.TrimSpace(ownerID), limit, before)
+}
+
+// audit records that callerID was shown locs. It is best effort, a failed
+// insert shouldn't fail the read
+func (s locationService) audit(ctx context.Context, callerID string, locs []db.UserLocation) {
+	now := time.Now().UTC()
+	views := make([]db.LocationView, 0, len(locs))
+	for _, l := range locs {
+		if l.UserID == callerID {
+			continue
+		}
+		views = append(views, db.LocationView{
+			ID:       uuid.New().String(),
+			OwnerID:  l.UserID,
+			ViewerID: callerID,
+			Precise:  !l.Coarse,
+			ViewedAt: now,
+		})
+	}
+	_ = s.viewrepo.CreateBatch(ctx, views)
+}
+
+// viewer is what the visibility rules need to know about the caller
+type viewer struct {
+	id    string
+	orgID string
+	// users the caller has an accepted match with inside the sharing window
+	matched map[string]struct{}
+}
+
+func (s locationService) newViewer(ctx context.Context, callerID string) (viewer, error) {
+	u, err := s.scope.user(ctx, callerID)
+	if err != nil {
+		return viewer{}, err
+	}
+	matches, err := s.matchrepo.ListAcceptedForUser(ctx, callerID)
+	if err != nil {
+		return viewer{}, err
+	}
+	now := time.Now()
+	v := viewer{id: callerID, orgID: u.OrgID, matched: make(map[string]struct{})}
+	for _, m := range matches {
+		if m.Ride == nil || now.Before(m.Ride.Time.Add(-shareLead)) || now.After(m.Ride.Time.Add(shareTail)) {
+			continue
+		}
+		if m.RiderID == callerID {
+			v.matched[m.DriverID] = struct{}{}
+		} else {
+			v.matched[m.RiderID] = struct{}{}
+		}
+	}
+	return v, nil
+}
+
+// sees applies the owner's visibility setting. Owners always see themselves,
+// active match counterparts get the exact point unless the owner chose
+// nobody, everyone else gets the coarse point when the setting allows it.
+func (v viewer) sees(loc db.UserLocation, settings map[string]db.LocationSetting) (db.UserLocation, bool) {
+	if loc.UserID == v.id {
+		return loc, true
+	}
+	vis := defaultVisibility
+	if st, ok := settings[loc.UserID]; ok && st.Visibility != "" {
+		vis = st.Visibility
+	}
+	if vis == visibilityNobody {
+		return loc, false
+	}
+	if _, ok := v.matched[loc.UserID]; ok {
+		return loc, true
+	}
+	switch vis {
+	case visibilityOrg:
+		if loc.OrgID != v.orgID {
+			return loc, false
+		}
+	case visibilityEveryone:
+		// org scope was already checked by the caller
+	default:
+		return loc, false
+	}
+	return coarsen(loc), true
+}
+
+func coarsen(loc db.UserLocation) db.UserLocation {
+	loc.Latitude = math.Round(loc.Latitude/coarseDegrees) * coarseDegrees
+	loc.Longitude = math.Round(loc.Longitude/coarseDegrees) * coarseDegrees
+	if len(loc.Geohash) > coarseGeohashLen {
+		loc.Geohash = loc.Geohash[:coarseGeohashLen]
+	}
+	loc.Coarse = true
+	return loc
+}
@@ -3,11 +3,16 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
+	"math"
 	"strings"
 	"time"
 
+	"hope/config"
 	"hope/db"
 	"hope/repository"
+	"hope/routing"
 
 	"github.com/google/uuid"
 )
@@ -18,27 +23,139 @@ var (
 )
 
 type MatchService interface {
-	RequestToJoin(ctx context.Context, match *db.Match) error
-	AcceptRideRequest(ctx context.Context, driverID, requestID string) (*db.Match, error)
-	AcceptRequest(ctx context.Context, callerID, matchID string) error
-	RejectRequest(ctx context.Context, callerID, matchID string) error
-	CompleteMatch(ctx context.Context, matchID string) error
-	GetMatchByID(ctx context.Context, matchID string) (*db.Match, error)
-	ListMatchesByRide(ctx context.Context, rideID string) ([]db.Match, error)
-	ListMatchesByRider(ctx context.Context, riderID string) ([]db.Match, error)
+	// RequestToJoin asks for seats on an offer, or joins its waitlist when full and waitlist is set
+	RequestToJoin(ctx context.Context, match *db.Match, waitlist bool) error
+	// RequestRoundTrip joins two rides as the legs of a round trip
+	RequestRoundTrip(ctx context.Context, out, back *db.Match, mode string) error
+	// AcceptRideRequest has the driver take a request as a ride of its own, picking up at pickupAt
+	AcceptRideRequest(ctx context.Context, driverID, requestID string, pickupAt *time.Time) (*db.Match, error)
+	// AcceptRequest agrees on the proposed pickup time, or on pickupAt when set
+	AcceptRequest(ctx context.Context, callerID, matchID string, pickupAt *time.Time) error
+	// CounterFare proposes another fare on a match not accepted yet
+	CounterFare(ctx context.Context, callerID, matchID string, fare float64, note string) (*db.Match, error)
+	// AcceptFare is the rider agreeing to the driver's counter
+	AcceptFare(ctx context.Context, callerID, matchID string) (*db.Match, error)
+	// GetFareHistory is every fare proposed on the match, oldest first
+	GetFareHistory(ctx context.Context, callerID, matchID string) ([]db.FareProposal, error)
+	RejectRequest(ctx context.Context, callerID, matchID, reason string) error
+	// CompleteMatch ends the trip of a checked-in rider
+	CompleteMatch(ctx context.Context, callerID, matchID string) error
+	GetMatchByID(ctx context.Context, callerID, matchID string) (*db.Match, error)
+	ListMatchesByRide(ctx context.Context, callerID, rideID string) ([]db.Match, error)
+	ListMatchesByRider(ctx context.Context, callerID, riderID string) ([]db.Match, error)
+	// ListMatchesByDriver is the matches on the caller's own offers, newest first
+	ListMatchesByDriver(ctx context.Context, callerID string, limit int) ([]db.Match, error)
+
+	// WithdrawRequest takes back the caller's pending join request
+	WithdrawRequest(ctx context.Context, callerID, matchID, reason string) error
+	// CancelMatch gives up an accepted seat, from either side
+	CancelMatch(ctx context.Context, callerID, matchID, reason string) error
+	// CancelRide calls off the driver's offer and returns the matches cancelled with it
+	CancelRide(ctx context.Context, callerID, offerID, reason string) ([]db.Match, error)
+	// ReportNoShow is one side of an accepted match reporting the other missing
+	ReportNoShow(ctx context.Context, callerID, matchID string) (*db.Match, error)
+
+	// IssuePickupPin gives the rider a fresh PIN to show at pickup
+	IssuePickupPin(ctx context.Context, callerID, matchID string) (string, time.Time, error)
+	// CheckInRider is the driver entering the rider's PIN
+	CheckInRider(ctx context.Context, callerID, matchID, pin string) (*db.Match, error)
+
+	// ConfirmWaitlistSeat takes the seat held for a promoted match
+	ConfirmWaitlistSeat(ctx context.Context, callerID, matchID string) (*db.Match, error)
+	GetWaitlistPosition(ctx context.Context, callerID, matchID string) (*WaitlistSpot, error)
+
+	// GetMatchHistory is every status change of the match, oldest first
+	GetMatchHistory(ctx context.Context, callerID, matchID string) ([]db.MatchEvent, error)
+}
+
+// MatchRules are the settings from config NewMatchService works with
+type MatchRules struct {
+	Cancellation config.CancellationPolicy
+	CheckIn      config.CheckIn
+	Booking      config.Booking
+	FareModel    config.FareModel
+	FarePolicy   config.FarePolicy
 }
 
 type matchService struct {
 	matchrepo       repository.MatchRepository
 	rideofferepo    repository.RideOfferRepository
 	riderequestrepo repository.RideRequestRepository
+	scope           orgScope
+	blocks          blockList
+	waypointrepo    repository.WaypointRepository
+	chatrepo        repository.ChatMessageRepository
+	favrepo         repository.UserFavoriteRepository
+	reviewrepo      repository.ReviewRepository
+	reliability     reliabilityScores
+	trips           *TripHub
+	router          routing.Router
+	planner         *TripPlanner
+	waitlist        *Waitlist
+	sched           schedule
+	fares           farePolicies
+	policy          config.CancellationPolicy
+	checkin         config.CheckIn
+}
+
+func NewMatchService(matchrepo repository.MatchRepository, rideofferepo repository.RideOfferRepository, riderequestrepo repository.RideRequestRepository, userrepo repository.UserRepository, orgrepo repository.OrganizationRepository, blockrepo repository.UserBlockRepository, waypointrepo repository.WaypointRepository, chatrepo repository.ChatMessageRepository, favrepo repository.UserFavoriteRepository, reviewrepo repository.ReviewRepository, trips *TripHub, router routing.Router, planner *TripPlanner, waitlist *Waitlist, rules MatchRules) MatchService {
+	return &matchService{
+		matchrepo:       matchrepo,
+		rideofferepo:    rideofferepo,
+		riderequestrepo: riderequestrepo,
+		scope:           orgScope{userrepo: userrepo, orgrepo: orgrepo},
+		blocks:          blockList{blockrepo: blockrepo},
+		waypointrepo:    waypointrepo,
+		chatrepo:        chatrepo,
+		favrepo:         favrepo,
+		reviewrepo:      reviewrepo,
+		reliability:     reliabilityScores{matchrepo: matchrepo, policy: rules.Cancellation},
+		trips:           trips,
+		router:          router,
+		planner:         planner,
+		waitlist:        waitlist,
+		sched: schedule{
+			offerrepo:    rideofferepo,
+			requestrepo:  riderequestrepo,
+			matchrepo:    matchrepo,
+			waypointrepo: waypointrepo,
+			router:       router,
+			cfg:          rules.Booking,
+			times:        newLegTimes(),
+		},
+		fares:   farePolicies{orgrepo: orgrepo, router: router, model: rules.FareModel, cfg: rules.FarePolicy},
+		policy:  rules.Cancellation,
+		checkin: rules.CheckIn,
+	}
+}
+
+// takesPart reports whether the user rides in or drives the match, they keep
+// seeing it after an admin moves them to another org
+func takesPart(m *db.Match, userID string) bool {
+	return m.RiderID == userID || m.DriverID == userID
 }
 
-func NewMatchService(matchrepo repository.MatchRepository, rideofferepo repository.RideOfferRepository, riderequestrepo repository.RideRequestRepository) MatchService {
-	return &matchService{matchrepo: matchrepo, rideofferepo: rideofferepo, riderequestrepo: riderequestrepo}
+// visibleOnly drops matches belonging to orgs the caller cannot see, unless
+// the caller takes part in them
+func (s matchService) visibleOnly(ctx context.Context, callerID string, ms []db.Match) ([]db.Match, error) {
+	orgIDs, err := s.scope.visibleOrgIDs(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	visible := make(map[string]struct{}, len(orgIDs))
+	for _, id := range orgIDs {
+		visible[id] = struct{}{}
+	}
+	out := make([]db.Match, 0, len(ms))
+	for _, m := range ms {
+		if _, ok := visible[m.OrgID]; ok || takesPart(&m, callerID) {
+			out = append(out, m)
+		}
+	}
+	return out, nil
 }
 
-func (s matchService) RequestToJoin(ctx context.Context, match *db.Match) error {
+func (s matchService) RequestToJoin(ctx context.Context, match *db.Match, waitlist bool) error {
 	if match == nil {
 		return errMissingFields
 	}
@@ -54,19 +171,132 @@ func (s matchService) RequestToJoin(ctx context.Context, match *db.Match) error
 	if err != nil || offer == nil || offer.ID == "" {
 		return errors.New("ride offer not found")
 	}
+	if ok, err := s.scope.canSee(ctx, match.RiderID, offer.OrgID); err != nil || !ok {
+		return errors.New("ride offer not found")
+	}
+	if offer.Status == "cancelled" {
+		return errRideClosed
+	}
+	match.OrgID = offer.OrgID
 	match.DriverID = offer.DriverID
+	if match.Seats <= 0 {
+		match.Seats = 1
+	}
+	stops, err := routeStops(ctx, s.waypointrepo, offer)
+	if err != nil {
+		return err
+	}
+	if match.DropoffStop > 0 {
+		// boarding and leaving at planned stops adds nothing to the route
+		if match.PickupStop < 0 || match.PickupStop >= match.DropoffStop || match.DropoffStop >= len(stops) {
+			return errInvalidStops
+		}
+		match.PickupGeo = stops[match.PickupStop].Geohash
+		match.DropoffGeo = stops[match.DropoffStop].Geohash
+	} else {
+		match.PickupStop = 0
+		if match.PickupGeo == "" {
+			match.PickupGeo = offer.FromGeo
+		}
+		if match.DropoffGeo == "" {
+			match.DropoffGeo = offer.ToGeo
+		}
+		if err := s.setDetour(match, offer, stops); err != nil {
+			return err
+		}
+	}
+	if err := s.openFare(ctx, offer, match); err != nil {
+		return err
+	}
+	matches, err := s.matchrepo.FindByRideID(ctx, offer.ID)
+	if err != nil {
+		return err
+	}
+	match.Status = "requested"
+	match.AcceptRule, match.AutoAccepted, match.AcceptNote = "", false, ""
+	if err := checkSeats(offer, matches, len(stops), *match); err != nil {
+		if !waitlist || !errors.Is(err, errNoSeatsFree) {
+			return err
+		}
+		// no one leaving frees more seats than the car has
+		if err := checkSeats(offer, nil, len(stops), *match); err != nil {
+			return err
+		}
+		match.Status = "waitlisted"
+	}
+	if err := s.planPickup(offer, stops, matches, match, match.PickupAt); err != nil {
+		return err
+	}
 	if match.DriverID == "" {
 		return errors.New("offer has no driver")
 	}
-	match.Status = "requested"
+	if blocked, err := s.blocks.between(ctx, match.RiderID, match.DriverID); err != nil || blocked {
+		return errBlocked
+	}
+	if err := s.sched.check(ctx, match.RiderID, s.sched.offerBooking(offer, stops), false); err != nil {
+		return err
+	}
+	// riders waiting for a seat are let in by the waitlist, not the rule
+	if match.Status == "requested" && offer.AutoAccept != "" {
+		ok, note, err := s.autoAccept(ctx, offer, match.RiderID)
+		if err != nil {
+			return err
+		}
+		if ok && !driverAgreed(offer, *match) {
+			ok, note = false, fmt.Sprintf("fare %.2f is below the listed %.2f", match.Fare, offer.Fare)
+		}
+		if ok {
+			if err := s.checkReturnLeg(ctx, match); errors.Is(err, errAwaitsReturn) {
+				ok, note = false, "the return leg of the round trip isn't accepted yet"
+			} else if err != nil {
+				return err
+			}
+		}
+		match.AcceptRule, match.AutoAccepted, match.AcceptNote = offer.AutoAccept, ok, note
+	}
 	if match.CreatedAt.IsZero() {
 		match.CreatedAt = time.Now().UTC()
 	}
 
-	return s.matchrepo.Create(ctx, match)
+	if err := s.matchrepo.Create(ctx, match, repository.Change{ActorID: match.RiderID, At: match.CreatedAt}); err != nil {
+		return err
+	}
+	if !match.AutoAccepted {
+		return nil
+	}
+	c := repository.Change{Reason: "auto-accepted: " + match.AcceptNote}
+	if err := s.matchrepo.Accept(ctx, match.ID, match.Status, *match.PickupAt, *match.DepartAt, c); err != nil {
+		return err
+	}
+	match.Status = "accepted"
+	s.planner.refresh(ctx, offer)
+	return s.withdrawOverlapping(ctx, match, offer)
+}
+
+// setDetour records the rider's detour and stops and holds it against the
+// offer's limit, unroutable points only fail when there is a limit
+func (s matchService) setDetour(match *db.Match, offer *db.RideOffer, stops []db.Waypoint) error {
+	d, err := offerDetour(s.router, stops, match.PickupGeo, match.DropoffGeo)
+	switch {
+	case errors.Is(err, routing.ErrNoRoad), errors.Is(err, routing.ErrNoRoute):
+		if offer.MaxDetourSeconds > 0 {
+			return err
+		}
+		log.Printf("match %s: detour not routed: %v", match.ID, err)
+		return nil
+	case err != nil:
+		return err
+	}
+	match.DetourSeconds = int(math.Round(d.ExtraSeconds))
+	match.DetourMeters = math.Round(d.ExtraMeters)
+	match.PickupStop, match.DropoffStop = d.PickupAfter, d.DropoffAfter+1
+	if offer.MaxDetourSeconds > 0 && match.DetourSeconds > offer.MaxDetourSeconds {
+		return fmt.Errorf("%w: %d min over a %d min limit", errDetourTooLong, (match.DetourSeconds+59)/60, offer.MaxDetourSeconds/60)
+	}
+	return nil
 }
 
-func (s matchService) AcceptRideRequest(ctx context.Context, driverID, requestID string) (*db.Match, error) {
+func (s matchService) AcceptRideRequest(ctx context.Context, driverID, requestID string, pickupAt *time.Time) (*db.Match, error) {
 	driverID = strings.TrimSpace(driverID)
 	requestID = strings.TrimSpace(requestID)
 	if driverID == "" || requestID == "" {
@@ -82,16 +312,94 @@ func (s matchService) AcceptRideRequest(ctx context.Context, driverID, requestID
 	if req.UserID == driverID {
 		return nil, errors.New("cannot accept own request")
 	}
+	if ok, err := s.scope.canSee(ctx, driverID, req.OrgID); err != nil || !ok {
+		return nil, errors.New("ride request not found")
+	}
+	if blocked, err := s.blocks.between(ctx, driverID, req.UserID); err != nil || blocked {
+		return nil, errBlocked
+	}
+	driver, err := s.scope.user(ctx, driverID)
+	if err != nil {
+		return nil, err
+	}
+	at := req.Time
+	if pickupAt != nil {
+		d := s.sched.drive([]db.Waypoint{{Geohash: req.FromGeo}, {Geohash: req.ToGeo}})
+		w := tripWindow(req.Time, req.EarliestAt, req.LatestAt, req.ArriveBy, d).intersect(window{from: time.Now().UTC()})
+		if !w.contains(*pickupAt) {
+			return nil, fmt.Errorf("%w: pick a time from %s to %s", errPickupOutside, w.from.UTC().Format(time.RFC3339), w.to.UTC().Format(time.RFC3339))
+		}
+		at = pickupAt.UTC()
+	}
+	legs := []*db.RideRequest{req}
+	if req.PairID != "" && req.PairMode == pairTogether {
+		// the rider wants both legs of their round trip or neither
+		partner, err := s.partnerRequest(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		legs = append(legs, partner)
+	}
+	for _, r := range legs {
+		// the new offer is the request's own trip, which must fit both sides
+		trip := s.sched.requestBooking(r)
+		if err := s.sched.check(ctx, driverID, trip, true); err != nil {
+			return nil, err
+		}
+		if err := s.sched.check(ctx, r.UserID, trip, false); err != nil {
+			return nil, err
+		}
+	}
+
+	// all legs are taken in one transaction, the rider gets every one of
+	// them or none
+	c := repository.Change{ActorID: driverID, At: time.Now().UTC()}
+	taken := make([]repository.Taken, len(legs))
+	for i, r := range legs {
+		if r != req {
+			at = r.Time
+		}
+		taken[i] = s.takeRequest(driver, r, at, c.At)
+	}
+	if err := s.riderequestrepo.Take(ctx, taken, c); err != nil {
+		return nil, err
+	}
+	for _, t := range taken {
+		s.planner.refresh(ctx, t.Offer)
+		if err := s.withdrawOverlapping(ctx, t.Match, t.Offer); err != nil {
+			return nil, err
+		}
+	}
+	for _, t := range taken {
+		if t.Match.Leg == legOutbound {
+			return t.Match, nil
+		}
+	}
+	return taken[0].Match, nil
+}
 
+// takeRequest makes req a ride of its own for driver, leaving at at with
+// the rider accepted on it
+func (s matchService) takeRequest(driver *db.User, req *db.RideRequest, at, now time.Time) repository.Taken {
+	driverID := driver.ID
+	// the driver takes the fare the rider proposed, or the estimate
+	fare, err := s.fares.listed(req.Fare, req.FromGeo, req.ToGeo)
+	if err != nil {
+		log.Printf("request %s: fare not estimated: %v", req.ID, err)
+	}
 	offer := &db.RideOffer{
 		ID:       uuid.New().String(),
 		DriverID: driverID,
+		OrgID:    driver.OrgID,
 		FromGeo:  req.FromGeo,
 		ToGeo:    req.ToGeo,
-		Fare:     0,
-		Time:     req.Time,
+		Fare:     fare,
+		Time:     at,
+		ArriveBy: req.ArriveBy,
 		Seats:    max(1, req.Seats),
 		Status:   "matched",
+		PairID:   req.PairID,
+		Leg:      req.Leg,
 	}
 
 	match := &db.Match{
@@ -99,23 +407,33 @@ func (s matchService) AcceptRideRequest(ctx context.Context, driverID, requestID
 		RiderID:   req.UserID,
 		DriverID:  driverID,
 		RideID:    offer.ID,
-		Status:    "accepted",
-		CreatedAt: time.Now().UTC(),
-	}
+		OrgID:     offer.OrgID,
+		PickupGeo: req.FromGeo,
+		// the offer is the request's own route, so there is no detour
+		DropoffGeo: req.ToGeo,
+		Seats:      offer.Seats,
+		Status:     "accepted",
+		CreatedAt:  now,
 
-	if err := s.rideofferepo.Create(ctx, offer); err != nil {
-		return nil, err
-	}
-	if err := s.matchrepo.Create(ctx, match); err != nil {
-		return nil, err
+		EarliestPickup: req.EarliestAt,
+		LatestPickup:   req.LatestAt,
+		ArriveBy:       req.ArriveBy,
+		PickupAt:       &at,
+		DepartAt:       &at,
+
+		PairID:   req.PairID,
+		Leg:      req.Leg,
+		PairMode: req.PairMode,
+
+		Fare: fare,
 	}
-	if err := s.riderequestrepo.UpdateStatus(ctx, req.ID, "matched"); err != nil {
-		return nil, err
+	if req.Fare > 0 {
+		match.FareBy = req.UserID
 	}
-	return match, nil
+	return repository.Taken{RequestID: req.ID, Offer: offer, Match: match}
 }
 
-func (s matchService) AcceptRequest(ctx context.Context, callerID, matchID string) error {
+func (s matchService) AcceptRequest(ctx context.Context, callerID, matchID string, pickupAt *time.Time) error {
 	callerID = strings.TrimSpace(callerID)
 	matchID = strings.TrimSpace(matchID)
 	if callerID == "" || matchID == "" {
@@ -132,16 +450,61 @@ func (s matchService) AcceptRequest(ctx context.Context, callerID, matchID strin
 	if m.Status != "requested" {
 		return errors.New("invalid state transition")
 	}
+	if m.FareBy == m.DriverID {
+		return errFareAwaitsRider
+	}
+	if blocked, err := s.blocks.between(ctx, m.DriverID, m.RiderID); err != nil || blocked {
+		return errBlocked
+	}
+	return s.acceptJoin(ctx, m, pickupAt, repository.Change{ActorID: callerID})
+}
+
+// acceptJoin accepts the pending match m at pickupAt, or at the time it
+// proposes, when the seats and both sides' times still allow
+func (s matchService) acceptJoin(ctx context.Context, m *db.Match, pickupAt *time.Time, c repository.Change) error {
+	if err := s.checkReturnLeg(ctx, m); err != nil {
+		return err
+	}
+	offer, err := s.rideofferepo.FindByID(ctx, m.RideID)
+	if err != nil || offer == nil {
+		return errOfferNotFound
+	}
+	stops, err := routeStops(ctx, s.waypointrepo, offer)
+	if err != nil {
+		return err
+	}
+	matches, err := s.matchrepo.FindByRideID(ctx, offer.ID)
+	if err != nil {
+		return err
+	}
+	if err := checkSeats(offer, matches, len(stops), *m); err != nil {
+		return err
+	}
+	if err := s.planPickup(offer, stops, matches, m, pickupAt); err != nil {
+		return err
+	}
+	if err := s.sched.check(ctx, m.RiderID, s.sched.offerBooking(offer, stops), false); err != nil {
+		return err
+	}
 
-	return s.matchrepo.UpdateStatus(ctx, matchID, "accepted")
+	if err := s.matchrepo.Accept(ctx, m.ID, m.Status, *m.PickupAt, *m.DepartAt, c); err != nil {
+		return err
+	}
+	m.Status = "accepted"
+	s.planner.refresh(ctx, offer)
+	return s.withdrawOverlapping(ctx, m, offer)
 }
 
-func (s matchService) RejectRequest(ctx context.Context, callerID, matchID string) error {
+func (s matchService) RejectRequest(ctx context.Context, callerID, matchID, reason string) error {
 	callerID = strings.TrimSpace(callerID)
 	matchID = strings.TrimSpace(matchID)
 	if callerID == "" || matchID == "" {
 		return errors.New("missing caller or match")
 	}
+	reason, err := cleanReason(reason)
+	if err != nil {
+		return err
+	}
 	m, err := s.matchrepo.FindByID(ctx, matchID)
 	if err != nil || m == nil || m.ID == "" {
 		return errMatchNotFound
@@ -149,29 +512,110 @@ func (s matchService) RejectRequest(ctx context.Context, callerID, matchID strin
 	if m.DriverID != callerID {
 		return errForbidden
 	}
-	if m.Status != "requested" {
+	if m.Status != "requested" && m.Status != "waitlisted" && m.Status != "promoted" {
 		return errors.New("invalid state transition")
 	}
 
-	return s.matchrepo.UpdateStatus(ctx, matchID, "rejected")
+	if err := s.matchrepo.UpdateStatus(ctx, matchID, m.Status, "rejected", repository.Change{ActorID: callerID, Reason: reason}); err != nil {
+		return err
+	}
+	if err := s.release(ctx, m); err != nil {
+		return err
+	}
+	return s.dropPartner(ctx, m)
 }
 
-func (s matchService) CompleteMatch(ctx context.Context, matchID string) error {
-	return s.matchrepo.UpdateStatus(ctx, strings.TrimSpace(matchID), "completed")
+// release hands the seats a promoted match held to the next riders on the
+// waitlist, once the match let go of them
+func (s matchService) release(ctx context.Context, m *db.Match) error {
+	if m.Status != "promoted" {
+		return nil
+	}
+	offer, err := s.rideofferepo.FindByID(ctx, m.RideID)
+	if err != nil || offer == nil || offer.ID == "" {
+		return errOfferNotFound
+	}
+	s.waitlist.refill(ctx, offer)
+	return nil
 }
 
-func (s matchService) GetMatchByID(ctx context.Context, matchID string) (*db.Match, error) {
+func (s matchService) CompleteMatch(ctx context.Context, callerID, matchID string) error {
+	callerID = strings.TrimSpace(callerID)
+	m, err := s.matchrepo.FindByID(ctx, strings.TrimSpace(matchID))
+	if err != nil || m == nil || m.ID == "" {
+		return errMatchNotFound
+	}
+	if m.RiderID != callerID && m.DriverID != callerID {
+		return errForbidden
+	}
+	if m.Status != "accepted" {
+		return errors.New("invalid state transition")
+	}
+	// only a rider the driver checked in with their PIN has been on board
+	if m.StartedAt == nil {
+		return errNotCheckedIn
+	}
+	if err := s.matchrepo.Complete(ctx, m.ID, m.Status, repository.Change{ActorID: callerID}); err != nil {
+		return err
+	}
+	return s.endTrip(ctx, m)
+}
+
+// endTrip stops live location for a match that is over: the rider's
+// stream always, the driver's once nobody else is still riding
+func (s matchService) endTrip(ctx context.Context, m *db.Match) error {
+	active, err := s.matchrepo.FindActiveByRide(ctx, m.RideID)
+	if err != nil {
+		return err
+	}
+	if active == nil {
+		s.trips.EndRide(m.RideID)
+	} else {
+		s.trips.EndMatch(m.RideID, m.ID)
+	}
+	return nil
+}
+
+func (s matchService) GetMatchByID(ctx context.Context, callerID, matchID string) (*db.Match, error) {
 	m, err := s.matchrepo.FindByID(ctx, strings.TrimSpace(matchID))
 	if err != nil || m == nil || m.ID == "" {
 		return nil, errMatchNotFound
 	}
+	if takesPart(m, callerID) {
+		return m, nil
+	}
+	if ok, err := s.scope.canSee(ctx, callerID, m.OrgID); err != nil || !ok {
+		return nil, errMatchNotFound
+	}
 	return m, nil
 }
 
-func (s matchService) ListMatchesByRide(ctx context.Context, rideID string) ([]db.Match, error) {
-	return s.matchrepo.FindByRideID(ctx, strings.TrimSpace(rideID))
+func (s matchService) ListMatchesByRide(ctx context.Context, callerID, rideID string) ([]db.Match, error) {
+	ms, err := s.matchrepo.FindByRideID(ctx, strings.TrimSpace(rideID))
+	if err != nil {
+		return nil, err
+	}
+	return s.visibleOnly(ctx, callerID, ms)
 }
 
-func (s matchService) ListMatchesByRider(ctx context.Context, riderID string) ([]db.Match, error) {
-	return s.matchrepo.FindByRiderID(ctx, strings.TrimSpace(riderID))
+func (s matchService) ListMatchesByRider(ctx context.Context, callerID, riderID string) ([]db.Match, error) {
+	ms, err := s.matchrepo.FindByRiderID(ctx, strings.TrimSpace(riderID))
+	if err != nil {
+		return nil, err
+	}
+	if ms, err = s.visibleOnly(ctx, callerID, ms); err != nil {
+		return nil, err
+	}
+	return legsTogether(ms, matchLeg), nil
+}
+
+func (s matchService) ListMatchesByDriver(ctx context.Context, callerID string, limit int) ([]db.Match, error) {
+	ms, err := s.matchrepo.ListByDriverID(ctx, strings.TrimSpace(callerID), limit)
+	if err != nil {
+		return nil, err
+	}
+	if ms, err = s.visibleOnly(ctx, callerID, ms); err != nil {
+		return nil, err
+	}
+	return legsTogether(ms, matchLeg), nil
 }
@@ -0,0 +1,445 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"hope/config"
+	"hope/db"
+	"hope/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	errOrgNotFound     = errors.New("organization not found")
+	errOrgNameRequired = errors.New("organization name required")
+	errInvalidDomain   = errors.New("invalid domain")
+	errDomainTaken     = errors.New("domain already belongs to an organization")
+	errSharingExists   = errors.New("sharing agreement already exists")
+	errSharingSelf     = errors.New("organization cannot share with itself")
+	errSharingNotFound = errors.New("sharing agreement not found")
+	errUserHasNoOrg    = errors.New("user has no organization")
+	errInviteNotFound  = errors.New("invite not found")
+	errInvalidEmail    = errors.New("invalid email")
+	errInvalidMaxUses  = errors.New("invalid max uses")
+)
+
+// invites nobody redeems should not linger forever
+const defaultInviteTTL = 14 * 24 * time.Hour
+
+// orgScope answers "which orgs can this user see", every service that lists
+// or loads tenant data goes through it so the scoping rule lives in one place
+type orgScope struct {
+	userrepo repository.UserRepository
+	orgrepo  repository.OrganizationRepository
+}
+
+func (s orgScope) user(ctx context.Context, userID string) (*db.User, error) {
+	u, err := s.userrepo.FindByID(ctx, strings.TrimSpace(userID))
+	if err != nil {
+		return nil, err
+	}
+	if u == nil || u.ID == "" {
+		return nil, errInvalidUser
+	}
+	return u, nil
+}
+
+// visibleOrgIDs is the caller's own org plus every org it shares with,
+// users created before orgs existed have an empty org and only see legacy rows
+func (s orgScope) visibleOrgIDs(ctx context.Context, userID string) ([]string, error) {
+	u, err := s.user(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	partners, err := s.orgrepo.ListPartnerOrgIDs(ctx, u.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return append([]string{u.OrgID}, partners...), nil
+}
+
+func (s orgScope) canSee(ctx context.Context, userID, orgID string) (bool, error) {
+	visible, err := s.visibleOrgIDs(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	for _, id := range visible {
+		if id == orgID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s orgScope) requireAdmin(ctx context.Context, userID string) error {
+	u, err := s.user(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if u.Role != "admin" {
+		return errForbidden
+	}
+	return nil
+}
+
+type OrganizationService interface {
+	GetMyOrganization(ctx context.Context, userID string) (*db.Organization, []db.OrganizationDomain, error)
+	CreateOrganization(ctx context.Context, callerID, name string, domains []string) (*db.Organization, error)
+	ListOrganizations(ctx context.Context, callerID string, limit int) ([]db.Organization, error)
+	AddDomain(ctx context.Context, callerID, orgID, domain string) error
+	RemoveDomain(ctx context.Context, callerID, domain string) error
+	AssignUser(ctx context.Context, callerID, userID, orgID string) error
+	CreateSharingAgreement(ctx context.Context, callerID, orgID, partnerOrgID string) (*db.OrgSharingAgreement, error)
+	DeleteSharingAgreement(ctx context.Context, callerID, orgID, partnerOrgID string) error
+	ListSharingAgreements(ctx context.Context, callerID string) ([]db.OrgSharingAgreement, error)
+
+	CreateInvite(ctx context.Context, callerID string, invite *db.Invite) error
+	RevokeInvite(ctx context.Context, callerID, inviteID string) error
+	ListInvites(ctx context.Context, callerID, orgID string, limit int) ([]db.Invite, error)
+
+	// GetFarePolicy is orgID's fare policy, the caller's own org when
+	// empty; other orgs' are for admins
+	GetFarePolicy(ctx context.Context, callerID, orgID string) (*db.OrgFarePolicy, error)
+	// SetFarePolicy replaces the fare policy of policy.OrgID
+	SetFarePolicy(ctx context.Context, callerID string, policy *db.OrgFarePolicy) error
+}
+
+type organizationService struct {
+	orgrepo         repository.OrganizationRepository
+	userrepo        repository.UserRepository
+	inviterepo      repository.InviteRepository
+	locrepo         repository.UserLocationRepository
+	rideofferepo    repository.RideOfferRepository
+	riderequestrepo repository.RideRequestRepository
+	cache           *AccessCache
+	scope           orgScope
+	fares           farePolicies
+}
+
+func NewOrganizationService(orgrepo repository.OrganizationRepository, userrepo repository.UserRepository, inviterepo repository.InviteRepository, locrepo repository.UserLocationRepository, rideofferepo repository.RideOfferRepository, riderequestrepo repository.RideRequestRepository, cache *AccessCache, farePolicy config.FarePolicy) OrganizationService {
+	return &organizationService{
+		orgrepo:         orgrepo,
+		userrepo:        userrepo,
+		inviterepo:      inviterepo,
+		locrepo:         locrepo,
+		rideofferepo:    rideofferepo,
+		riderequestrepo: riderequestrepo,
+		cache:           cache,
+		scope:           orgScope{userrepo: userrepo, orgrepo: orgrepo},
+		fares:           farePolicies{orgrepo: orgrepo, cfg: farePolicy},
+	}
+}
+
+func newInviteCode() (string, error) {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b), nil
+}
+
+func normalizeDomain(domain string) (string, error) {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	domain = strings.TrimPrefix(domain, "@")
+	if domain == "" || strings.ContainsAny(domain, "@ ,") || !strings.Contains(domain, ".") {
+		return "", errInvalidDomain
+	}
+	return domain, nil
+}
+
+func (s organizationService) GetMyOrganization(ctx context.Context, userID string) (*db.Organization, []db.OrganizationDomain, error) {
+	u, err := s.scope.user(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if u.OrgID == "" {
+		return nil, nil, errUserHasNoOrg
+	}
+	org, err := s.orgrepo.FindByID(ctx, u.OrgID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if org == nil || org.ID == "" {
+		return nil, nil, errOrgNotFound
+	}
+	domains, err := s.orgrepo.ListDomains(ctx, org.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return org, domains, nil
+}
+
+func (s organizationService) CreateOrganization(ctx context.Context, callerID, name string, domains []string) (*db.Organization, error) {
+	if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+		return nil, err
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errOrgNameRequired
+	}
+	normalized := make([]string, 0, len(domains))
+	for _, d := range domains {
+		nd, err := normalizeDomain(d)
+		if err != nil {
+			return nil, err
+		}
+		existing, err := s.orgrepo.FindByDomain(ctx, nd)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, errDomainTaken
+		}
+		normalized = append(normalized, nd)
+	}
+
+	org := &db.Organization{
+		ID:        uuid.New().String(),
+		Name:      name,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := s.orgrepo.Create(ctx, org); err != nil {
+		return nil, err
+	}
+	for _, d := range normalized {
+		if err := s.orgrepo.AddDomain(ctx, &db.OrganizationDomain{Domain: d, OrgID: org.ID}); err != nil {
+			return nil, err
+		}
+	}
+	s.cache.Flush()
+	return org, nil
+}
+
+func (s organizationService) ListOrganizations(ctx context.Context, callerID string, limit int) ([]db.Organization, error) {
+	if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+		return nil, err
+	}
+	return s.orgrepo.List(ctx, limit)
+}
+
+func (s organizationService) AddDomain(ctx context.Context, callerID, orgID, domain string) error {
+	if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+		return err
+	}
+	d, err := normalizeDomain(domain)
+	if err != nil {
+		return err
+	}
+	org, err := s.orgrepo.FindByID(ctx, strings.TrimSpace(orgID))
+	if err != nil {
+		return err
+	}
+	if org == nil || org.ID == "" {
+		return errOrgNotFound
+	}
+	existing, err := s.orgrepo.FindByDomain(ctx, d)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return errDomainTaken
+	}
+	if err := s.orgrepo.AddDomain(ctx, &db.OrganizationDomain{Domain: d, OrgID: org.ID}); err != nil {
+		return err
+	}
+	s.cache.Flush()
+	return nil
+}
+
+// RemoveDomain blocks logins from the domain from now on, tokens already
+// issued stay valid until they expire. Users admitted by invite are unaffected
+func (s organizationService) RemoveDomain(ctx context.Context, callerID, domain string) error {
+	if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+		return err
+	}
+	d, err := normalizeDomain(domain)
+	if err != nil {
+		return err
+	}
+	if err := s.orgrepo.RemoveDomain(ctx, d); err != nil {
+		return err
+	}
+	s.cache.Flush()
+	return nil
+}
+
+func (s organizationService) AssignUser(ctx context.Context, callerID, userID, orgID string) error {
+	if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+		return err
+	}
+	u, err := s.scope.user(ctx, userID)
+	if err != nil {
+		return err
+	}
+	org, err := s.orgrepo.FindByID(ctx, strings.TrimSpace(orgID))
+	if err != nil {
+		return err
+	}
+	if org == nil || org.ID == "" {
+		return errOrgNotFound
+	}
+	// the location, offers and requests carry the org too, they move first
+	// so a failure leaves the user in the old org and retrying finishes it.
+	// Matches stay in the ride's org, participants see them either way
+	if err := s.locrepo.MoveToOrg(ctx, u.ID, org.ID); err != nil {
+		return err
+	}
+	if err := s.rideofferepo.MoveDriverToOrg(ctx, u.ID, org.ID); err != nil {
+		return err
+	}
+	if err := s.riderequestrepo.MoveUserToOrg(ctx, u.ID, org.ID); err != nil {
+		return err
+	}
+	u.OrgID = org.ID
+	return s.userrepo.Update(ctx, u)
+}
+
+func (s organizationService) CreateSharingAgreement(ctx context.Context, callerID, orgID, partnerOrgID string) (*db.OrgSharingAgreement, error) {
+	if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+		return nil, err
+	}
+	orgID = strings.TrimSpace(orgID)
+	partnerOrgID = strings.TrimSpace(partnerOrgID)
+	if orgID == partnerOrgID {
+		return nil, errSharingSelf
+	}
+	for _, id := range []string{orgID, partnerOrgID} {
+		org, err := s.orgrepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if org == nil || org.ID == "" {
+			return nil, errOrgNotFound
+		}
+	}
+	existing, err := s.orgrepo.FindSharing(ctx, orgID, partnerOrgID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errSharingExists
+	}
+	agreement := &db.OrgSharingAgreement{
+		ID:           uuid.New().String(),
+		OrgID:        orgID,
+		PartnerOrgID: partnerOrgID,
+		CreatedBy:    strings.TrimSpace(callerID),
+		CreatedAt:    time.Now().UTC(),
+	}
+	if err := s.orgrepo.CreateSharing(ctx, agreement); err != nil {
+		return nil, err
+	}
+	return agreement, nil
+}
+
+func (s organizationService) DeleteSharingAgreement(ctx context.Context, callerID, orgID, partnerOrgID string) error {
+	if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+		return err
+	}
+	existing, err := s.orgrepo.FindSharing(ctx, strings.TrimSpace(orgID), strings.TrimSpace(partnerOrgID))
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return errSharingNotFound
+	}
+	return s.orgrepo.DeleteSharing(ctx, existing.ID)
+}
+
+func (s organizationService) ListSharingAgreements(ctx context.Context, callerID string) ([]db.OrgSharingAgreement, error) {
+	u, err := s.scope.user(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	if u.OrgID == "" {
+		return []db.OrgSharingAgreement{}, nil
+	}
+	return s.orgrepo.ListSharing(ctx, u.OrgID)
+}
+
+// CreateInvite admits a user outside the allowlisted domains. With an email
+// the invite is applied automatically when that user logs in, without one the
+// generated code has to be passed to Login
+func (s organizationService) CreateInvite(ctx context.Context, callerID string, invite *db.Invite) error {
+	if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+		return err
+	}
+	if invite == nil {
+		return errMissingFields
+	}
+	org, err := s.orgrepo.FindByID(ctx, strings.TrimSpace(invite.OrgID))
+	if err != nil {
+		return err
+	}
+	if org == nil || org.ID == "" {
+		return errOrgNotFound
+	}
+	invite.Email = strings.ToLower(strings.TrimSpace(invite.Email))
+	if invite.Email != "" && strings.Count(invite.Email, "@") != 1 {
+		return errInvalidEmail
+	}
+
+	now := time.Now().UTC()
+	code, err := newInviteCode()
+	if err != nil {
+		return err
+	}
+	invite.ID = uuid.New().String()
+	invite.Code = code
+	invite.OrgID = org.ID
+	invite.CreatedBy = strings.TrimSpace(callerID)
+	invite.Uses = 0
+	invite.RevokedAt = nil
+	invite.CreatedAt = now
+	// an invite addressed to one person is single use, a code invite takes
+	// max uses as given with 0 meaning unlimited
+	if invite.MaxUses < 0 {
+		return errInvalidMaxUses
+	}
+	if invite.Email != "" {
+		invite.MaxUses = 1
+	}
+	if invite.ExpiresAt.IsZero() {
+		invite.ExpiresAt = now.Add(defaultInviteTTL)
+	}
+	if !invite.ExpiresAt.After(now) {
+		return errPastTime
+	}
+	if err := s.inviterepo.Create(ctx, invite); err != nil {
+		return err
+	}
+	s.cache.Flush()
+	return nil
+}
+
+// RevokeInvite also cuts off users already admitted through it on their next login
+func (s organizationService) RevokeInvite(ctx context.Context, callerID, inviteID string) error {
+	if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+		return err
+	}
+	inv, err := s.inviterepo.FindByID(ctx, strings.TrimSpace(inviteID))
+	if err != nil {
+		return err
+	}
+	if inv == nil || inv.ID == "" {
+		return errInviteNotFound
+	}
+	if err := s.inviterepo.Revoke(ctx, inv.ID, time.Now().UTC()); err != nil {
+		return err
+	}
+	s.cache.Flush()
+	return nil
+}
+
+func (s organizationService) ListInvites(ctx context.Context, callerID, orgID string, limit int) ([]db.Invite, error) {
+	if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+		return nil, err
+	}
+	return s.inviterepo.ListByOrg(ctx, strings.TrimSpace(orgID), limit)
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	"hope/config"
+	"hope/repository"
+)
+
+var errInvalidReliability = errors.New("invalid min reliability: must be between 0 and 100")
+
+// every user starts as if this many trips went fine, so a single early
+// no-show doesn't sink a new account
+const reliabilityPrior = 3
+
+// Reliability is how well a user keeps the rides they agreed to
+type Reliability struct {
+	// 0 to 100, 100 for users without any history
+	Score       float64
+	Completed   int
+	LateCancels int
+	NoShows     int
+}
+
+// reliabilityScores turns match history into scores under the
+// cancellation policy's penalties
+type reliabilityScores struct {
+	matchrepo repository.MatchRepository
+	policy    config.CancellationPolicy
+}
+
+// of scores each of userIDs, users without history included
+func (r reliabilityScores) of(ctx context.Context, userIDs []string) (map[string]Reliability, error) {
+	recs, err := r.matchrepo.TrackRecords(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]Reliability, len(userIDs))
+	for _, id := range userIDs {
+		out[id] = r.score(recs[id])
+	}
+	return out, nil
+}
+
+// score is the share of trips that went fine, each late cancel and
+// no-show weighing as many trips gone wrong as its penalty
+func (r reliabilityScores) score(rec repository.TrackRecord) Reliability {
+	good := float64(rec.Completed + reliabilityPrior)
+	bad := float64(rec.LateCancels)*r.policy.LatePenalty + float64(rec.NoShows)*r.policy.NoShowPenalty
+	return Reliability{
+		Score:       math.Round(1000*good/(good+bad)) / 10,
+		Completed:   rec.Completed,
+		LateCancels: rec.LateCancels,
+		NoShows:     rec.NoShows,
+	}
+}
+
+// atLeast is the subset of userIDs scoring min or more
+func (r reliabilityScores) atLeast(ctx context.Context, userIDs []string, min float64) (map[string]bool, error) {
+	scores, err := r.of(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]bool, len(scores))
+	for id, rel := range scores {
+		if rel.Score >= min {
+			out[id] = true
+		}
+	}
+	return out, nil
+}
+
+func checkMinReliability(min float64) error {
+	if min < 0 || min > 100 {
+		return errInvalidReliability
+	}
+	return nil
+}
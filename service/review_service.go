@@ -17,12 +17,16 @@ type ReviewService interface {
 	DeleteReview(ctx context.Context, reviewID string) error
 }
 
+var errNoTripTogether = errors.New("forbidden: no completed trip together on this ride")
+
 type reviewService struct {
 	reviewrepo repository.ReviewRepository
+	matchrepo  repository.MatchRepository
+	blocks     blockList
 }
 
-func NewReviewService(reviewrepo repository.ReviewRepository) ReviewService {
-	return &reviewService{reviewrepo: reviewrepo}
+func NewReviewService(reviewrepo repository.ReviewRepository, blockrepo repository.UserBlockRepository, matchrepo repository.MatchRepository) ReviewService {
+	return &reviewService{reviewrepo: reviewrepo, matchrepo: matchrepo, blocks: blockList{blockrepo: blockrepo}}
 }
 
 func (s reviewService) SubmitReview(ctx context.Context, review *db.Review) error {
@@ -41,6 +45,14 @@ func (s reviewService) SubmitReview(ctx context.Context, review *db.Review) erro
 	if review.FromUserID == review.ToUserID {
 		return errors.New("cannot review yourself")
 	}
+	if blocked, err := s.blocks.between(ctx, review.FromUserID, review.ToUserID); err != nil || blocked {
+		return errBlocked
+	}
+	if ok, err := s.rodeTogether(ctx, review.RideID, review.FromUserID, review.ToUserID); err != nil {
+		return err
+	} else if !ok {
+		return errNoTripTogether
+	}
 
 	review.ID = uuid.New().String()
 	review.CreatedAt = time.Now().UTC()
@@ -59,3 +71,21 @@ func (s reviewService) ListReviewsByRide(ctx context.Context, rideID string) ([]
 func (s reviewService) DeleteReview(ctx context.Context, reviewID string) error {
 	return s.reviewrepo.Delete(ctx, strings.TrimSpace(reviewID))
 }
+
+// rodeTogether tells whether a and b are the rider and driver of a match
+// on the ride that was completed, which takes the rider's check-in
+func (s reviewService) rodeTogether(ctx context.Context, rideID, a, b string) (bool, error) {
+	matches, err := s.matchrepo.FindByRideID(ctx, rideID)
+	if err != nil {
+		return false, err
+	}
+	for _, m := range matches {
+		if m.Status != "completed" {
+			continue
+		}
+		if (m.RiderID == a && m.DriverID == b) || (m.RiderID == b && m.DriverID == a) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
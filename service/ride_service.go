@@ -3,9 +3,12 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/google/uuid"
+	"hope/config"
 	"hope/db"
 	"hope/repository"
+	"hope/routing"
 	"strings"
 	"time"
 )
@@ -18,41 +21,158 @@ var (
 	errSeatsPositive   = errors.New("seats must be positive")
 	errInvalidDriver   = errors.New("invalid driver")
 	errInvalidUser     = errors.New("invalid user")
+	errInvalidRadius   = errors.New("invalid radius: must be between 0 and 50km")
+
+	errInvalidDetourLimit = errors.New("invalid max detour: cannot be negative")
+
+	errOfferStatusSet    = errors.New("status can only be set to completed on an offer, cancel it with CancelRide")
+	errSeatsTaken        = errors.New("invalid state: seats can't go below the seats riders already hold")
+	errRidersAboard      = errors.New("invalid state: riders on this ride are still accepted, complete or cancel their matches first")
+	errRequestTransition = errors.New("invalid state: an active request can only be cancelled and a matched one completed")
 )
 
+// radius searches are capped so a single call can't scan a whole region
+const maxSearchRadiusMeters = 50000.0
+
+func checkCircle(lat, lon, meters float64) error {
+	if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+		return errInvalidLatLon
+	}
+	if meters <= 0 || meters > maxSearchRadiusMeters {
+		return errInvalidRadius
+	}
+	return nil
+}
+
 type RideService interface {
 	CreateOffer(ctx context.Context, offer *db.RideOffer) error
-	ListNearbyOffers(ctx context.Context, geohashPrefix string, limit int) ([]db.RideOffer, error)
-	GetOfferByID(ctx context.Context, id string) (*db.RideOffer, error)
+	// ListNearbyOffers leaves out drivers whose reliability score is below
+	// minReliability, 0 keeps everyone, and offers that can't leave within
+	// when or arrive by its ArriveBy
+	ListNearbyOffers(ctx context.Context, callerID, geohashPrefix string, limit int, minReliability float64, when TimeWindow) ([]db.RideOffer, error)
+	// ListOffersWithinRadius is ListNearbyOffers around a point, closest first
+	ListOffersWithinRadius(ctx context.Context, callerID string, lat, lon, meters float64, limit int, minReliability float64, when TimeWindow) ([]db.RideOffer, error)
+	GetOfferByID(ctx context.Context, callerID, id string) (*db.RideOffer, error)
 	UpdateOffer(ctx context.Context, offer *db.RideOffer) error
 	DeleteOffer(ctx context.Context, id string) error
+	// ListMyOffers lists the legs of a round trip together, outbound first
 	ListMyOffers(ctx context.Context, driverID string, limit int) ([]db.RideOffer, error)
+	// CreateRoundTrip creates out and back, its return from out's
+	// destination, as a round trip sharing a PairID. Back has to leave
+	// after out arrives
+	CreateRoundTrip(ctx context.Context, out, back *db.RideOffer) error
+	// SetAutoAccept replaces the auto-accept rule of the caller's open
+	// offer with offer's. Requests already waiting on the driver stay so
+	SetAutoAccept(ctx context.Context, callerID string, offer *db.RideOffer) (*db.RideOffer, error)
+	// ListOfferStops is the offer's route, stop by stop, with the seats
+	// still free on each leg
+	ListOfferStops(ctx context.Context, callerID, offerID string) ([]StopInfo, error)
 
 	CreateRequest(ctx context.Context, req *db.RideRequest) error
-	ListNearbyRequests(ctx context.Context, geohashPrefix string, limit int) ([]db.RideRequest, error)
-	GetRequestByID(ctx context.Context, id string) (*db.RideRequest, error)
-	UpdateRequestStatus(ctx context.Context, id string, status string) error
+	// ListNearbyRequests and ListRequestsWithinRadius filter riders by
+	// reliability, and requests by time, like the offer searches do
+	ListNearbyRequests(ctx context.Context, callerID, geohashPrefix string, limit int, minReliability float64, when TimeWindow) ([]db.RideRequest, error)
+	ListRequestsWithinRadius(ctx context.Context, callerID string, lat, lon, meters float64, limit int, minReliability float64, when TimeWindow) ([]db.RideRequest, error)
+	GetRequestByID(ctx context.Context, callerID, id string) (*db.RideRequest, error)
+	UpdateRequestStatus(ctx context.Context, callerID, id string, status string) error
 	DeleteRequest(ctx context.Context, id string) error
+	// ListMyRequests lists round trips like ListMyOffers
 	ListMyRequests(ctx context.Context, userID string, limit int) ([]db.RideRequest, error)
+	// CreateRoundTripRequest is CreateRoundTrip for requests. With mode
+	// together (the default) a driver takes both legs or neither,
+	// independent leaves each leg to whichever driver takes it
+	CreateRoundTripRequest(ctx context.Context, out, back *db.RideRequest, mode string) error
+
+	// GetOfferHistory and GetRequestHistory are every status change of the
+	// row, oldest first, for its owner and admins. The owner keeps access
+	// after deleting it
+	GetOfferHistory(ctx context.Context, callerID, offerID string) ([]db.OfferEvent, error)
+	GetRequestHistory(ctx context.Context, callerID, requestID string) ([]db.RequestEvent, error)
+
+	// MeetingPointPrefix is the geohash prefix a nearby search around a
+	// meeting point should use
+	MeetingPointPrefix(ctx context.Context, callerID, pointID string) (string, error)
 }
 
 type rideService struct {
 	rideofferepo    repository.RideOfferRepository
 	riderequestrepo repository.RideRequestRepository
 	userrepo        repository.UserRepository
+	waypointrepo    repository.WaypointRepository
+	matchrepo       repository.MatchRepository
+	scope           orgScope
+	blocks          blockList
+	area            areaRules
+	reliability     reliabilityScores
+	sched           schedule
+	fares           farePolicies
+	waitlist        *Waitlist
+}
+
+func NewRideService(rideofferepo repository.RideOfferRepository, riderequestrepo repository.RideRequestRepository, userrepo repository.UserRepository, orgrepo repository.OrganizationRepository, blockrepo repository.UserBlockRepository, zonerepo repository.ServiceZoneRepository, pointrepo repository.MeetingPointRepository, waypointrepo repository.WaypointRepository, matchrepo repository.MatchRepository, policy config.CancellationPolicy, router routing.Router, booking config.Booking, fareModel config.FareModel, farePolicy config.FarePolicy, waitlist *Waitlist) RideService {
+	return &rideService{
+		rideofferepo:    rideofferepo,
+		riderequestrepo: riderequestrepo,
+		userrepo:        userrepo,
+		waypointrepo:    waypointrepo,
+		matchrepo:       matchrepo,
+		scope:           orgScope{userrepo: userrepo, orgrepo: orgrepo},
+		blocks:          blockList{blockrepo: blockrepo},
+		area:            areaRules{zonerepo: zonerepo, pointrepo: pointrepo},
+		reliability:     reliabilityScores{matchrepo: matchrepo, policy: policy},
+		sched: schedule{
+			offerrepo:    rideofferepo,
+			requestrepo:  riderequestrepo,
+			matchrepo:    matchrepo,
+			waypointrepo: waypointrepo,
+			router:       router,
+			cfg:          booking,
+			times:        newLegTimes(),
+		},
+		fares:    farePolicies{orgrepo: orgrepo, router: router, model: fareModel, cfg: farePolicy},
+		waitlist: waitlist,
+	}
 }
 
-func NewRideService(rideofferepo repository.RideOfferRepository, riderequestrepo repository.RideRequestRepository, userrepo repository.UserRepository) RideService {
-	return &rideService{rideofferepo: rideofferepo, riderequestrepo: riderequestrepo, userrepo: userrepo}
+// resolvePoints fills from/to geohashes from meeting point ids, an explicit
+// point wins over a raw geohash
+func (s rideService) resolvePoints(ctx context.Context, callerID string, fromPointID, toPointID string, fromGeo, toGeo *string) error {
+	if id := strings.TrimSpace(fromPointID); id != "" {
+		p, err := s.area.point(ctx, s.scope, callerID, id)
+		if err != nil {
+			return err
+		}
+		*fromGeo = p.Geohash
+	}
+	if id := strings.TrimSpace(toPointID); id != "" {
+		p, err := s.area.point(ctx, s.scope, callerID, id)
+		if err != nil {
+			return err
+		}
+		*toGeo = p.Geohash
+	}
+	return nil
 }
 
 func (s rideService) CreateOffer(ctx context.Context, offer *db.RideOffer) error {
+	if err := s.checkOffer(ctx, offer); err != nil {
+		return err
+	}
+	return s.rideofferepo.Create(ctx, offer)
+}
+
+// checkOffer validates a new offer and fills in what the server decides:
+// its id, org, status and full route
+func (s rideService) checkOffer(ctx context.Context, offer *db.RideOffer) error {
 	if offer == nil {
 		return errMissingFields
 	}
 	offer.DriverID = strings.TrimSpace(offer.DriverID)
 	offer.FromGeo = strings.TrimSpace(offer.FromGeo)
 	offer.ToGeo = strings.TrimSpace(offer.ToGeo)
+	if err := s.resolvePoints(ctx, offer.DriverID, offer.FromPointID, offer.ToPointID, &offer.FromGeo, &offer.ToGeo); err != nil {
+		return err
+	}
 
 	if offer.DriverID == "" || offer.FromGeo == "" || offer.ToGeo == "" {
 		return errMissingFields
@@ -64,6 +184,12 @@ func (s rideService) CreateOffer(ctx context.Context, offer *db.RideOffer) error
 	if offer.Seats <= 0 {
 		return errSeatsPositive
 	}
+	if offer.MaxDetourSeconds < 0 {
+		return errInvalidDetourLimit
+	}
+	if err := checkAutoAccept(offer); err != nil {
+		return err
+	}
 	if strings.TrimSpace(offer.Status) == "" {
 		offer.Status = "active"
 	}
@@ -74,20 +200,166 @@ func (s rideService) CreateOffer(ctx context.Context, offer *db.RideOffer) error
 	if driver == nil || driver.ID == "" {
 		return errInvalidDriver
 	}
+	offer.OrgID = driver.OrgID
+	if err := s.area.checkRoute(ctx, offer.OrgID, offer.FromGeo, offer.ToGeo); err != nil {
+		return err
+	}
 
-	return s.rideofferepo.Create(ctx, offer)
+	// offer.Waypoints comes in as the stops between origin and destination
+	// and is saved with the offer as the full route
+	for i := range offer.Waypoints {
+		w := &offer.Waypoints[i]
+		if id := strings.TrimSpace(w.PointID); id != "" {
+			p, err := s.area.point(ctx, s.scope, offer.DriverID, id)
+			if err != nil {
+				return err
+			}
+			w.Geohash = p.Geohash
+		}
+	}
+	if len(offer.Waypoints) > 0 {
+		stops, err := buildStops(offer, offer.Waypoints)
+		if err != nil {
+			return err
+		}
+		offer.Waypoints = stops
+	}
+	stops := plannedStops(offer)
+	if err := checkTimes(offer.Time, offer.EarliestAt, offer.LatestAt, offer.ArriveBy, s.sched.drive(stops)); err != nil {
+		return err
+	}
+	// a driver can't be at the wheel twice, nor riding or asking for a ride
+	return s.sched.check(ctx, offer.DriverID, s.sched.offerBooking(offer, stops), true)
 }
 
-func (s rideService) ListNearbyOffers(ctx context.Context, geohashPrefix string, limit int) ([]db.RideOffer, error) {
-	return s.rideofferepo.ListNearbyOffers(ctx, strings.TrimSpace(geohashPrefix), limit)
+// plannedStops is the route of an offer not saved yet, its origin and
+// destination alone when it has no waypoints
+func plannedStops(offer *db.RideOffer) []db.Waypoint {
+	if len(offer.Waypoints) > 0 {
+		return offer.Waypoints
+	}
+	return []db.Waypoint{{Geohash: offer.FromGeo, PlannedAt: &offer.Time}, {Geohash: offer.ToGeo}}
+}
+
+func (s rideService) ListNearbyOffers(ctx context.Context, callerID, geohashPrefix string, limit int, minReliability float64, when TimeWindow) ([]db.RideOffer, error) {
+	if err := checkMinReliability(minReliability); err != nil {
+		return nil, err
+	}
+	if err := when.check(); err != nil {
+		return nil, err
+	}
+	orgIDs, err := s.scope.visibleOrgIDs(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	return upTo(limit, func(n int) ([]db.RideOffer, error) {
+		return s.rideofferepo.ListNearbyOffers(ctx, orgIDs, strings.TrimSpace(geohashPrefix), n)
+	}, func(offers []db.RideOffer) ([]db.RideOffer, error) {
+		offers, err := s.withoutHiddenDrivers(ctx, callerID, offers, minReliability)
+		if err != nil {
+			return nil, err
+		}
+		return s.offersLeaving(ctx, offers, when)
+	})
+}
+
+func (s rideService) ListOffersWithinRadius(ctx context.Context, callerID string, lat, lon, meters float64, limit int, minReliability float64, when TimeWindow) ([]db.RideOffer, error) {
+	if err := checkCircle(lat, lon, meters); err != nil {
+		return nil, err
+	}
+	if err := checkMinReliability(minReliability); err != nil {
+		return nil, err
+	}
+	if err := when.check(); err != nil {
+		return nil, err
+	}
+	orgIDs, err := s.scope.visibleOrgIDs(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	return upTo(limit, func(n int) ([]db.RideOffer, error) {
+		return s.rideofferepo.ListOffersWithinRadius(ctx, orgIDs, lat, lon, meters, n)
+	}, func(offers []db.RideOffer) ([]db.RideOffer, error) {
+		offers, err := s.withoutHiddenDrivers(ctx, callerID, offers, minReliability)
+		if err != nil {
+			return nil, err
+		}
+		return s.offersLeaving(ctx, offers, when)
+	})
+}
+
+// upTo fills a page of limit rows that survive keep. Filtering after the
+// query's LIMIT would come up short, so list is asked for twice as many
+// rows each round until keep leaves enough or list has no more
+func upTo[T any](limit int, list func(n int) ([]T, error), keep func([]T) ([]T, error)) ([]T, error) {
+	if limit <= 0 {
+		rows, err := list(0)
+		if err != nil {
+			return nil, err
+		}
+		return keep(rows)
+	}
+	for n := limit; ; n *= 2 {
+		rows, err := list(n)
+		if err != nil {
+			return nil, err
+		}
+		more := len(rows) == n
+		if rows, err = keep(rows); err != nil {
+			return nil, err
+		}
+		if len(rows) >= limit {
+			return rows[:limit], nil
+		}
+		if !more {
+			return rows, nil
+		}
+	}
 }
 
-func (s rideService) GetOfferByID(ctx context.Context, id string) (*db.RideOffer, error) {
+// withoutHiddenDrivers drops offers of drivers blocked either way, and of
+// drivers scoring below minReliability when it is set
+func (s rideService) withoutHiddenDrivers(ctx context.Context, callerID string, offers []db.RideOffer, minReliability float64) ([]db.RideOffer, error) {
+	hidden, err := s.blocks.hiddenFrom(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	var reliable map[string]bool
+	if minReliability > 0 {
+		ids := make([]string, 0, len(offers))
+		for _, o := range offers {
+			ids = append(ids, o.DriverID)
+		}
+		if reliable, err = s.reliability.atLeast(ctx, ids, minReliability); err != nil {
+			return nil, err
+		}
+	}
+	out := offers[:0]
+	for _, o := range offers {
+		if _, ok := hidden[o.DriverID]; ok {
+			continue
+		}
+		if reliable != nil && !reliable[o.DriverID] {
+			continue
+		}
+		out = append(out, o)
+	}
+	return out, nil
+}
+
+// GetOfferByID hides offers of orgs the caller cannot see behind not found
+func (s rideService) GetOfferByID(ctx context.Context, callerID, id string) (*db.RideOffer, error) {
 	id = strings.TrimSpace(id)
 	o, err := s.rideofferepo.FindByID(ctx, id)
 	if err != nil || o == nil || o.ID == "" {
 		return nil, errOfferNotFound
 	}
+	if ok, err := s.scope.canSee(ctx, callerID, o.OrgID); err != nil || !ok {
+		return nil, errOfferNotFound
+	}
+	if blocked, err := s.blocks.between(ctx, callerID, o.DriverID); err != nil || blocked {
+		return nil, errOfferNotFound
+	}
 	return o, nil
 }
 
@@ -99,16 +371,65 @@ func (s rideService) UpdateOffer(ctx context.Context, offer *db.RideOffer) error
 	if err != nil || current == nil || current.ID == "" {
 		return errOfferNotFound
 	}
-
-	if offer.Seats > 0 {
-		current.Seats = offer.Seats
+	switch strings.TrimSpace(offer.Status) {
+	case "":
+	case "completed":
+		return s.completeOffer(ctx, current)
+	default:
+		// cancelling has to release seats and tell the riders, CancelRide does
+		return errOfferStatusSet
+	}
+	if offer.Seats <= 0 || offer.Seats == current.Seats {
+		return nil
+	}
+	if current.Status != "active" && current.Status != "matched" {
+		return errRideClosed
 	}
 
-	if strings.TrimSpace(offer.Status) != "" {
-		current.Status = strings.TrimSpace(offer.Status)
+	stops, err := routeStops(ctx, s.waypointrepo, current)
+	if err != nil {
+		return err
+	}
+	matches, err := s.matchrepo.FindByRideID(ctx, current.ID)
+	if err != nil {
+		return err
+	}
+	held := 0
+	for _, n := range seatsUsed(matches, len(stops)) {
+		held = max(held, n)
+	}
+	if offer.Seats < held {
+		return fmt.Errorf("%w: %d are taken", errSeatsTaken, held)
+	}
+	more := offer.Seats > current.Seats
+	current.Seats = offer.Seats
+	if err := s.rideofferepo.Update(ctx, current); err != nil {
+		return err
+	}
+	if more {
+		s.waitlist.refill(ctx, current)
 	}
+	return nil
+}
 
-	return s.rideofferepo.Update(ctx, current)
+// completeOffer closes a ride that has left once none of its riders is
+// still accepted, each one completed, cancelled or reported missing
+func (s rideService) completeOffer(ctx context.Context, offer *db.RideOffer) error {
+	if offer.Status != "active" && offer.Status != "matched" {
+		return errRideClosed
+	}
+	if time.Now().Before(offer.Time) {
+		return errNotDeparted
+	}
+	aboard, err := s.matchrepo.FindActiveByRide(ctx, offer.ID)
+	if err != nil {
+		return err
+	}
+	if aboard != nil {
+		return errRidersAboard
+	}
+	offer.Status = "completed"
+	return s.rideofferepo.Update(ctx, offer)
 }
 
 func (s rideService) DeleteOffer(ctx context.Context, id string) error {
@@ -119,16 +440,56 @@ func (s rideService) ListMyOffers(ctx context.Context, driverID string, limit in
 	if driverID == "" {
 		return nil, errors.New("driverID required")
 	}
-	return s.rideofferepo.ListByDriver(ctx, driverID, limit)
+	offers, err := s.rideofferepo.ListByDriver(ctx, driverID, limit)
+	if err != nil {
+		return nil, err
+	}
+	return legsTogether(offers, offerLeg), nil
+}
+
+func (s rideService) ListOfferStops(ctx context.Context, callerID, offerID string) ([]StopInfo, error) {
+	offer, err := s.GetOfferByID(ctx, callerID, offerID)
+	if err != nil {
+		return nil, err
+	}
+	stops, err := routeStops(ctx, s.waypointrepo, offer)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.matchrepo.FindByRideID(ctx, offer.ID)
+	if err != nil {
+		return nil, err
+	}
+	used := seatsUsed(matches, len(stops))
+	out := make([]StopInfo, 0, len(stops))
+	for i, w := range stops {
+		info := StopInfo{Seq: i, Geohash: w.Geohash, PointID: w.PointID, PlannedAt: w.Planned()}
+		if i < len(used) {
+			info.SeatsFree = max(0, offer.Seats-used[i])
+		}
+		out = append(out, info)
+	}
+	return out, nil
 }
 
 func (s rideService) CreateRequest(ctx context.Context, req *db.RideRequest) error {
+	if err := s.checkRequest(ctx, req); err != nil {
+		return err
+	}
+	return s.riderequestrepo.Create(ctx, req)
+}
+
+// checkRequest is checkOffer for requests
+func (s rideService) checkRequest(ctx context.Context, req *db.RideRequest) error {
 	if req == nil {
 		return errMissingFields
 	}
 	req.UserID = strings.TrimSpace(req.UserID)
 	req.FromGeo = strings.TrimSpace(req.FromGeo)
 	req.ToGeo = strings.TrimSpace(req.ToGeo)
+	if err := s.resolvePoints(ctx, req.UserID, req.FromPointID, req.ToPointID, &req.FromGeo, &req.ToGeo); err != nil {
+		return err
+	}
 
 	if req.UserID == "" || req.FromGeo == "" || req.ToGeo == "" {
 		return errMissingFields
@@ -150,25 +511,139 @@ func (s rideService) CreateRequest(ctx context.Context, req *db.RideRequest) err
 	if u == nil || u.ID == "" {
 		return errInvalidUser
 	}
+	req.OrgID = u.OrgID
+	if err := s.area.checkRoute(ctx, req.OrgID, req.FromGeo, req.ToGeo); err != nil {
+		return err
+	}
+	if req.Fare != 0 {
+		// the fare the rider proposes to whoever takes the request
+		listed, err := s.fares.listed(0, req.FromGeo, req.ToGeo)
+		if err != nil {
+			return err
+		}
+		if err := s.fares.check(ctx, req.OrgID, listed, req.Fare); err != nil {
+			return err
+		}
+	}
+	d := s.sched.drive([]db.Waypoint{{Geohash: req.FromGeo}, {Geohash: req.ToGeo}})
+	if err := checkTimes(req.Time, req.EarliestAt, req.LatestAt, req.ArriveBy, d); err != nil {
+		return err
+	}
+	return s.sched.check(ctx, req.UserID, s.sched.requestBooking(req), true)
+}
 
-	return s.riderequestrepo.Create(ctx, req)
+func (s rideService) ListNearbyRequests(ctx context.Context, callerID, geohashPrefix string, limit int, minReliability float64, when TimeWindow) ([]db.RideRequest, error) {
+	if err := checkMinReliability(minReliability); err != nil {
+		return nil, err
+	}
+	if err := when.check(); err != nil {
+		return nil, err
+	}
+	orgIDs, err := s.scope.visibleOrgIDs(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	return upTo(limit, func(n int) ([]db.RideRequest, error) {
+		return s.riderequestrepo.ListNearby(ctx, orgIDs, strings.TrimSpace(geohashPrefix), n)
+	}, func(reqs []db.RideRequest) ([]db.RideRequest, error) {
+		reqs, err := s.withoutHiddenRiders(ctx, callerID, reqs, minReliability)
+		if err != nil {
+			return nil, err
+		}
+		return s.requestsLeaving(reqs, when), nil
+	})
 }
 
-func (s rideService) ListNearbyRequests(ctx context.Context, geohashPrefix string, limit int) ([]db.RideRequest, error) {
-	return s.riderequestrepo.ListNearby(ctx, strings.TrimSpace(geohashPrefix), limit)
+func (s rideService) ListRequestsWithinRadius(ctx context.Context, callerID string, lat, lon, meters float64, limit int, minReliability float64, when TimeWindow) ([]db.RideRequest, error) {
+	if err := checkCircle(lat, lon, meters); err != nil {
+		return nil, err
+	}
+	if err := checkMinReliability(minReliability); err != nil {
+		return nil, err
+	}
+	if err := when.check(); err != nil {
+		return nil, err
+	}
+	orgIDs, err := s.scope.visibleOrgIDs(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	return upTo(limit, func(n int) ([]db.RideRequest, error) {
+		return s.riderequestrepo.ListWithinRadius(ctx, orgIDs, lat, lon, meters, n)
+	}, func(reqs []db.RideRequest) ([]db.RideRequest, error) {
+		reqs, err := s.withoutHiddenRiders(ctx, callerID, reqs, minReliability)
+		if err != nil {
+			return nil, err
+		}
+		return s.requestsLeaving(reqs, when), nil
+	})
+}
+
+func (s rideService) withoutHiddenRiders(ctx context.Context, callerID string, reqs []db.RideRequest, minReliability float64) ([]db.RideRequest, error) {
+	hidden, err := s.blocks.hiddenFrom(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+	var reliable map[string]bool
+	if minReliability > 0 {
+		ids := make([]string, 0, len(reqs))
+		for _, r := range reqs {
+			ids = append(ids, r.UserID)
+		}
+		if reliable, err = s.reliability.atLeast(ctx, ids, minReliability); err != nil {
+			return nil, err
+		}
+	}
+	out := reqs[:0]
+	for _, r := range reqs {
+		if _, ok := hidden[r.UserID]; ok {
+			continue
+		}
+		if reliable != nil && !reliable[r.UserID] {
+			continue
+		}
+		out = append(out, r)
+	}
+	return out, nil
 }
 
-func (s rideService) GetRequestByID(ctx context.Context, id string) (*db.RideRequest, error) {
+// GetRequestByID hides requests of orgs the caller cannot see behind not found
+func (s rideService) GetRequestByID(ctx context.Context, callerID, id string) (*db.RideRequest, error) {
 	id = strings.TrimSpace(id)
 	r, err := s.riderequestrepo.FindByID(ctx, id)
 	if err != nil || r == nil || r.ID == "" {
 		return nil, errRequestNotFound
 	}
+	if ok, err := s.scope.canSee(ctx, callerID, r.OrgID); err != nil || !ok {
+		return nil, errRequestNotFound
+	}
+	if blocked, err := s.blocks.between(ctx, callerID, r.UserID); err != nil || blocked {
+		return nil, errRequestNotFound
+	}
 	return r, nil
 }
 
-func (s rideService) UpdateRequestStatus(ctx context.Context, id string, status string) error {
-	return s.riderequestrepo.UpdateStatus(ctx, strings.TrimSpace(id), strings.TrimSpace(status))
+// UpdateRequestStatus only lets the rider withdraw an active request. It
+// goes to matched when a driver takes it, and a match is dropped with
+// CancelMatch
+func (s rideService) UpdateRequestStatus(ctx context.Context, callerID, id string, status string) error {
+	req, err := s.riderequestrepo.FindByID(ctx, strings.TrimSpace(id))
+	if err != nil || req == nil || req.ID == "" {
+		return errRequestNotFound
+	}
+	// a driver taking the request matches it, the rider closes it once the
+	// ride has left
+	status = strings.TrimSpace(status)
+	switch {
+	case req.Status == "active" && status == "cancelled":
+	case req.Status == "matched" && status == "completed":
+		if time.Now().Before(req.Time) {
+			return errNotDeparted
+		}
+	default:
+		return errRequestTransition
+	}
+	return s.riderequestrepo.UpdateStatus(ctx, req.ID, status, repository.Change{ActorID: strings.TrimSpace(callerID)})
 }
 
 func (s rideService) DeleteRequest(ctx context.Context, id string) error {
@@ -179,5 +654,20 @@ func (s rideService) ListMyRequests(ctx context.Context, userID string, limit in
 	if userID == "" {
 		return nil, errors.New("userID required")
 	}
-	return s.riderequestrepo.ListByUser(ctx, userID, limit)
+	reqs, err := s.riderequestrepo.ListByUser(ctx, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	return legsTogether(reqs, requestLeg), nil
+}
+
+func (s rideService) MeetingPointPrefix(ctx context.Context, callerID, pointID string) (string, error) {
+	p, err := s.area.point(ctx, s.scope, callerID, pointID)
+	if err != nil {
+		return "", err
+	}
+	if len(p.Geohash) > meetingPointSearchLen {
+		return p.Geohash[:meetingPointSearchLen], nil
+	}
+	return p.Geohash, nil
 }
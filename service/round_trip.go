@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"hope/db"
+	"hope/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	errInvalidPairMode = errors.New("invalid round trip: mode must be together or independent")
+	errSameRide        = errors.New("invalid round trip: the legs must be different rides")
+	errReturnTooEarly  = errors.New("invalid round trip: the return has to leave after the outbound arrives")
+	errNoReturnRide    = errors.New("invalid round trip: return ride_id is required unless the ride has a return leg")
+	errPairClosed      = errors.New("invalid state: the other leg of the round trip is no longer open")
+	errAwaitsReturn    = errors.New("invalid state: the return leg of the round trip has to be accepted first")
+)
+
+// the legs of a round trip, and how a rider's legs are accepted
+const (
+	legOutbound = "outbound"
+	legReturn   = "return"
+	// both legs or neither: one falling through before it is ridden takes
+	// the other with it
+	pairTogether    = "together"
+	pairIndependent = "independent"
+)
+
+// checkPairMode is mode cleaned up, together when it is empty
+func checkPairMode(mode string) (string, error) {
+	switch mode = strings.ToLower(strings.TrimSpace(mode)); mode {
+	case "":
+		return pairTogether, nil
+	case pairTogether, pairIndependent:
+		return mode, nil
+	}
+	return "", errInvalidPairMode
+}
+
+// checkLegs fails unless back leaves after out arrives, with the buffer
+// double-booking keeps between trips
+func (s schedule) checkLegs(out, back booking) error {
+	if s.overlap(out, back) || back.start.Before(out.start) {
+		return errReturnTooEarly
+	}
+	return nil
+}
+
+// legsTogether moves the legs of each round trip next to each other,
+// outbound first, where the first of them was listed
+func legsTogether[T any](items []T, legOf func(*T) (pairID, leg string)) []T {
+	pairs := make(map[string][]int)
+	for i := range items {
+		if id, _ := legOf(&items[i]); id != "" {
+			pairs[id] = append(pairs[id], i)
+		}
+	}
+	out := make([]T, 0, len(items))
+	placed := make([]bool, len(items))
+	for i := range items {
+		if placed[i] {
+			continue
+		}
+		id, _ := legOf(&items[i])
+		if id == "" {
+			out = append(out, items[i])
+			placed[i] = true
+			continue
+		}
+		legs := pairs[id]
+		sort.SliceStable(legs, func(a, b int) bool {
+			_, la := legOf(&items[legs[a]])
+			_, lb := legOf(&items[legs[b]])
+			return la == legOutbound && lb != legOutbound
+		})
+		for _, j := range legs {
+			out = append(out, items[j])
+			placed[j] = true
+		}
+	}
+	return out
+}
+
+func offerLeg(o *db.RideOffer) (string, string)     { return o.PairID, o.Leg }
+func requestLeg(r *db.RideRequest) (string, string) { return r.PairID, r.Leg }
+func matchLeg(m *db.Match) (string, string)         { return m.PairID, m.Leg }
+
+func (s rideService) CreateRoundTrip(ctx context.Context, out, back *db.RideOffer) error {
+	if out == nil || back == nil {
+		return errMissingFields
+	}
+	if err := s.checkOffer(ctx, out); err != nil {
+		return err
+	}
+	if err := s.checkOffer(ctx, back); err != nil {
+		return err
+	}
+	if err := s.sched.checkLegs(s.sched.offerBooking(out, plannedStops(out)), s.sched.offerBooking(back, plannedStops(back))); err != nil {
+		return err
+	}
+	pairID := uuid.New().String()
+	out.PairID, out.Leg = pairID, legOutbound
+	back.PairID, back.Leg = pairID, legReturn
+	return s.rideofferepo.CreatePair(ctx, out, back)
+}
+
+func (s rideService) CreateRoundTripRequest(ctx context.Context, out, back *db.RideRequest, mode string) error {
+	if out == nil || back == nil {
+		return errMissingFields
+	}
+	mode, err := checkPairMode(mode)
+	if err != nil {
+		return err
+	}
+	if err := s.checkRequest(ctx, out); err != nil {
+		return err
+	}
+	if err := s.checkRequest(ctx, back); err != nil {
+		return err
+	}
+	if err := s.sched.checkLegs(s.sched.requestBooking(out), s.sched.requestBooking(back)); err != nil {
+		return err
+	}
+	pairID := uuid.New().String()
+	out.PairID, out.Leg, out.PairMode = pairID, legOutbound, mode
+	back.PairID, back.Leg, back.PairMode = pairID, legReturn, mode
+	return s.riderequestrepo.CreatePair(ctx, out, back)
+}
+
+func (s matchService) RequestRoundTrip(ctx context.Context, out, back *db.Match, mode string) error {
+	if out == nil || back == nil {
+		return errMissingFields
+	}
+	mode, err := checkPairMode(mode)
+	if err != nil {
+		return err
+	}
+	outRide, err := s.visibleRide(ctx, out.RiderID, out.RideID)
+	if err != nil {
+		return err
+	}
+	back.RideID = strings.TrimSpace(back.RideID)
+	if back.RideID == "" {
+		if back.RideID, err = s.returnRide(ctx, outRide); err != nil {
+			return err
+		}
+	}
+	if back.RideID == outRide.ID {
+		return errSameRide
+	}
+	backRide, err := s.visibleRide(ctx, back.RiderID, back.RideID)
+	if err != nil {
+		return err
+	}
+	ob, err := s.sched.rideBooking(ctx, outRide)
+	if err != nil {
+		return err
+	}
+	bb, err := s.sched.rideBooking(ctx, backRide)
+	if err != nil {
+		return err
+	}
+	if err := s.sched.checkLegs(ob, bb); err != nil {
+		return err
+	}
+
+	pairID := uuid.New().String()
+	out.PairID, out.Leg, out.PairMode = pairID, legOutbound, mode
+	back.PairID, back.Leg, back.PairMode = pairID, legReturn, mode
+	// the return goes first: an auto-accept rule on the outbound ride only
+	// takes the rider once their way back is settled
+	if err := s.RequestToJoin(ctx, back, false); err != nil {
+		return err
+	}
+	if err := s.RequestToJoin(ctx, out, false); err != nil {
+		// the return leg alone is not what the rider asked for
+		if derr := s.dropLeg(ctx, back, "the outbound leg could not be requested"); derr != nil {
+			return errors.Join(err, derr)
+		}
+		return err
+	}
+	return nil
+}
+
+// checkReturnLeg fails with errAwaitsReturn while m is the outbound leg of
+// a together round trip whose return leg isn't accepted. Taking the rider
+// out before that could leave them without a way back
+func (s matchService) checkReturnLeg(ctx context.Context, m *db.Match) error {
+	if m.PairID == "" || m.PairMode != pairTogether || m.Leg != legOutbound {
+		return nil
+	}
+	legs, err := s.matchrepo.FindByPairID(ctx, m.PairID)
+	if err != nil {
+		return err
+	}
+	for _, p := range legs {
+		if p.Leg == legReturn && (p.Status == "accepted" || p.Status == "completed") {
+			return nil
+		}
+	}
+	return errAwaitsReturn
+}
+
+// visibleRide is offer rideID when riderID can see it
+func (s matchService) visibleRide(ctx context.Context, riderID, rideID string) (*db.RideOffer, error) {
+	offer, err := s.rideofferepo.FindByID(ctx, strings.TrimSpace(rideID))
+	if err != nil || offer == nil || offer.ID == "" {
+		return nil, errOfferNotFound
+	}
+	if ok, err := s.scope.canSee(ctx, strings.TrimSpace(riderID), offer.OrgID); err != nil || !ok {
+		return nil, errOfferNotFound
+	}
+	return offer, nil
+}
+
+// returnRide is the return leg of offer's round trip
+func (s matchService) returnRide(ctx context.Context, offer *db.RideOffer) (string, error) {
+	if offer.PairID == "" || offer.Leg != legOutbound {
+		return "", errNoReturnRide
+	}
+	legs, err := s.rideofferepo.FindByPairID(ctx, offer.PairID)
+	if err != nil {
+		return "", err
+	}
+	for _, o := range legs {
+		if o.Leg == legReturn {
+			return o.ID, nil
+		}
+	}
+	return "", errNoReturnRide
+}
+
+// partnerRequest is the other leg of req's round trip, which has to be
+// open still for a driver to take both
+func (s matchService) partnerRequest(ctx context.Context, req *db.RideRequest) (*db.RideRequest, error) {
+	legs, err := s.riderequestrepo.FindByPairID(ctx, req.PairID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range legs {
+		if legs[i].ID == req.ID {
+			continue
+		}
+		if legs[i].Status != "active" {
+			return nil, errPairClosed
+		}
+		return &legs[i], nil
+	}
+	return nil, errPairClosed
+}
+
+// dropPartner takes back the other leg of m's round trip after m fell
+// through, when the rider wanted both legs or neither. A leg the rider is
+// already riding, or rode, stays
+func (s matchService) dropPartner(ctx context.Context, m *db.Match) error {
+	if m.PairID == "" || m.PairMode != pairTogether {
+		return nil
+	}
+	legs, err := s.matchrepo.FindByPairID(ctx, m.PairID)
+	if err != nil {
+		return err
+	}
+	for i := range legs {
+		p := &legs[i]
+		if p.ID == m.ID || p.StartedAt != nil {
+			continue
+		}
+		if err := s.dropLeg(ctx, p, "the "+m.Leg+" leg of the round trip fell through"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropLeg withdraws a pending leg or cancels an accepted one for the
+// system. Neither counts against anyone
+func (s matchService) dropLeg(ctx context.Context, m *db.Match, reason string) error {
+	c := repository.Change{Reason: reason}
+	switch m.Status {
+	case "requested", "waitlisted", "promoted":
+		if err := s.matchrepo.Cancel(ctx, m.ID, m.Status, "withdrawn", c, false); err != nil {
+			return err
+		}
+		return s.release(ctx, m)
+	case "accepted":
+		offer, err := s.rideofferepo.FindByID(ctx, m.RideID)
+		if err != nil || offer == nil || offer.ID == "" {
+			return errOfferNotFound
+		}
+		if err := s.matchrepo.Cancel(ctx, m.ID, m.Status, "cancelled", c, false); err != nil {
+			return err
+		}
+		s.notify(ctx, m.RideID, m.RiderID, "lost their seat", reason)
+		if err := s.endTrip(ctx, m); err != nil {
+			return err
+		}
+		s.planner.refresh(ctx, offer)
+		s.waitlist.refill(ctx, offer)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"hope/config"
+	"hope/db"
+	"hope/geo"
+	"hope/repository"
+	"hope/routing"
+)
+
+var errDetourTooLong = errors.New("invalid state: detour exceeds the driver's limit")
+
+// NewRouter loads the road graph from the configured extract, or falls back
+// to straight line estimates from the speed model when none is configured
+func NewRouter(cfg config.Routing, model config.SpeedModel) (routing.Router, error) {
+	if cfg.OSMFile == "" {
+		return routing.StraightLine{SpeedKMH: model.DefaultKMH, RoadFactor: model.RoadFactor}, nil
+	}
+	start := time.Now()
+	g, err := routing.LoadOSM(cfg.OSMFile)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("road graph loaded from %s: %d nodes, %d edges in %s", cfg.OSMFile, g.Nodes(), g.Edges(), time.Since(start).Round(time.Millisecond))
+	return g, nil
+}
+
+func geoPoint(label, hash string) (routing.Point, error) {
+	lat, lon, err := geo.Decode(strings.TrimSpace(hash))
+	if err != nil {
+		return routing.Point{}, fmt.Errorf("invalid %s geohash", label)
+	}
+	return routing.Point{Lat: lat, Lon: lon}, nil
+}
+
+// offerDetour is what taking a rider from pickup to dropoff adds to the
+// offer's planned stops
+func offerDetour(router routing.Router, stops []db.Waypoint, pickupGeo, dropoffGeo string) (routing.Detour, error) {
+	points := make([]routing.Point, 0, len(stops))
+	for _, st := range stops {
+		p, err := geoPoint("stop", st.Geohash)
+		if err != nil {
+			return routing.Detour{}, err
+		}
+		points = append(points, p)
+	}
+	pickup, err := geoPoint("pickup", pickupGeo)
+	if err != nil {
+		return routing.Detour{}, err
+	}
+	dropoff, err := geoPoint("dropoff", dropoffGeo)
+	if err != nil {
+		return routing.Detour{}, err
+	}
+	return routing.PlanDetour(router, points, pickup, dropoff)
+}
+
+// FareEstimate is the suggested price of a route under the fare model.
+type FareEstimate struct {
+	Fare    float64
+	Meters  float64
+	Seconds float64
+}
+
+func estimateFare(model config.FareModel, r routing.Route) FareEstimate {
+	fare := model.Base + model.PerKM*r.Meters/1000 + model.PerMinute*r.Seconds/60
+	return FareEstimate{
+		Fare:    math.Round(fare*100) / 100,
+		Meters:  r.Meters,
+		Seconds: r.Seconds,
+	}
+}
+
+type RouteService interface {
+	GetRoute(ctx context.Context, fromGeo, toGeo string) (*routing.Route, error)
+	// EstimateDetour is what picking the caller up at pickupGeo and
+	// dropping them at dropoffGeo adds to an offer, empty geohashes
+	// default to the offer's own endpoints
+	EstimateDetour(ctx context.Context, callerID, offerID, pickupGeo, dropoffGeo string) (*routing.Detour, error)
+	EstimateFare(ctx context.Context, fromGeo, toGeo string) (*FareEstimate, error)
+}
+
+type routeService struct {
+	router       routing.Router
+	rideofferepo repository.RideOfferRepository
+	waypointrepo repository.WaypointRepository
+	scope        orgScope
+	fares        config.FareModel
+}
+
+func NewRouteService(router routing.Router, rideofferepo repository.RideOfferRepository, waypointrepo repository.WaypointRepository, userrepo repository.UserRepository, orgrepo repository.OrganizationRepository, fares config.FareModel) RouteService {
+	return &routeService{
+		router:       router,
+		rideofferepo: rideofferepo,
+		waypointrepo: waypointrepo,
+		scope:        orgScope{userrepo: userrepo, orgrepo: orgrepo},
+		fares:        fares,
+	}
+}
+
+func (s routeService) route(fromGeo, toGeo string) (routing.Route, error) {
+	from, err := geoPoint("from", fromGeo)
+	if err != nil {
+		return routing.Route{}, err
+	}
+	to, err := geoPoint("to", toGeo)
+	if err != nil {
+		return routing.Route{}, err
+	}
+	return s.router.Route(from, to)
+}
+
+func (s routeService) GetRoute(ctx context.Context, fromGeo, toGeo string) (*routing.Route, error) {
+	r, err := s.route(fromGeo, toGeo)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (s routeService) EstimateDetour(ctx context.Context, callerID, offerID, pickupGeo, dropoffGeo string) (*routing.Detour, error) {
+	offer, err := s.rideofferepo.FindByID(ctx, strings.TrimSpace(offerID))
+	if err != nil || offer == nil {
+		return nil, errOfferNotFound
+	}
+	if ok, err := s.scope.canSee(ctx, callerID, offer.OrgID); err != nil || !ok {
+		return nil, errOfferNotFound
+	}
+	if strings.TrimSpace(pickupGeo) == "" {
+		pickupGeo = offer.FromGeo
+	}
+	if strings.TrimSpace(dropoffGeo) == "" {
+		dropoffGeo = offer.ToGeo
+	}
+	stops, err := routeStops(ctx, s.waypointrepo, offer)
+	if err != nil {
+		return nil, err
+	}
+	d, err := offerDetour(s.router, stops, pickupGeo, dropoffGeo)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (s routeService) EstimateFare(ctx context.Context, fromGeo, toGeo string) (*FareEstimate, error) {
+	r, err := s.route(fromGeo, toGeo)
+	if err != nil {
+		return nil, err
+	}
+	est := estimateFare(s.fares, r)
+	return &est, nil
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"hope/db"
+)
+
+var (
+	errInvalidWindow     = errors.New("invalid departure window: earliest can't be after the planned time, nor latest before it")
+	errWindowTooWide     = errors.New("invalid departure window: can't span more than a day")
+	errInvalidArriveBy   = errors.New("invalid arrive by: the trip can't get there in time")
+	errInvalidTimeWindow = errors.New("invalid time window: earliest is after latest")
+	errNoPickupTime      = errors.New("invalid state: no pickup time suits both the driver and the rider")
+	errPickupOutside     = errors.New("invalid pickup time: outside what the driver and the rider allow")
+)
+
+// TimeWindow narrows a search to trips that can leave between Earliest
+// and Latest and arrive by ArriveBy, nil leaves that side open
+type TimeWindow struct {
+	Earliest *time.Time
+	Latest   *time.Time
+	ArriveBy *time.Time
+}
+
+func (t TimeWindow) open() bool {
+	return t.Earliest == nil && t.Latest == nil && t.ArriveBy == nil
+}
+
+func (t TimeWindow) check() error {
+	if t.Earliest != nil && t.Latest != nil && t.Earliest.After(*t.Latest) {
+		return errInvalidTimeWindow
+	}
+	return nil
+}
+
+// fits tells whether a trip that may leave within w and takes d to drive
+// can leave and arrive as t asks
+func (t TimeWindow) fits(w window, d time.Duration) bool {
+	w = w.intersect(between(t.Earliest, t.Latest))
+	if t.ArriveBy != nil {
+		w = w.intersect(window{to: t.ArriveBy.Add(-d)})
+	}
+	return !w.empty()
+}
+
+// window is a span of times, a zero end leaves that side open
+type window struct {
+	from, to time.Time
+}
+
+func between(from, to *time.Time) window {
+	var w window
+	if from != nil {
+		w.from = *from
+	}
+	if to != nil {
+		w.to = *to
+	}
+	return w
+}
+
+func (w window) intersect(o window) window {
+	if w.from.IsZero() || o.from.After(w.from) {
+		w.from = o.from
+	}
+	if w.to.IsZero() || (!o.to.IsZero() && o.to.Before(w.to)) {
+		w.to = o.to
+	}
+	return w
+}
+
+func (w window) empty() bool {
+	return !w.from.IsZero() && !w.to.IsZero() && w.from.After(w.to)
+}
+
+func (w window) contains(t time.Time) bool {
+	return (w.from.IsZero() || !t.Before(w.from)) && (w.to.IsZero() || !t.After(w.to))
+}
+
+// clamp is the time in w closest to t
+func (w window) clamp(t time.Time) time.Time {
+	if !w.from.IsZero() && t.Before(w.from) {
+		return w.from
+	}
+	if !w.to.IsZero() && t.After(w.to) {
+		return w.to
+	}
+	return t
+}
+
+func (w window) shift(d time.Duration) window {
+	if !w.from.IsZero() {
+		w.from = w.from.Add(d)
+	}
+	if !w.to.IsZero() {
+		w.to = w.to.Add(d)
+	}
+	return w
+}
+
+// tripWindow is when a trip planned to leave at t may leave: between
+// earliest and latest, or right at t where they're not set, and early
+// enough to arrive by arriveBy when the drive takes d
+func tripWindow(t time.Time, earliest, latest, arriveBy *time.Time, d time.Duration) window {
+	w := window{from: t, to: t}
+	if earliest != nil {
+		w.from = *earliest
+	}
+	if latest != nil {
+		w.to = *latest
+	}
+	if arriveBy != nil {
+		w = w.intersect(window{to: arriveBy.Add(-d)})
+	}
+	return w
+}
+
+// checkTimes validates the departure window around t of a new trip that
+// takes d to drive, and that it can still arrive by arriveBy
+func checkTimes(t time.Time, earliest, latest, arriveBy *time.Time, d time.Duration) error {
+	if (earliest != nil && earliest.After(t)) || (latest != nil && latest.Before(t)) {
+		return errInvalidWindow
+	}
+	if earliest != nil && earliest.Before(time.Now().UTC()) {
+		return errPastTime
+	}
+	w := tripWindow(t, earliest, latest, nil, 0)
+	if w.to.Sub(w.from) > bookingLookback {
+		return errWindowTooWide
+	}
+	if arriveBy != nil && w.from.Add(d).After(*arriveBy) {
+		return fmt.Errorf("%w: the drive takes %d min", errInvalidArriveBy, int(d.Minutes()+0.5))
+	}
+	return nil
+}
+
+// agreedDeparture is when offer leaves: the departure its accepted riders'
+// pickup times assume, or its planned time before anyone agreed on one
+func agreedDeparture(offer *db.RideOffer, matches []db.Match) time.Time {
+	for _, m := range matches {
+		if m.Status == "accepted" && m.DepartAt != nil {
+			return *m.DepartAt
+		}
+	}
+	return offer.Time
+}
+
+// departures is when offer can still leave: within its window, in time to
+// arrive by its ArriveBy, and once riders agreed on pickup times, at the
+// departure those assume
+func (s schedule) departures(offer *db.RideOffer, stops []db.Waypoint, matches []db.Match) window {
+	w := tripWindow(offer.Time, offer.EarliestAt, offer.LatestAt, offer.ArriveBy, s.drive(stops))
+	for _, m := range matches {
+		if m.Status == "accepted" && m.DepartAt != nil {
+			w = w.intersect(window{from: *m.DepartAt, to: *m.DepartAt})
+		}
+	}
+	return w
+}
+
+// reach is how long the drive from the origin through stops up to i, and
+// on from there to geo, takes
+func (s schedule) reach(stops []db.Waypoint, i int, geo string) time.Duration {
+	d := s.drive(stops[:i+1])
+	if geo != "" && geo != stops[i].Geohash {
+		d += s.drive([]db.Waypoint{stops[i], {Geohash: geo}})
+	}
+	return d.Round(time.Second)
+}
+
+// legs is how long after departure the offer reaches m's pickup and
+// dropoff, leaving out the detour the rider adds
+func (s schedule) legs(stops []db.Waypoint, m db.Match) (pickup, dropoff time.Duration) {
+	from, to := span(m, len(stops))
+	return s.reach(stops, from, m.PickupGeo), s.reach(stops, to-1, m.DropoffGeo)
+}
+
+// planPickup sets m's pickup time, and the departure it assumes: want when
+// given, else the time m already proposes while it still fits, else the
+// one closest to the offer's plan. The time has to be one the offer can
+// leave for, inside the rider's pickup window, still ahead, and early
+// enough to drop them off by their ArriveBy
+func (s matchService) planPickup(offer *db.RideOffer, stops []db.Waypoint, matches []db.Match, m *db.Match, want *time.Time) error {
+	if m.EarliestPickup != nil && m.LatestPickup != nil && m.EarliestPickup.After(*m.LatestPickup) {
+		return errInvalidTimeWindow
+	}
+	pickup, dropoff := s.sched.legs(stops, *m)
+	w := s.sched.departures(offer, stops, matches).shift(pickup)
+	w = w.intersect(between(m.EarliestPickup, m.LatestPickup))
+	w = w.intersect(window{from: time.Now().UTC()})
+	if m.ArriveBy != nil {
+		w = w.intersect(window{to: m.ArriveBy.Add(pickup - dropoff)})
+	}
+	if w.empty() {
+		return errNoPickupTime
+	}
+	var at time.Time
+	switch {
+	case want != nil:
+		if !w.contains(*want) {
+			return fmt.Errorf("%w: pick a time from %s to %s", errPickupOutside, w.from.UTC().Format(time.RFC3339), w.to.UTC().Format(time.RFC3339))
+		}
+		at = want.UTC()
+	case m.PickupAt != nil && w.contains(*m.PickupAt):
+		at = m.PickupAt.UTC()
+	default:
+		at = w.clamp(agreedDeparture(offer, matches).Add(pickup)).UTC()
+	}
+	depart := at.Add(-pickup)
+	m.PickupAt, m.DepartAt = &at, &depart
+	return nil
+}
+
+// offersLeaving keeps the offers that can leave and arrive as when asks
+func (s rideService) offersLeaving(ctx context.Context, offers []db.RideOffer, when TimeWindow) ([]db.RideOffer, error) {
+	if when.open() || len(offers) == 0 {
+		return offers, nil
+	}
+	ids := make([]string, 0, len(offers))
+	for _, o := range offers {
+		ids = append(ids, o.ID)
+	}
+	matches, err := s.matchrepo.FindByRideIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byRide := make(map[string][]db.Match, len(offers))
+	for _, m := range matches {
+		byRide[m.RideID] = append(byRide[m.RideID], m)
+	}
+	waypoints, err := s.waypointrepo.ListByRides(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	stopsOf := make(map[string][]db.Waypoint, len(offers))
+	for _, w := range waypoints {
+		stopsOf[w.RideID] = append(stopsOf[w.RideID], w)
+	}
+	out := offers[:0]
+	for i := range offers {
+		o := &offers[i]
+		stops := offerStops(o, stopsOf[o.ID])
+		if when.fits(s.sched.departures(o, stops, byRide[o.ID]), s.sched.drive(stops)) {
+			out = append(out, *o)
+		}
+	}
+	return out, nil
+}
+
+// requestsLeaving keeps the requests that can leave and arrive as when asks
+func (s rideService) requestsLeaving(reqs []db.RideRequest, when TimeWindow) []db.RideRequest {
+	if when.open() {
+		return reqs
+	}
+	out := reqs[:0]
+	for _, r := range reqs {
+		d := s.sched.drive([]db.Waypoint{{Geohash: r.FromGeo}, {Geohash: r.ToGeo}})
+		if when.fits(tripWindow(r.Time, r.EarliestAt, r.LatestAt, r.ArriveBy, d), d) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
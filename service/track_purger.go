@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"hope/config"
+	"hope/repository"
+)
+
+// TrackPurger deletes trip points older than the retention window.
+type TrackPurger struct {
+	pointrepo repository.TripPointRepository
+	retention config.TrackRetention
+}
+
+func NewTrackPurger(pointrepo repository.TripPointRepository, retention config.TrackRetention) *TrackPurger {
+	return &TrackPurger{pointrepo: pointrepo, retention: retention}
+}
+
+// Run purges once right away and then every Interval until ctx is done.
+// It does nothing when retention is disabled.
+func (p *TrackPurger) Run(ctx context.Context) {
+	if p.retention.MaxAge <= 0 || p.retention.Interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(p.retention.Interval)
+	defer ticker.Stop()
+	for {
+		p.purge(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *TrackPurger) purge(ctx context.Context) {
+	cutoff := time.Now().UTC().Add(-p.retention.MaxAge)
+	n, err := p.pointrepo.DeleteBefore(ctx, cutoff)
+	if err != nil {
+		log.Printf("track purge failed: %v", err)
+		return
+	}
+	if n > 0 {
+		log.Printf("track purge removed %d points older than %s", n, cutoff.Format(time.RFC3339))
+	}
+}
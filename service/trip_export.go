@@ -0,0 +1,135 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"time"
+
+	"hope/db"
+)
+
+var errExportFormat = errors.New("invalid format: use gpx or geojson")
+
+const (
+	ExportGPX     = "gpx"
+	ExportGeoJSON = "geojson"
+)
+
+// TripExport is a rendered track file
+type TripExport struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// trackPart is one participant's points in an export
+type trackPart struct {
+	UserID string
+	Role   string // driver, rider
+	Points []db.TripPoint
+}
+
+type gpxFile struct {
+	XMLName  xml.Name   `xml:"gpx"`
+	Version  string     `xml:"version,attr"`
+	Creator  string     `xml:"creator,attr"`
+	XMLNS    string     `xml:"xmlns,attr"`
+	Metadata gpxMeta    `xml:"metadata"`
+	Tracks   []gpxTrack `xml:"trk"`
+}
+
+type gpxMeta struct {
+	Name string `xml:"name"`
+	Time string `xml:"time,omitempty"`
+}
+
+type gpxTrack struct {
+	Name    string     `xml:"name"`
+	Type    string     `xml:"type"`
+	Segment gpxSegment `xml:"trkseg"`
+}
+
+type gpxSegment struct {
+	Points []gpxPoint `xml:"trkpt"`
+}
+
+type gpxPoint struct {
+	Lat  float64 `xml:"lat,attr"`
+	Lon  float64 `xml:"lon,attr"`
+	Time string  `xml:"time"`
+}
+
+func renderGPX(matchID string, parts []trackPart) ([]byte, error) {
+	f := gpxFile{
+		Version:  "1.1",
+		Creator:  "hope",
+		XMLNS:    "http://www.topografix.com/GPX/1/1",
+		Metadata: gpxMeta{Name: "match " + matchID},
+	}
+	for _, part := range parts {
+		trk := gpxTrack{Name: part.UserID, Type: part.Role}
+		for _, p := range part.Points {
+			trk.Segment.Points = append(trk.Segment.Points, gpxPoint{
+				Lat:  p.Latitude,
+				Lon:  p.Longitude,
+				Time: p.RecordedAt.UTC().Format(time.RFC3339),
+			})
+		}
+		if len(part.Points) > 0 && f.Metadata.Time == "" {
+			f.Metadata.Time = part.Points[0].RecordedAt.UTC().Format(time.RFC3339)
+		}
+		f.Tracks = append(f.Tracks, trk)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(f); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type geoJSONCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+type geoJSONFeature struct {
+	Type       string         `json:"type"`
+	Geometry   geoJSONLine    `json:"geometry"`
+	Properties map[string]any `json:"properties"`
+}
+
+type geoJSONLine struct {
+	Type        string       `json:"type"`
+	Coordinates [][2]float64 `json:"coordinates"`
+}
+
+// renderGeoJSON writes one LineString feature per participant, GeoJSON
+// wants lon/lat order. timestamps go in a parallel "times" property
+func renderGeoJSON(matchID string, parts []trackPart) ([]byte, error) {
+	fc := geoJSONCollection{Type: "FeatureCollection", Features: []geoJSONFeature{}}
+	for _, part := range parts {
+		coords := make([][2]float64, 0, len(part.Points))
+		times := make([]string, 0, len(part.Points))
+		for _, p := range part.Points {
+			coords = append(coords, [2]float64{p.Longitude, p.Latitude})
+			times = append(times, p.RecordedAt.UTC().Format(time.RFC3339))
+		}
+		fc.Features = append(fc.Features, geoJSONFeature{
+			Type:     "Feature",
+			Geometry: geoJSONLine{Type: "LineString", Coordinates: coords},
+			Properties: map[string]any{
+				"match_id": matchID,
+				"user_id":  part.UserID,
+				"role":     part.Role,
+				"times":    times,
+			},
+		})
+	}
+	return json.MarshalIndent(fc, "", "  ")
+}
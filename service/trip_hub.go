@@ -0,0 +1,156 @@
+package service
+
+import (
+	"sync"
+	"time"
+)
+
+// TripEvent is one position pushed to the other side of a trip.
+type TripEvent struct {
+	UserID    string
+	Driver    bool
+	Latitude  float64
+	Longitude float64
+	Geohash   string
+	SpeedMPS  float64
+	At        time.Time
+	// ETA is the driver's time to the recipient's pickup, only set on
+	// driver positions sent to riders
+	ETA *PickupETA
+	// Ended is the last event of a session, sent when the match completes
+	Ended bool
+}
+
+// tripSub is one open ShareTripLocation stream
+type tripSub struct {
+	userID  string
+	matchID string
+	rideID  string
+	driver  bool
+	events  chan TripEvent
+	once    sync.Once
+
+	// rider subs know their pickup so driver positions can carry an ETA
+	hasPickup bool
+	pickupLat float64
+	pickupLon float64
+}
+
+func (s *tripSub) close() {
+	s.once.Do(func() { close(s.events) })
+}
+
+// push never blocks the publisher: a slow reader loses its oldest
+// position, which is stale anyway
+func (s *tripSub) push(ev TripEvent) {
+	for {
+		select {
+		case s.events <- ev:
+			return
+		default:
+		}
+		select {
+		case <-s.events:
+		default:
+		}
+	}
+}
+
+// TripHub fans live positions out between the participants of a ride. It is
+// in-memory, so every participant of a trip has to be connected to the same
+// instance.
+type TripHub struct {
+	mu    sync.Mutex
+	rides map[string]map[*tripSub]struct{}
+}
+
+func NewTripHub() *TripHub {
+	return &TripHub{rides: make(map[string]map[*tripSub]struct{})}
+}
+
+// subscribe registers sub on its ride, its fields must not change afterwards
+func (h *TripHub) subscribe(sub *tripSub) *tripSub {
+	rideID := sub.rideID
+	sub.events = make(chan TripEvent, 16)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.rides[rideID] == nil {
+		h.rides[rideID] = make(map[*tripSub]struct{})
+	}
+	h.rides[rideID][sub] = struct{}{}
+	return sub
+}
+
+func (h *TripHub) unsubscribe(sub *tripSub) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if subs, ok := h.rides[sub.rideID]; ok {
+		if _, member := subs[sub]; member {
+			delete(subs, sub)
+			sub.close()
+		}
+		if len(subs) == 0 {
+			delete(h.rides, sub.rideID)
+		}
+	}
+}
+
+// publish sends a driver's position to every rider on the ride, and a
+// rider's position to the driver only. riders never see each other.
+// eventFor may tailor the event per recipient (e.g. fill an ETA), it runs
+// outside the lock since it may hit the database
+func (h *TripHub) publish(from *tripSub, ev TripEvent, eventFor func(to *tripSub, ev TripEvent) TripEvent) {
+	h.mu.Lock()
+	targets := make([]*tripSub, 0, len(h.rides[from.rideID]))
+	for sub := range h.rides[from.rideID] {
+		if sub.userID == from.userID || sub.driver == from.driver {
+			continue
+		}
+		targets = append(targets, sub)
+	}
+	h.mu.Unlock()
+
+	events := make([]TripEvent, len(targets))
+	for i, sub := range targets {
+		events[i] = ev
+		if eventFor != nil {
+			events[i] = eventFor(sub, ev)
+		}
+	}
+
+	// subs are only closed under the lock after leaving the map, so the
+	// membership check keeps us from sending on a closed channel
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for i, sub := range targets {
+		if _, ok := h.rides[from.rideID][sub]; ok {
+			sub.push(events[i])
+		}
+	}
+}
+
+// EndMatch finishes the rider streams of a completed match.
+func (h *TripHub) EndMatch(rideID, matchID string) {
+	h.end(rideID, func(sub *tripSub) bool { return !sub.driver && sub.matchID == matchID })
+}
+
+// EndRide finishes every stream on the ride, drivers included.
+func (h *TripHub) EndRide(rideID string) {
+	h.end(rideID, func(*tripSub) bool { return true })
+}
+
+func (h *TripHub) end(rideID string, match func(*tripSub) bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.rides[rideID] {
+		if !match(sub) {
+			continue
+		}
+		delete(h.rides[rideID], sub)
+		sub.push(TripEvent{Ended: true, At: time.Now().UTC()})
+		sub.close()
+	}
+	if len(h.rides[rideID]) == 0 {
+		delete(h.rides, rideID)
+	}
+}
@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"hope/db"
+)
+
+// Trip is an offer seen from the driver's seat: who asked to ride, who is
+// coming, and the order they are collected in.
+type Trip struct {
+	Offer *db.RideOffer
+	// nil in ListMyTrips
+	Plan *db.TripPlan
+	// matches grouped by status, accepted first
+	Groups []TripGroup
+	// most seats taken on any leg of the route, and what that leaves
+	SeatsUsed int
+	SeatsFree int
+}
+
+type TripGroup struct {
+	Status string
+	Riders []TripRider
+}
+
+// TripRider is a match plus the rider's profile
+type TripRider struct {
+	Match    db.Match
+	Name     string
+	PhotoURL string
+	Rating   float64
+	Reviews  int
+}
+
+// ListMyTrips returns this many offers when the caller sets no limit
+const defaultTripLimit = 20
+
+// groups come in this order, statuses not listed go last by name
+var tripStatusOrder = map[string]int{"accepted": 0, "promoted": 1, "requested": 2, "waitlisted": 3, "completed": 4, "rejected": 5}
+
+func (s tripService) GetTripManifest(ctx context.Context, callerID, rideID string) (*Trip, error) {
+	callerID = strings.TrimSpace(callerID)
+	offer, err := s.offerrepo.FindByID(ctx, strings.TrimSpace(rideID))
+	if err != nil || offer == nil || offer.ID == "" {
+		return nil, errOfferNotFound
+	}
+	matches, err := s.matchrepo.FindByRideID(ctx, offer.ID)
+	if err != nil {
+		return nil, err
+	}
+	driver := offer.DriverID == callerID
+	if !driver && !acceptedRider(matches, callerID) {
+		return nil, errOfferNotFound
+	}
+
+	trip, err := s.trip(ctx, offer, matches)
+	if err != nil {
+		return nil, err
+	}
+	if trip.Plan, err = s.planner.plan(ctx, offer); err != nil {
+		return nil, err
+	}
+	if !driver {
+		riderView(callerID, trip)
+	}
+	return trip, nil
+}
+
+func (s tripService) ListMyTrips(ctx context.Context, callerID string, limit int) ([]Trip, error) {
+	callerID = strings.TrimSpace(callerID)
+	if callerID == "" {
+		return nil, errMissingFields
+	}
+	if limit <= 0 {
+		limit = defaultTripLimit
+	}
+	offers, err := s.offerrepo.ListByDriver(ctx, callerID, limit)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(offers))
+	for _, o := range offers {
+		ids = append(ids, o.ID)
+	}
+	all, err := s.matchrepo.FindByRideIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byRide := make(map[string][]db.Match, len(offers))
+	for _, m := range all {
+		byRide[m.RideID] = append(byRide[m.RideID], m)
+	}
+	out := make([]Trip, 0, len(offers))
+	for i := range offers {
+		trip, err := s.trip(ctx, &offers[i], byRide[offers[i].ID])
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *trip)
+	}
+	return out, nil
+}
+
+// trip assembles offer and its matches with rider profiles and seat use
+func (s tripService) trip(ctx context.Context, offer *db.RideOffer, matches []db.Match) (*Trip, error) {
+	stops, err := routeStops(ctx, s.waypointrepo, offer)
+	if err != nil {
+		return nil, err
+	}
+	used := 0
+	for _, n := range seatsUsed(matches, len(stops)) {
+		used = max(used, n)
+	}
+
+	riderIDs := make([]string, 0, len(matches))
+	for _, m := range matches {
+		riderIDs = append(riderIDs, m.RiderID)
+	}
+	users, err := s.userrepo.FindByIDs(ctx, riderIDs)
+	if err != nil {
+		return nil, err
+	}
+	profiles := make(map[string]db.User, len(users))
+	for _, u := range users {
+		profiles[u.ID] = u
+	}
+	ratings, err := s.reviewrepo.RatingsFor(ctx, riderIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byStatus := map[string][]TripRider{}
+	for _, m := range matches {
+		u := profiles[m.RiderID]
+		r := ratings[m.RiderID]
+		byStatus[m.Status] = append(byStatus[m.Status], TripRider{
+			Match:    m,
+			Name:     u.Name,
+			PhotoURL: u.PhotoURL,
+			Rating:   r.Average,
+			Reviews:  r.Count,
+		})
+	}
+	trip := &Trip{Offer: offer, SeatsUsed: used, SeatsFree: max(0, offer.Seats-used)}
+	for status, riders := range byStatus {
+		trip.Groups = append(trip.Groups, TripGroup{Status: status, Riders: riders})
+	}
+	sort.Slice(trip.Groups, func(i, j int) bool {
+		a, b := trip.Groups[i].Status, trip.Groups[j].Status
+		ra, oka := tripStatusOrder[a]
+		rb, okb := tripStatusOrder[b]
+		switch {
+		case oka && okb:
+			return ra < rb
+		case oka != okb:
+			return oka
+		default:
+			return a < b
+		}
+	})
+	return trip, nil
+}
+
+func acceptedRider(matches []db.Match, userID string) bool {
+	for _, m := range matches {
+		if m.RiderID == userID && m.Status == "accepted" {
+			return true
+		}
+	}
+	return false
+}
+
+// riderView trims trip to what one of its riders may see: everyone
+// coming along, but not where the other riders get on and off, nor who
+// else asked to join
+func riderView(riderID string, trip *Trip) {
+	own := map[string]bool{}
+	groups := trip.Groups[:0]
+	for _, g := range trip.Groups {
+		riders := make([]TripRider, 0, len(g.Riders))
+		for _, r := range g.Riders {
+			switch {
+			case r.Match.RiderID == riderID:
+				own[r.Match.ID] = true
+			case r.Match.Status == "accepted":
+				r.Match = db.Match{RiderID: r.Match.RiderID, RideID: r.Match.RideID, Status: r.Match.Status, Seats: r.Match.Seats}
+			default:
+				continue
+			}
+			riders = append(riders, r)
+		}
+		if len(riders) > 0 {
+			groups = append(groups, TripGroup{Status: g.Status, Riders: riders})
+		}
+	}
+	trip.Groups = groups
+
+	plan := *trip.Plan
+	plan.Stops = make([]db.TripPlanStop, len(trip.Plan.Stops))
+	for i, st := range trip.Plan.Stops {
+		if st.MatchID != "" && !own[st.MatchID] {
+			st.MatchID, st.Geohash = "", ""
+		}
+		plan.Stops[i] = st
+	}
+	trip.Plan = &plan
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	"hope/config"
+	"hope/db"
+	"hope/repository"
+	"hope/routing"
+
+	"github.com/google/uuid"
+)
+
+const (
+	planOrigin      = "origin"
+	planWaypoint    = "waypoint"
+	planPickup      = "pickup"
+	planDropoff     = "dropoff"
+	planDestination = "destination"
+)
+
+// TripPlanner keeps each offer's trip plan, the order its pickups and
+// dropoffs are made in, in step with the accepted matches. The match
+// service rebuilds plans as riders come and go and the trip service
+// serves them.
+type TripPlanner struct {
+	router       routing.Router
+	planrepo     repository.TripPlanRepository
+	waypointrepo repository.WaypointRepository
+	matchrepo    repository.MatchRepository
+	slack        time.Duration
+}
+
+func NewTripPlanner(router routing.Router, planrepo repository.TripPlanRepository, waypointrepo repository.WaypointRepository, matchrepo repository.MatchRepository, cfg config.TripPlanning, model config.SpeedModel) *TripPlanner {
+	return &TripPlanner{
+		// a rider the detour check let in without a road to them (offers
+		// without a detour limit) must not break the whole trip's plan
+		router:       routing.Fallback{Router: router, Else: routing.StraightLine{SpeedKMH: model.DefaultKMH, RoadFactor: model.RoadFactor}},
+		planrepo:     planrepo,
+		waypointrepo: waypointrepo,
+		matchrepo:    matchrepo,
+		slack:        cfg.Slack,
+	}
+}
+
+// plan is the stored plan of offer, built on first use
+func (p *TripPlanner) plan(ctx context.Context, offer *db.RideOffer) (*db.TripPlan, error) {
+	plan, err := p.planrepo.FindByRide(ctx, offer.ID)
+	if err != nil || plan != nil {
+		return plan, err
+	}
+	return p.rebuild(ctx, offer)
+}
+
+// refresh rebuilds the plan after a change to offer's matches. A failed
+// rebuild leaves the previous plan in place until the next accept or
+// cancel. The match change stands either way, so the error is logged
+func (p *TripPlanner) refresh(ctx context.Context, offer *db.RideOffer) {
+	if _, err := p.rebuild(ctx, offer); err != nil {
+		log.Printf("ride %s: trip plan not rebuilt: %v", offer.ID, err)
+	}
+}
+
+// latest is the last moment a planned time is still kept, zero when
+// nothing was planned
+func (p *TripPlanner) latest(planned time.Time) time.Time {
+	if planned.IsZero() {
+		return planned
+	}
+	return planned.Add(p.slack)
+}
+
+// rebuild orders the offer's waypoints and its accepted riders' pickups
+// and dropoffs for the least driving, keeping to planned stop times where
+// the seats allow, and stores the result
+func (p *TripPlanner) rebuild(ctx context.Context, offer *db.RideOffer) (*db.TripPlan, error) {
+	stops, err := routeStops(ctx, p.waypointrepo, offer)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := p.matchrepo.FindByRideID(ctx, offer.ID)
+	if err != nil {
+		return nil, err
+	}
+	points := make([]routing.Point, len(stops))
+	for i, st := range stops {
+		if points[i], err = geoPoint("stop", st.Geohash); err != nil {
+			return nil, err
+		}
+	}
+
+	// what each visit stands for, in the order handed to the sequencer
+	type ref struct {
+		kind    string
+		matchID string
+		stopSeq int
+		geohash string
+	}
+	var visits []routing.Visit
+	var refs []ref
+	last := len(stops) - 1
+	for i := 1; i < last; i++ {
+		after := len(visits) - 1 // the previous waypoint, the driver keeps their order
+		visits = append(visits, routing.Visit{
+			Point:    points[i],
+			Earliest: stops[i].Planned(),
+			Latest:   p.latest(stops[i].Planned()),
+			After:    after,
+		})
+		refs = append(refs, ref{kind: planWaypoint, stopSeq: i, geohash: stops[i].Geohash})
+	}
+	for _, m := range matches {
+		if m.Status != "accepted" {
+			continue
+		}
+		pickupGeo, dropoffGeo := m.PickupGeo, m.DropoffGeo
+		if pickupGeo == "" {
+			pickupGeo = offer.FromGeo
+		}
+		if dropoffGeo == "" {
+			dropoffGeo = offer.ToGeo
+		}
+		pickup, err := geoPoint("pickup", pickupGeo)
+		if err != nil {
+			return nil, err
+		}
+		dropoff, err := geoPoint("dropoff", dropoffGeo)
+		if err != nil {
+			return nil, err
+		}
+		// riders are promised the times of the stops around them: no
+		// pickup before the driver leaves the stop before it, and no
+		// later than the stop after it
+		from, to := span(m, len(stops))
+		pickupFrom, pickupBy := stops[from].Planned(), stops[from].Planned()
+		if pickupGeo != stops[from].Geohash {
+			pickupBy = stops[from+1].Planned()
+		}
+		// a pickup time agreed with the rider takes over from the stops'
+		if m.PickupAt != nil {
+			pickupFrom, pickupBy = *m.PickupAt, *m.PickupAt
+		}
+		dropoffBy := p.latest(stops[to].Planned())
+		if m.ArriveBy != nil && (dropoffBy.IsZero() || m.ArriveBy.Before(dropoffBy)) {
+			dropoffBy = *m.ArriveBy
+		}
+		visits = append(visits,
+			routing.Visit{Point: pickup, Earliest: pickupFrom, Latest: p.latest(pickupBy), Load: seatsOf(m), After: -1},
+			routing.Visit{Point: dropoff, Latest: dropoffBy, Load: -seatsOf(m), After: len(visits)},
+		)
+		refs = append(refs,
+			ref{kind: planPickup, matchID: m.ID, stopSeq: -1, geohash: pickupGeo},
+			ref{kind: planDropoff, matchID: m.ID, stopSeq: -1, geohash: dropoffGeo},
+		)
+	}
+
+	depart := agreedDeparture(offer, matches)
+	sched, err := routing.Sequence(p.router, depart, points[0], points[last], visits, offer.Seats)
+	if err != nil {
+		return nil, err
+	}
+	var direct routing.Route
+	for i := 0; i < last; i++ {
+		leg, err := p.router.Route(points[i], points[i+1])
+		if err != nil {
+			return nil, err
+		}
+		direct.Meters += leg.Meters
+		direct.Seconds += leg.Seconds
+	}
+
+	plan := &db.TripPlan{
+		RideID:        offer.ID,
+		Meters:        math.Round(sched.Meters),
+		Seconds:       int(math.Round(sched.Seconds)),
+		DetourMeters:  math.Round(math.Max(0, sched.Meters-direct.Meters)),
+		DetourSeconds: int(math.Round(math.Max(0, sched.Seconds-direct.Seconds))),
+		LateSeconds:   int(math.Round(sched.LateSeconds)),
+		Optimal:       sched.Optimal,
+		UpdatedAt:     time.Now().UTC(),
+	}
+	plan.Stops = append(plan.Stops, db.TripPlanStop{
+		Kind:    planOrigin,
+		Geohash: stops[0].Geohash,
+		Arrive:  depart,
+		Depart:  depart,
+	})
+	for _, c := range sched.Calls {
+		r := ref{kind: planDestination, stopSeq: last, geohash: stops[last].Geohash}
+		if c.Visit >= 0 {
+			r = refs[c.Visit]
+		}
+		plan.Stops = append(plan.Stops, db.TripPlanStop{
+			Kind:        r.kind,
+			MatchID:     r.matchID,
+			StopSeq:     r.stopSeq,
+			Geohash:     r.geohash,
+			Arrive:      c.Arrive,
+			Depart:      c.Depart,
+			LateSeconds: int(math.Round(c.LateSeconds)),
+			LegMeters:   math.Round(c.Meters),
+			LegSeconds:  int(math.Round(c.Seconds)),
+			Onboard:     c.Load,
+		})
+	}
+	for i := range plan.Stops {
+		st := &plan.Stops[i]
+		st.ID = uuid.New().String()
+		st.RideID = offer.ID
+		st.Seq = i
+		st.Arrive, st.Depart = st.Arrive.UTC(), st.Depart.UTC()
+	}
+	if err := p.planrepo.Save(ctx, plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"hope/config"
+	"hope/db"
+	"hope/geo"
+	"hope/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	errTripNotActive    = errors.New("invalid state: match is not accepted")
+	errTripNotCompleted = errors.New("invalid state: match is not completed")
+)
+
+const (
+	// a point is only stored when the participant moved trackMinDistance
+	// meters or trackMinInterval passed since the last stored one
+	trackMinInterval = 15 * time.Second
+	trackMinDistance = 50.0
+
+	tripGeohashLen = 9
+
+	// how long a driver session reuses its observed speed before asking
+	// the track history again
+	speedRefresh = 30 * time.Second
+)
+
+type TripService interface {
+	// OpenSession joins callerID to the live trip of an accepted match they
+	// are the rider or driver of
+	OpenSession(ctx context.Context, callerID, matchID string) (*TripSession, error)
+	// ExportTrip renders the recorded tracks of a completed match, for its
+	// participants and admins only
+	ExportTrip(ctx context.Context, callerID, matchID, format string) (*TripExport, error)
+	// GetPickupETA estimates when the driver of an accepted match reaches
+	// the rider's pickup point
+	GetPickupETA(ctx context.Context, callerID, matchID string) (*PickupETA, error)
+	// GetTripManifest is an offer with its riders and the planned order
+	// of their pickups and dropoffs, for its driver and accepted riders
+	GetTripManifest(ctx context.Context, callerID, rideID string) (*Trip, error)
+	// ListMyTrips is the caller's offers as a driver with their riders,
+	// latest departure first. Plans are left out, see GetTripManifest
+	ListMyTrips(ctx context.Context, callerID string, limit int) ([]Trip, error)
+}
+
+type tripService struct {
+	matchrepo    repository.MatchRepository
+	offerrepo    repository.RideOfferRepository
+	pointrepo    repository.TripPointRepository
+	locationrepo repository.UserLocationRepository
+	userrepo     repository.UserRepository
+	reviewrepo   repository.ReviewRepository
+	waypointrepo repository.WaypointRepository
+	scope        orgScope
+	hub          *TripHub
+	eta          etaEstimator
+	planner      *TripPlanner
+}
+
+func NewTripService(
+	matchrepo repository.MatchRepository,
+	offerrepo repository.RideOfferRepository,
+	pointrepo repository.TripPointRepository,
+	locationrepo repository.UserLocationRepository,
+	userrepo repository.UserRepository,
+	orgrepo repository.OrganizationRepository,
+	reviewrepo repository.ReviewRepository,
+	waypointrepo repository.WaypointRepository,
+	hub *TripHub,
+	speedModel config.SpeedModel,
+	planner *TripPlanner,
+) TripService {
+	return &tripService{
+		matchrepo:    matchrepo,
+		offerrepo:    offerrepo,
+		pointrepo:    pointrepo,
+		locationrepo: locationrepo,
+		userrepo:     userrepo,
+		reviewrepo:   reviewrepo,
+		waypointrepo: waypointrepo,
+		scope:        orgScope{userrepo: userrepo, orgrepo: orgrepo},
+		hub:          hub,
+		eta:          etaEstimator{pointrepo: pointrepo, model: speedModel},
+		planner:      planner,
+	}
+}
+
+func (s tripService) OpenSession(ctx context.Context, callerID, matchID string) (*TripSession, error) {
+	callerID = strings.TrimSpace(callerID)
+	matchID = strings.TrimSpace(matchID)
+	if callerID == "" || matchID == "" {
+		return nil, errMissingFields
+	}
+	m, err := s.matchrepo.FindByID(ctx, matchID)
+	if err != nil || m == nil || m.ID == "" {
+		return nil, errMatchNotFound
+	}
+	if m.RiderID != callerID && m.DriverID != callerID {
+		return nil, errMatchNotFound
+	}
+	if m.Status != "accepted" {
+		return nil, errTripNotActive
+	}
+	u, err := s.scope.user(ctx, callerID)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &tripSub{
+		userID:  callerID,
+		matchID: m.ID,
+		rideID:  m.RideID,
+		driver:  m.DriverID == callerID,
+	}
+	if !sub.driver {
+		// no pickup just means no ETA on the events
+		if lat, lon, err := pickupPoint(ctx, s.offerrepo, m); err == nil {
+			sub.hasPickup, sub.pickupLat, sub.pickupLon = true, lat, lon
+		}
+	}
+	s.hub.subscribe(sub)
+	return &TripSession{
+		MatchID: m.ID,
+		RideID:  m.RideID,
+		UserID:  callerID,
+		Driver:  sub.driver,
+		Events:  sub.events,
+		svc:     s,
+		sub:     sub,
+		orgID:   u.OrgID,
+	}, nil
+}
+
+// TripSession is one participant's side of ShareTripLocation. Events is
+// closed when the session ends, either by Close or by the match completing.
+type TripSession struct {
+	MatchID string
+	RideID  string
+	UserID  string
+	Driver  bool
+	Events  <-chan TripEvent
+
+	svc   tripService
+	sub   *tripSub
+	orgID string
+
+	mu        sync.Mutex
+	last      *db.TripPoint // last reported position
+	lastSaved *db.TripPoint // last persisted position
+
+	// driver sessions only, see speed
+	speedKMH      float64
+	speedObserved bool
+	speedAt       time.Time
+}
+
+// speed is the driver's recent speed, refreshed every speedRefresh
+func (t *TripSession) speed(ctx context.Context) (float64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if time.Since(t.speedAt) >= speedRefresh {
+		t.speedKMH, t.speedObserved = t.svc.eta.speedKMH(ctx, t.UserID)
+		t.speedAt = time.Now()
+	}
+	return t.speedKMH, t.speedObserved
+}
+
+// Report stores the caller's position when it passes the downsampling
+// thresholds. A driver's position is also published to the riders, a
+// rider's never leaves the server.
+func (t *TripSession) Report(ctx context.Context, lat, lon float64, geohash string) error {
+	if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+		return errInvalidLatLon
+	}
+	geohash = strings.TrimSpace(geohash)
+	if geohash == "" {
+		geohash = geo.Encode(lat, lon, tripGeohashLen)
+	}
+	now := time.Now().UTC()
+	p := &db.TripPoint{
+		ID:         uuid.New().String(),
+		RideID:     t.RideID,
+		UserID:     t.UserID,
+		Latitude:   lat,
+		Longitude:  lon,
+		Geohash:    geohash,
+		RecordedAt: now,
+	}
+
+	t.mu.Lock()
+	if t.last != nil {
+		if dt := now.Sub(t.last.RecordedAt).Seconds(); dt > 0 {
+			p.SpeedMPS = geo.Distance(t.last.Latitude, t.last.Longitude, lat, lon) / dt
+		}
+	}
+	t.last = p
+	save := t.lastSaved == nil ||
+		now.Sub(t.lastSaved.RecordedAt) >= trackMinInterval ||
+		geo.Distance(t.lastSaved.Latitude, t.lastSaved.Longitude, lat, lon) >= trackMinDistance
+	if save {
+		t.lastSaved = p
+	}
+	t.mu.Unlock()
+
+	if t.Driver {
+		kmh, observed := t.speed(ctx)
+		withETA := func(to *tripSub, ev TripEvent) TripEvent {
+			if to.hasPickup {
+				eta := t.svc.eta.estimate(lat, lon, to.pickupLat, to.pickupLon, kmh, observed, now)
+				ev.ETA = &eta
+			}
+			return ev
+		}
+		t.svc.hub.publish(t.sub, TripEvent{
+			UserID:    t.UserID,
+			Driver:    true,
+			Latitude:  lat,
+			Longitude: lon,
+			Geohash:   geohash,
+			SpeedMPS:  p.SpeedMPS,
+			At:        now,
+		}, withETA)
+	}
+
+	if !save {
+		return nil
+	}
+	if err := t.svc.pointrepo.Create(ctx, p); err != nil {
+		return err
+	}
+	// keep the latest-position table fresh too, so GetLocationByUser and
+	// ListNearby don't lag behind the trip
+	return t.svc.locationrepo.Upsert(ctx, &db.UserLocation{
+		UserID:    t.UserID,
+		Latitude:  lat,
+		Longitude: lon,
+		Geohash:   geohash,
+		OrgID:     t.orgID,
+	})
+}
+
+// Close leaves the trip. It is safe to call more than once.
+func (t *TripSession) Close() {
+	t.svc.hub.unsubscribe(t.sub)
+}
+
+func (s tripService) ExportTrip(ctx context.Context, callerID, matchID, format string) (*TripExport, error) {
+	callerID = strings.TrimSpace(callerID)
+	matchID = strings.TrimSpace(matchID)
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = ExportGPX
+	}
+	if format != ExportGPX && format != ExportGeoJSON {
+		return nil, errExportFormat
+	}
+
+	m, err := s.matchrepo.FindByID(ctx, matchID)
+	if err != nil || m == nil || m.ID == "" {
+		return nil, errMatchNotFound
+	}
+	if m.RiderID != callerID && m.DriverID != callerID {
+		// admins may export any trip they can see, everyone else gets not found
+		if err := s.scope.requireAdmin(ctx, callerID); err != nil {
+			return nil, errMatchNotFound
+		}
+		if ok, err := s.scope.canSee(ctx, callerID, m.OrgID); err != nil || !ok {
+			return nil, errMatchNotFound
+		}
+	}
+	if m.Status != "completed" {
+		return nil, errTripNotCompleted
+	}
+
+	var parts []trackPart
+	for _, p := range []struct{ userID, role string }{
+		{m.DriverID, "driver"},
+		{m.RiderID, "rider"},
+	} {
+		points, err := s.pointrepo.ListTrack(ctx, m.RideID, p.userID)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, trackPart{UserID: p.userID, Role: p.role, Points: onboard(m, points)})
+	}
+
+	out := &TripExport{Filename: "trip-" + m.ID + "." + format}
+	switch format {
+	case ExportGPX:
+		out.ContentType = "application/gpx+xml"
+		out.Data, err = renderGPX(m.ID, parts)
+	case ExportGeoJSON:
+		out.ContentType = "application/geo+json"
+		out.Data, err = renderGeoJSON(m.ID, parts)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s tripService) GetPickupETA(ctx context.Context, callerID, matchID string) (*PickupETA, error) {
+	callerID = strings.TrimSpace(callerID)
+	m, err := s.matchrepo.FindByID(ctx, strings.TrimSpace(matchID))
+	if err != nil || m == nil || m.ID == "" {
+		return nil, errMatchNotFound
+	}
+	if m.RiderID != callerID && m.DriverID != callerID {
+		return nil, errMatchNotFound
+	}
+	if m.Status != "accepted" {
+		return nil, errTripNotActive
+	}
+
+	toLat, toLon, err := pickupPoint(ctx, s.offerrepo, m)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := s.locationrepo.GetByUserID(ctx, m.DriverID)
+	if err != nil {
+		return nil, err
+	}
+	if loc == nil {
+		return nil, errDriverLocationUnknown
+	}
+	kmh, observed := s.eta.speedKMH(ctx, m.DriverID)
+	eta := s.eta.estimate(loc.Latitude, loc.Longitude, toLat, toLon, kmh, observed, loc.UpdatedAt)
+	return &eta, nil
+}
+
+// onboard keeps the points recorded between the rider's check-in and the
+// trip's completion, so an export shows the ride itself rather than the
+// driver's way to the pickup. Matches from before check-in keep all points
+func onboard(m *db.Match, points []db.TripPoint) []db.TripPoint {
+	if m.StartedAt == nil || m.CompletedAt == nil {
+		return points
+	}
+	out := points[:0]
+	for _, p := range points {
+		if !p.RecordedAt.Before(*m.StartedAt) && !p.RecordedAt.After(*m.CompletedAt) {
+			out = append(out, p)
+		}
+	}
+	return out
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"hope/config"
 	"hope/db"
 	"hope/repository"
 )
@@ -14,6 +15,8 @@ var (
 	errUserNotFound      = errors.New("user not found")
 	errEmailAndNameReq   = errors.New("name and email required")
 	errEmailAlreadyInUse = errors.New("user already exists with this email")
+	errBlockSelf         = errors.New("cannot block yourself")
+	errFavoriteSelf      = errors.New("cannot favorite yourself")
 )
 
 type UserService interface {
@@ -23,14 +26,39 @@ type UserService interface {
 	UpdateUser(ctx context.Context, user *db.User) error
 	DeleteUser(ctx context.Context, id string) error
 	UpdateLastSeen(ctx context.Context, id string) error
+
+	BlockUser(ctx context.Context, blockerID, blockedID string, muteOnly bool) error
+	UnblockUser(ctx context.Context, blockerID, blockedID string) error
+	ListBlocked(ctx context.Context, blockerID string, limit int) ([]db.UserBlock, error)
+
+	// AddFavorite marks favoriteID as one of userID's favorites, whom
+	// their offers can accept without asking
+	AddFavorite(ctx context.Context, userID, favoriteID string) error
+	RemoveFavorite(ctx context.Context, userID, favoriteID string) error
+	ListFavorites(ctx context.Context, userID string, limit int) ([]db.UserFavorite, error)
+
+	// Reliability scores each user from how their matches ended
+	Reliability(ctx context.Context, userIDs []string) (map[string]Reliability, error)
 }
 
 type userService struct {
-	userRepo repository.UserRepository
+	userRepo    repository.UserRepository
+	blockrepo   repository.UserBlockRepository
+	favrepo     repository.UserFavoriteRepository
+	reliability reliabilityScores
+	scope       orgScope
+	blocks      blockList
 }
 
-func NewUserService(userRepo repository.UserRepository) UserService {
-	return &userService{userRepo: userRepo}
+func NewUserService(userRepo repository.UserRepository, orgrepo repository.OrganizationRepository, blockrepo repository.UserBlockRepository, favrepo repository.UserFavoriteRepository, matchrepo repository.MatchRepository, policy config.CancellationPolicy) UserService {
+	return &userService{
+		userRepo:    userRepo,
+		blockrepo:   blockrepo,
+		favrepo:     favrepo,
+		reliability: reliabilityScores{matchrepo: matchrepo, policy: policy},
+		scope:       orgScope{userrepo: userRepo, orgrepo: orgrepo},
+		blocks:      blockList{blockrepo: blockrepo},
+	}
 }
 
 func (s userService) CreateUser(ctx context.Context, user *db.User) error {
@@ -132,3 +160,94 @@ func (s userService) UpdateLastSeen(ctx context.Context, id string) error {
 	u.LastSeen = time.Now()
 	return s.userRepo.Update(ctx, u)
 }
+
+// BlockUser blocks (or with muteOnly just mutes) blockedID for blockerID,
+// calling it again switches between the two
+func (s userService) BlockUser(ctx context.Context, blockerID, blockedID string, muteOnly bool) error {
+	blockerID = strings.TrimSpace(blockerID)
+	blockedID = strings.TrimSpace(blockedID)
+	if blockerID == "" || blockedID == "" {
+		return errMissingFields
+	}
+	if blockerID == blockedID {
+		return errBlockSelf
+	}
+	u, err := s.userRepo.FindByID(ctx, blockedID)
+	if err != nil {
+		return err
+	}
+	if u == nil || u.ID == "" {
+		return errUserNotFound
+	}
+	kind := "block"
+	if muteOnly {
+		kind = "mute"
+	}
+	return s.blockrepo.Upsert(ctx, &db.UserBlock{
+		BlockerID: blockerID,
+		BlockedID: blockedID,
+		Kind:      kind,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func (s userService) UnblockUser(ctx context.Context, blockerID, blockedID string) error {
+	blockerID = strings.TrimSpace(blockerID)
+	blockedID = strings.TrimSpace(blockedID)
+	if blockerID == "" || blockedID == "" {
+		return errMissingFields
+	}
+	return s.blockrepo.Delete(ctx, blockerID, blockedID)
+}
+
+func (s userService) ListBlocked(ctx context.Context, blockerID string, limit int) ([]db.UserBlock, error) {
+	return s.blockrepo.ListByBlocker(ctx, strings.TrimSpace(blockerID), limit)
+}
+
+func (s userService) AddFavorite(ctx context.Context, userID, favoriteID string) error {
+	userID = strings.TrimSpace(userID)
+	favoriteID = strings.TrimSpace(favoriteID)
+	if userID == "" || favoriteID == "" {
+		return errMissingFields
+	}
+	if userID == favoriteID {
+		return errFavoriteSelf
+	}
+	u, err := s.userRepo.FindByID(ctx, favoriteID)
+	if err != nil {
+		return err
+	}
+	if u == nil || u.ID == "" {
+		return errUserNotFound
+	}
+	// users of orgs the caller can't see, and blocks either way, look like
+	// unknown ids so favoriting can't be used to probe for them
+	if ok, err := s.scope.canSee(ctx, userID, u.OrgID); err != nil || !ok {
+		return errUserNotFound
+	}
+	if blocked, err := s.blocks.between(ctx, userID, favoriteID); err != nil || blocked {
+		return errUserNotFound
+	}
+	return s.favrepo.Add(ctx, &db.UserFavorite{
+		UserID:     userID,
+		FavoriteID: favoriteID,
+		CreatedAt:  time.Now().UTC(),
+	})
+}
+
+func (s userService) RemoveFavorite(ctx context.Context, userID, favoriteID string) error {
+	userID = strings.TrimSpace(userID)
+	favoriteID = strings.TrimSpace(favoriteID)
+	if userID == "" || favoriteID == "" {
+		return errMissingFields
+	}
+	return s.favrepo.Remove(ctx, userID, favoriteID)
+}
+
+func (s userService) ListFavorites(ctx context.Context, userID string, limit int) ([]db.UserFavorite, error) {
+	return s.favrepo.ListByUser(ctx, strings.TrimSpace(userID), limit)
+}
+
+func (s userService) Reliability(ctx context.Context, userIDs []string) (map[string]Reliability, error) {
+	return s.reliability.of(ctx, userIDs)
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"hope/config"
+	"hope/db"
+	"hope/repository"
+)
+
+var (
+	errNotPromoted = errors.New("invalid state: no seat is being held for this match")
+	errHoldLapsed  = errors.New("invalid state: the seat was not confirmed in time")
+)
+
+// Waitlist keeps the lines of riders waiting on full offers. When seats
+// come free it promotes the first riders in line that fit, who hold the
+// seats until they confirm them or the hold lapses, and Run moves lapsed
+// holds on to whoever is next.
+type Waitlist struct {
+	matchrepo    repository.MatchRepository
+	rideofferepo repository.RideOfferRepository
+	waypointrepo repository.WaypointRepository
+	cfg          config.Waitlist
+}
+
+func NewWaitlist(matchrepo repository.MatchRepository, rideofferepo repository.RideOfferRepository, waypointrepo repository.WaypointRepository, cfg config.Waitlist) *Waitlist {
+	return &Waitlist{
+		matchrepo:    matchrepo,
+		rideofferepo: rideofferepo,
+		waypointrepo: waypointrepo,
+		cfg:          cfg,
+	}
+}
+
+// WaitlistSpot is where a match stands in its offer's waitlist. Position
+// counts from 1 for the next in line and is 0 once the match left the
+// line; Length is how many are waiting in all
+type WaitlistSpot struct {
+	Match    *db.Match
+	Position int
+	Length   int
+}
+
+// Run sweeps once right away and then every SweepInterval until ctx is
+// done. It does nothing when sweeping is disabled.
+func (w *Waitlist) Run(ctx context.Context) {
+	if w.cfg.SweepInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(w.cfg.SweepInterval)
+	defer ticker.Stop()
+	for {
+		w.sweep(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Waitlist) sweep(ctx context.Context) {
+	rides, err := w.matchrepo.ListWaitlistRides(ctx, time.Now().UTC())
+	if err != nil {
+		log.Printf("waitlist sweep failed: %v", err)
+		return
+	}
+	for _, id := range rides {
+		offer, err := w.rideofferepo.FindByID(ctx, id)
+		if err != nil {
+			log.Printf("waitlist sweep: ride %s: %v", id, err)
+			continue
+		}
+		if offer != nil {
+			w.refill(ctx, offer)
+		}
+	}
+}
+
+// refill promotes waitlisted riders into the seats free on offer. Run
+// goes over the offer again on its next sweep, so an error here is logged
+// rather than failing whoever freed the seats
+func (w *Waitlist) refill(ctx context.Context, offer *db.RideOffer) {
+	if err := w.promote(ctx, offer); err != nil {
+		log.Printf("ride %s: waitlist not moved on: %v", offer.ID, err)
+	}
+}
+
+// promote lapses holds that were not confirmed in time, then walks the
+// line first come first served and promotes every rider who fits into the
+// seats left. A rider wanting more seats than are free, or proposing a
+// fare below the listed one the driver hasn't agreed to, keeps their place
+// without holding up those behind them. Once the ride has left or closed,
+// whoever is still waiting lapses
+func (w *Waitlist) promote(ctx context.Context, offer *db.RideOffer) error {
+	matches, err := w.matchrepo.FindByRideID(ctx, offer.ID)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	open := (offer.Status == "active" || offer.Status == "matched") && now.Before(offer.Time)
+	for i := range matches {
+		m := &matches[i]
+		var reason string
+		switch {
+		case m.Status == "promoted" && m.ConfirmBy != nil && now.After(*m.ConfirmBy):
+			reason = "seat not confirmed in time"
+		case !open && (m.Status == "waitlisted" || m.Status == "promoted"):
+			reason = "ride no longer open"
+		default:
+			continue
+		}
+		if err := w.matchrepo.UpdateStatus(ctx, m.ID, m.Status, "lapsed", repository.Change{Reason: reason, At: now}); err != nil {
+			return err
+		}
+		m.Status = "lapsed"
+	}
+	if !open {
+		return nil
+	}
+
+	stops, err := routeStops(ctx, w.waypointrepo, offer)
+	if err != nil {
+		return err
+	}
+	confirmBy := now.Add(w.cfg.ConfirmWindow)
+	if confirmBy.After(offer.Time) {
+		confirmBy = offer.Time
+	}
+	for _, i := range waitingLine(matches) {
+		m := &matches[i]
+		if !driverAgreed(offer, *m) || checkSeats(offer, matches, len(stops), *m) != nil {
+			continue
+		}
+		if err := w.matchrepo.Promote(ctx, m.ID, m.Status, confirmBy, repository.Change{Reason: "a seat came free", At: now}); err != nil {
+			return err
+		}
+		m.Status, m.ConfirmBy = "promoted", &confirmBy
+	}
+	return nil
+}
+
+// waitingLine is the indexes of the waitlisted matches in line order,
+// first come first served
+func waitingLine(matches []db.Match) []int {
+	var line []int
+	for i, m := range matches {
+		if m.Status == "waitlisted" {
+			line = append(line, i)
+		}
+	}
+	sort.Slice(line, func(a, b int) bool {
+		ma, mb := matches[line[a]], matches[line[b]]
+		if !ma.CreatedAt.Equal(mb.CreatedAt) {
+			return ma.CreatedAt.Before(mb.CreatedAt)
+		}
+		return ma.ID < mb.ID
+	})
+	return line
+}
+
+func (s matchService) ConfirmWaitlistSeat(ctx context.Context, callerID, matchID string) (*db.Match, error) {
+	callerID = strings.TrimSpace(callerID)
+	m, err := s.matchrepo.FindByID(ctx, strings.TrimSpace(matchID))
+	if err != nil || m == nil || m.ID == "" {
+		return nil, errMatchNotFound
+	}
+	if m.RiderID != callerID {
+		return nil, errForbidden
+	}
+	if m.Status != "promoted" {
+		return nil, errNotPromoted
+	}
+	offer, err := s.rideofferepo.FindByID(ctx, m.RideID)
+	if err != nil || offer == nil || offer.ID == "" {
+		return nil, errOfferNotFound
+	}
+	if m.ConfirmBy != nil && time.Now().UTC().After(*m.ConfirmBy) {
+		s.waitlist.refill(ctx, offer)
+		return nil, errHoldLapsed
+	}
+	if !driverAgreed(offer, *m) {
+		return nil, errFareAwaitsDriver
+	}
+	if err := s.checkReturnLeg(ctx, m); err != nil {
+		return nil, err
+	}
+	if blocked, err := s.blocks.between(ctx, m.DriverID, m.RiderID); err != nil || blocked {
+		return nil, errBlocked
+	}
+	stops, err := routeStops(ctx, s.waypointrepo, offer)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.matchrepo.FindByRideID(ctx, offer.ID)
+	if err != nil {
+		return nil, err
+	}
+	// riders accepted while this one waited may have settled the departure
+	if err := s.planPickup(offer, stops, matches, m, nil); err != nil {
+		return nil, err
+	}
+	if err := s.sched.check(ctx, m.RiderID, s.sched.offerBooking(offer, stops), false); err != nil {
+		return nil, err
+	}
+
+	if err := s.matchrepo.Accept(ctx, m.ID, m.Status, *m.PickupAt, *m.DepartAt, repository.Change{ActorID: callerID}); err != nil {
+		return nil, err
+	}
+	m.Status = "accepted"
+	s.planner.refresh(ctx, offer)
+	if err := s.withdrawOverlapping(ctx, m, offer); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (s matchService) GetWaitlistPosition(ctx context.Context, callerID, matchID string) (*WaitlistSpot, error) {
+	callerID = strings.TrimSpace(callerID)
+	m, err := s.matchrepo.FindByID(ctx, strings.TrimSpace(matchID))
+	if err != nil || m == nil || m.ID == "" {
+		return nil, errMatchNotFound
+	}
+	if m.RiderID != callerID && m.DriverID != callerID {
+		return nil, errMatchNotFound
+	}
+	matches, err := s.matchrepo.FindByRideID(ctx, m.RideID)
+	if err != nil {
+		return nil, err
+	}
+	line := waitingLine(matches)
+	spot := &WaitlistSpot{Match: m, Length: len(line)}
+	for n, i := range line {
+		if matches[i].ID == m.ID {
+			spot.Position = n + 1
+		}
+	}
+	return spot, nil
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"hope/db"
+	"hope/repository"
+
+	"github.com/google/uuid"
+)
+
+var (
+	errInvalidStops = errors.New("invalid stops: pickup must come before dropoff on the route")
+	errNoSeatsFree  = errors.New("invalid state: no seats free between those stops")
+)
+
+// an offer passes at most this many stops, origin and destination included
+const maxStops = 12
+
+// routeStops is the offer's stops in order, origin and destination
+// included. Offers without waypoint rows get the two implied ones
+func routeStops(ctx context.Context, waypointrepo repository.WaypointRepository, offer *db.RideOffer) ([]db.Waypoint, error) {
+	stops, err := waypointrepo.ListByRide(ctx, offer.ID)
+	if err != nil {
+		return nil, err
+	}
+	return offerStops(offer, stops), nil
+}
+
+// offerStops is routeStops for waypoints already loaded
+func offerStops(offer *db.RideOffer, stops []db.Waypoint) []db.Waypoint {
+	if len(stops) >= 2 {
+		return stops
+	}
+	return []db.Waypoint{
+		{RideID: offer.ID, Seq: 0, Geohash: offer.FromGeo, PointID: offer.FromPointID, PlannedAt: &offer.Time},
+		{RideID: offer.ID, Seq: 1, Geohash: offer.ToGeo, PointID: offer.ToPointID},
+	}
+}
+
+// buildStops turns the driver's intermediate waypoints into the offer's
+// full stop list. Planned times must not go backwards and none may be
+// before departure
+func buildStops(offer *db.RideOffer, via []db.Waypoint) ([]db.Waypoint, error) {
+	if len(via)+2 > maxStops {
+		return nil, fmt.Errorf("invalid waypoints: at most %d allowed", maxStops-2)
+	}
+	stops := make([]db.Waypoint, 0, len(via)+2)
+	departAt := offer.Time
+	stops = append(stops, db.Waypoint{Geohash: offer.FromGeo, PointID: offer.FromPointID, PlannedAt: &departAt})
+	last := offer.Time
+	for _, w := range via {
+		w.Geohash = strings.TrimSpace(w.Geohash)
+		if w.Geohash == "" {
+			return nil, errors.New("invalid waypoints: geohash or point required")
+		}
+		if w.PlannedAt != nil {
+			if w.PlannedAt.Before(last) {
+				return nil, errors.New("invalid waypoints: planned times must follow departure and each other")
+			}
+			last = *w.PlannedAt
+		}
+		stops = append(stops, w)
+	}
+	stops = append(stops, db.Waypoint{Geohash: offer.ToGeo, PointID: offer.ToPointID})
+	for i := range stops {
+		stops[i].ID = uuid.New().String()
+		stops[i].RideID = offer.ID
+		stops[i].Seq = i
+		if stops[i].PlannedAt != nil {
+			at := stops[i].PlannedAt.UTC()
+			stops[i].PlannedAt = &at
+		}
+	}
+	return stops, nil
+}
+
+// span is the stretch of route m occupies, from its pickup stop up to but
+// not including its dropoff stop, as segment indexes. Matches made without
+// stops ride the whole route
+func span(m db.Match, stops int) (from, to int) {
+	if m.DropoffStop <= m.PickupStop || m.DropoffStop >= stops {
+		return 0, stops - 1
+	}
+	return m.PickupStop, m.DropoffStop
+}
+
+func seatsOf(m db.Match) int {
+	if m.Seats < 1 {
+		return 1
+	}
+	return m.Seats
+}
+
+// seatsUsed is how many seats accepted matches take on each segment
+// (segment i runs from stop i to stop i+1)
+func seatsUsed(matches []db.Match, stops int) []int {
+	used := make([]int, stops-1)
+	for _, m := range matches {
+		// a rider promoted from the waitlist holds their seats until the
+		// hold lapses
+		if m.Status != "accepted" && m.Status != "promoted" {
+			continue
+		}
+		from, to := span(m, stops)
+		for i := from; i < to; i++ {
+			used[i] += seatsOf(m)
+		}
+	}
+	return used
+}
+
+// checkSeats fails when m does not fit next to the accepted matches on
+// every segment it rides
+func checkSeats(offer *db.RideOffer, matches []db.Match, stops int, m db.Match) error {
+	used := seatsUsed(matches, stops)
+	from, to := span(m, stops)
+	for i := from; i < to; i++ {
+		if used[i]+seatsOf(m) > offer.Seats {
+			return errNoSeatsFree
+		}
+	}
+	return nil
+}
+
+// StopInfo is a stop plus the free seats on the segment leaving it (0 at
+// the destination).
+type StopInfo struct {
+	Seq       int
+	Geohash   string
+	PointID   string
+	PlannedAt time.Time
+	SeatsFree int
+}
@@ -0,0 +1,199 @@
+// Package spatial is an in-memory geohash trie used to answer nearby and
+// radius queries without going to the database.
+package spatial
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"hope/geo"
+)
+
+// MaxDepth is how deep the trie goes (~150m cells). Longer geohashes are
+// kept whole on the entry and compared when a query is more precise than
+// the trie, deeper levels would mostly hold single entries.
+const MaxDepth = 7
+
+const alphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+type entry[T any] struct {
+	id      string
+	geohash string
+	lat     float64
+	lon     float64
+	value   T
+	node    *node[T]
+	pos     int // index in node.entries
+}
+
+type node[T any] struct {
+	children [32]*node[T]
+	entries  []*entry[T]
+}
+
+// Index maps ids to values placed at a geohash. It is safe for concurrent use.
+type Index[T any] struct {
+	mu   sync.RWMutex
+	root node[T]
+	byID map[string]*entry[T]
+}
+
+func NewIndex[T any]() *Index[T] {
+	return &Index[T]{byID: make(map[string]*entry[T])}
+}
+
+// Hit is a radius query result.
+type Hit[T any] struct {
+	Value    T
+	Distance float64 // meters
+}
+
+// Upsert places id at geohash, moving it if it was elsewhere. Invalid
+// geohashes remove the id instead.
+func (ix *Index[T]) Upsert(id, geohash string, v T) {
+	geohash = strings.ToLower(strings.TrimSpace(geohash))
+	lat, lon, err := geo.Decode(geohash)
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.removeLocked(id)
+	if err != nil {
+		return
+	}
+	n := &ix.root
+	for i := 0; i < len(geohash) && i < MaxDepth; i++ {
+		c := strings.IndexByte(alphabet, geohash[i])
+		if n.children[c] == nil {
+			n.children[c] = &node[T]{}
+		}
+		n = n.children[c]
+	}
+	e := &entry[T]{id: id, geohash: geohash, lat: lat, lon: lon, value: v, node: n, pos: len(n.entries)}
+	n.entries = append(n.entries, e)
+	ix.byID[id] = e
+}
+
+// Remove drops id, it is a no-op for unknown ids.
+func (ix *Index[T]) Remove(id string) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	ix.removeLocked(id)
+}
+
+// emptied nodes are left in place, the trie only grows as wide as the map
+func (ix *Index[T]) removeLocked(id string) {
+	e, ok := ix.byID[id]
+	if !ok {
+		return
+	}
+	entries := e.node.entries
+	last := entries[len(entries)-1]
+	entries[e.pos], last.pos = last, e.pos
+	entries[len(entries)-1] = nil
+	e.node.entries = entries[:len(entries)-1]
+	delete(ix.byID, id)
+}
+
+// Len is the number of indexed ids.
+func (ix *Index[T]) Len() int {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return len(ix.byID)
+}
+
+// RemoveIf drops every entry drop matches and returns how many went.
+func (ix *Index[T]) RemoveIf(drop func(T) bool) int {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	n := 0
+	for id, e := range ix.byID {
+		if drop(e.value) {
+			ix.removeLocked(id)
+			n++
+		}
+	}
+	return n
+}
+
+// Prefix returns every value whose geohash starts with prefix and that keep
+// accepts (nil keeps all). Order is unspecified.
+func (ix *Index[T]) Prefix(prefix string, keep func(T) bool) []T {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	var out []T
+	ix.prefixLocked(prefix, func(e *entry[T]) {
+		if keep == nil || keep(e.value) {
+			out = append(out, e.value)
+		}
+	})
+	return out
+}
+
+func (ix *Index[T]) prefixLocked(prefix string, visit func(*entry[T])) {
+	n := &ix.root
+	for i := 0; i < len(prefix) && i < MaxDepth; i++ {
+		c := strings.IndexByte(alphabet, prefix[i])
+		if c < 0 || n.children[c] == nil {
+			return
+		}
+		n = n.children[c]
+	}
+	if len(prefix) > MaxDepth {
+		for _, e := range n.entries {
+			if strings.HasPrefix(e.geohash, prefix) {
+				visit(e)
+			}
+		}
+		return
+	}
+	walk(n, visit)
+}
+
+func walk[T any](n *node[T], visit func(*entry[T])) {
+	for _, e := range n.entries {
+		visit(e)
+	}
+	for _, c := range n.children {
+		if c != nil {
+			walk(c, visit)
+		}
+	}
+}
+
+// Radius returns values within meters of lat/lon that keep accepts,
+// closest first. Subtrees whose cell misses the circle's bounding box are
+// skipped.
+func (ix *Index[T]) Radius(lat, lon, meters float64, keep func(T) bool) []Hit[T] {
+	minLat, maxLat, minLon, maxLon := geo.CircleBounds(lat, lon, meters)
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	var out []Hit[T]
+	var descend func(n *node[T], path []byte)
+	descend = func(n *node[T], path []byte) {
+		for _, e := range n.entries {
+			if keep != nil && !keep(e.value) {
+				continue
+			}
+			if d := geo.Distance(lat, lon, e.lat, e.lon); d <= meters {
+				out = append(out, Hit[T]{Value: e.value, Distance: d})
+			}
+		}
+		for i, c := range n.children {
+			if c == nil {
+				continue
+			}
+			child := append(path, alphabet[i])
+			latLo, latHi, lonLo, lonHi, err := geo.Bounds(string(child))
+			if err != nil || latHi < minLat || latLo > maxLat || lonHi < minLon || lonLo > maxLon {
+				continue
+			}
+			descend(c, child)
+		}
+	}
+	descend(&ix.root, make([]byte, 0, MaxDepth))
+	sort.Slice(out, func(i, j int) bool { return out[i].Distance < out[j].Distance })
+	return out
+}
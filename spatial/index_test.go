@@ -0,0 +1,221 @@
+package spatial
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+
+	"hope/geo"
+)
+
+// check walks the trie and verifies every entry sits at its recorded
+// position and byID agrees with what the nodes hold
+func check[T any](t *testing.T, ix *Index[T]) {
+	t.Helper()
+	seen := 0
+	walk(&ix.root, func(e *entry[T]) {
+		seen++
+		if e.node.entries[e.pos] != e {
+			t.Fatalf("entry %s recorded at %d but not found there", e.id, e.pos)
+		}
+		if ix.byID[e.id] != e {
+			t.Fatalf("entry %s in the trie but not in byID", e.id)
+		}
+	})
+	if seen != len(ix.byID) {
+		t.Fatalf("trie holds %d entries, byID %d", seen, len(ix.byID))
+	}
+}
+
+func ids(vs []string) []string {
+	sort.Strings(vs)
+	return vs
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRemoveSwapsLastIntoPlace(t *testing.T) {
+	for _, victim := range []string{"a", "b", "c"} {
+		ix := NewIndex[string]()
+		for _, id := range []string{"a", "b", "c"} {
+			ix.Upsert(id, "tdr1y6g", id)
+		}
+		ix.Remove(victim)
+		check(t, ix)
+
+		var want []string
+		for _, id := range []string{"a", "b", "c"} {
+			if id != victim {
+				want = append(want, id)
+			}
+		}
+		if got := ids(ix.Prefix("tdr1y6g", nil)); !equal(got, want) {
+			t.Fatalf("removing %s: got %v, want %v", victim, got, want)
+		}
+	}
+}
+
+func TestRemoveOnlyEntry(t *testing.T) {
+	ix := NewIndex[string]()
+	ix.Upsert("a", "tdr1y6g", "a")
+	ix.Remove("a")
+	check(t, ix)
+	if ix.Len() != 0 || len(ix.Prefix("t", nil)) != 0 {
+		t.Fatal("index not empty after removing its only entry")
+	}
+	// the emptied node is reused
+	ix.Upsert("b", "tdr1y6g", "b")
+	check(t, ix)
+	if got := ix.Prefix("tdr1y6g", nil); !equal(got, []string{"b"}) {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestRemoveUnknown(t *testing.T) {
+	ix := NewIndex[string]()
+	ix.Upsert("a", "tdr1y6g", "a")
+	ix.Remove("missing")
+	check(t, ix)
+	if ix.Len() != 1 {
+		t.Fatalf("len %d, want 1", ix.Len())
+	}
+}
+
+func TestUpsertMoves(t *testing.T) {
+	ix := NewIndex[string]()
+	ix.Upsert("a", "tdr1y6g", "a")
+	ix.Upsert("b", "tdr1y6g", "b")
+	ix.Upsert("a", "tdr1wxy", "a")
+	check(t, ix)
+	if got := ix.Prefix("tdr1y6g", nil); !equal(got, []string{"b"}) {
+		t.Fatalf("old cell: got %v", got)
+	}
+	if got := ix.Prefix("tdr1wxy", nil); !equal(got, []string{"a"}) {
+		t.Fatalf("new cell: got %v", got)
+	}
+}
+
+func TestUpsertInvalidRemoves(t *testing.T) {
+	ix := NewIndex[string]()
+	ix.Upsert("a", "tdr1y6g", "a")
+	ix.Upsert("a", "not a geohash", "a")
+	check(t, ix)
+	if ix.Len() != 0 {
+		t.Fatalf("len %d, want 0", ix.Len())
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+	ix := NewIndex[int]()
+	for i := 0; i < 20; i++ {
+		ix.Upsert(strconv.Itoa(i), "tdr1y6g", i)
+	}
+	if n := ix.RemoveIf(func(v int) bool { return v%3 == 0 }); n != 7 {
+		t.Fatalf("removed %d, want 7", n)
+	}
+	check(t, ix)
+	for _, v := range ix.Prefix("tdr1y6g", nil) {
+		if v%3 == 0 {
+			t.Fatalf("%d survived RemoveIf", v)
+		}
+	}
+}
+
+// TestRemoveRandom churns entries through a few shared cells and compares
+// the index against a plain map after every step
+func TestRemoveRandom(t *testing.T) {
+	cells := []string{"tdr1y6g", "tdr1y6gq", "tdr1y6u", "tdr1wxy"}
+	ix := NewIndex[string]()
+	model := make(map[string]string)
+	r := rand.New(rand.NewSource(7))
+	for step := 0; step < 2000; step++ {
+		id := strconv.Itoa(r.Intn(50))
+		if r.Intn(3) == 0 {
+			ix.Remove(id)
+			delete(model, id)
+		} else {
+			cell := cells[r.Intn(len(cells))]
+			ix.Upsert(id, cell, id)
+			model[id] = cell
+		}
+		check(t, ix)
+		if ix.Len() != len(model) {
+			t.Fatalf("step %d: len %d, want %d", step, ix.Len(), len(model))
+		}
+	}
+	for _, cell := range cells {
+		var want []string
+		for id, c := range model {
+			if len(c) >= len(cell) && c[:len(cell)] == cell {
+				want = append(want, id)
+			}
+		}
+		if got := ids(ix.Prefix(cell, nil)); !equal(got, ids(want)) {
+			t.Fatalf("prefix %s: got %v, want %v", cell, got, want)
+		}
+	}
+}
+
+func TestRadiusClosestFirst(t *testing.T) {
+	ix := NewIndex[string]()
+	lat, lon := 12.97, 77.59
+	ix.Upsert("near", geo.Encode(lat+0.001, lon, 9), "near")
+	ix.Upsert("mid", geo.Encode(lat+0.005, lon, 9), "mid")
+	ix.Upsert("far", geo.Encode(lat+0.1, lon, 9), "far")
+	hits := ix.Radius(lat, lon, 1000, nil)
+	if len(hits) != 2 || hits[0].Value != "near" || hits[1].Value != "mid" {
+		t.Fatalf("got %v", hits)
+	}
+}
+
+// fill spreads n entries over a ~20km square and returns some of their
+// geohashes to search around
+func fill(n int) (*Index[int], []string) {
+	ix := NewIndex[int]()
+	r := rand.New(rand.NewSource(42))
+	centers := make([]string, 0, 1000)
+	for i := 0; i < n; i++ {
+		hash := geo.Encode(12.9+r.Float64()*0.2, 77.5+r.Float64()*0.2, 9)
+		ix.Upsert(strconv.Itoa(i), hash, i)
+		if len(centers) < cap(centers) {
+			centers = append(centers, hash)
+		}
+	}
+	return ix, centers
+}
+
+func BenchmarkUpsert(b *testing.B) {
+	ix, centers := fill(50000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ix.Upsert(strconv.Itoa(i%50000), centers[i%len(centers)], i)
+	}
+}
+
+func BenchmarkPrefix(b *testing.B) {
+	ix, centers := fill(50000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ix.Prefix(centers[i%len(centers)][:5], nil)
+	}
+}
+
+func BenchmarkRadius(b *testing.B) {
+	ix, centers := fill(50000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		lat, lon, _ := geo.Decode(centers[i%len(centers)])
+		ix.Radius(lat, lon, 2000, nil)
+	}
+}